@@ -142,3 +142,23 @@ resource "keycloak_group_roles" "front_desk_roles" {
     "${data.keycloak_role.realm_offline_access.id}",
   ]
 }
+
+resource "keycloak_user" "pet_api_vet" {
+  realm_id = "${keycloak_realm.roles_example.id}"
+  username = "vet"
+}
+
+resource "keycloak_user_roles" "vet_roles" {
+  realm_id = "${keycloak_realm.roles_example.id}"
+  user_id  = "${keycloak_user.pet_api_vet.id}"
+
+  role_ids = [
+    "${keycloak_role.pet_api_read_pet.id}",
+    "${data.keycloak_role.realm_offline_access.id}",
+  ]
+}
+
+data "keycloak_user_roles" "vet_roles" {
+  realm_id = "${keycloak_user_roles.vet_roles.realm_id}"
+  user_id  = "${keycloak_user_roles.vet_roles.user_id}"
+}
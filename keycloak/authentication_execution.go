@@ -0,0 +1,183 @@
+package keycloak
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// an execution of an authenticator within a flow, as returned by GET realms/{{realm}}/authentication/executions/{{id}}
+type AuthenticationExecution struct {
+	Id              string `json:"id,omitempty"`
+	RealmId         string `json:"-"`
+	ParentFlowAlias string `json:"-"`
+	Authenticator   string `json:"authenticator"`
+	Requirement     string `json:"requirement"`
+	Priority        int    `json:"priority"`
+}
+
+// the representation used to list and update the executions of a flow. unlike AuthenticationExecution, this includes
+// executions of subflows, which are identified by their FlowId
+type AuthenticationExecutionInfo struct {
+	Id                 string `json:"id"`
+	DisplayName        string `json:"displayName,omitempty"`
+	Requirement        string `json:"requirement"`
+	ProviderId         string `json:"providerId,omitempty"`
+	AuthenticationFlow bool   `json:"authenticationFlow,omitempty"`
+	FlowId             string `json:"flowId,omitempty"`
+	Level              int    `json:"level"`
+	Index              int    `json:"index"`
+	Priority           int    `json:"priority,omitempty"`
+}
+
+func authenticationFlowExecutionsPath(realmId, flowAlias string) string {
+	return fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, url.PathEscape(flowAlias))
+}
+
+// ListAuthenticationExecutionInfo returns every execution within the flow, including the executions of its subflows.
+// The direct children of the flow have a level of 0.
+func (keycloakClient *KeycloakClient) ListAuthenticationExecutionInfo(realmId, flowAlias string) ([]*AuthenticationExecutionInfo, error) {
+	var executions []*AuthenticationExecutionInfo
+
+	err := keycloakClient.get(authenticationFlowExecutionsPath(realmId, flowAlias), &executions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
+// returns the info for the execution directly within the flow for which `matches` returns true, along with the number
+// of executions directly within the flow
+func (keycloakClient *KeycloakClient) getAuthenticationExecutionInfo(realmId, flowAlias string, matches func(*AuthenticationExecutionInfo) bool) (*AuthenticationExecutionInfo, int, error) {
+	executions, err := keycloakClient.ListAuthenticationExecutionInfo(realmId, flowAlias)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var match *AuthenticationExecutionInfo
+	var siblings int
+	for _, execution := range executions {
+		if execution.Level != 0 {
+			continue
+		}
+
+		siblings++
+
+		if matches(execution) {
+			match = execution
+		}
+	}
+
+	if match == nil {
+		return nil, 0, fmt.Errorf("execution could not be found within authentication flow %s", flowAlias)
+	}
+
+	return match, siblings, nil
+}
+
+func (keycloakClient *KeycloakClient) NewAuthenticationExecution(execution *AuthenticationExecution) error {
+	_, location, err := keycloakClient.post(authenticationFlowExecutionsPath(execution.RealmId, execution.ParentFlowAlias)+"/execution", map[string]string{
+		"provider": execution.Authenticator,
+	})
+	if err != nil {
+		return err
+	}
+
+	execution.Id = getIdFromLocationHeader(location)
+
+	// new executions are always disabled
+	return keycloakClient.UpdateAuthenticationExecutionRequirement(execution.RealmId, execution.ParentFlowAlias, execution.Id, execution.Requirement)
+}
+
+func (keycloakClient *KeycloakClient) GetAuthenticationExecution(realmId, parentFlowAlias, id string) (*AuthenticationExecution, error) {
+	var execution AuthenticationExecution
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), &execution, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	execution.RealmId = realmId
+	execution.ParentFlowAlias = parentFlowAlias
+
+	return &execution, nil
+}
+
+// UpdateAuthenticationExecutionRequirement sets the requirement of an execution within the flow. This is the only
+// property of an existing execution that can be changed, apart from its position.
+func (keycloakClient *KeycloakClient) UpdateAuthenticationExecutionRequirement(realmId, parentFlowAlias, id, requirement string) error {
+	// the rest of the execution is sent back as it was, so newer versions of keycloak don't reset its priority
+	execution, _, err := keycloakClient.getAuthenticationExecutionInfo(realmId, parentFlowAlias, func(execution *AuthenticationExecutionInfo) bool {
+		return execution.Id == id
+	})
+	if err != nil {
+		return err
+	}
+
+	execution.Requirement = requirement
+
+	return keycloakClient.put(authenticationFlowExecutionsPath(realmId, parentFlowAlias), execution)
+}
+
+// GetAuthenticationExecutionIndex returns the position of an execution within its parent flow
+func (keycloakClient *KeycloakClient) GetAuthenticationExecutionIndex(realmId, parentFlowAlias, id string) (int, error) {
+	execution, _, err := keycloakClient.getAuthenticationExecutionInfo(realmId, parentFlowAlias, func(execution *AuthenticationExecutionInfo) bool {
+		return execution.Id == id
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return execution.Index, nil
+}
+
+// LockAuthenticationFlow blocks until no other caller holds the lock for the flow, and returns a function that releases
+// it. Moving an execution takes several requests, each of which depends on the positions of the other executions in the
+// flow, so moves within the same flow are made one at a time.
+func (keycloakClient *KeycloakClient) LockAuthenticationFlow(realmId, flowAlias string) func() {
+	mutex, _ := keycloakClient.flowMutexes.LoadOrStore(realmId+"/"+flowAlias, &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock()
+
+	return mutex.(*sync.Mutex).Unlock
+}
+
+// MoveAuthenticationExecution moves an execution to `index` within its parent flow. Keycloak can only move an execution
+// up or down by one position at a time, so this raises or lowers its priority until it's in place.
+func (keycloakClient *KeycloakClient) MoveAuthenticationExecution(realmId, parentFlowAlias, id string, index int) error {
+	for moves := 0; ; moves++ {
+		execution, siblings, err := keycloakClient.getAuthenticationExecutionInfo(realmId, parentFlowAlias, func(execution *AuthenticationExecutionInfo) bool {
+			return execution.Id == id
+		})
+		if err != nil {
+			return err
+		}
+
+		if index >= siblings {
+			return fmt.Errorf("execution %s cannot be moved to position %d within authentication flow %s, which only has %d executions", id, index, parentFlowAlias, siblings)
+		}
+
+		var operation string
+		if execution.Index > index {
+			operation = "raise-priority"
+		} else if execution.Index < index {
+			operation = "lower-priority"
+		} else {
+			return nil
+		}
+
+		// an execution never needs to move past every other execution, so it's stuck if it has
+		if moves >= siblings {
+			return fmt.Errorf("execution %s could not be moved to position %d within authentication flow %s", id, index, parentFlowAlias)
+		}
+
+		_, _, err = keycloakClient.post(fmt.Sprintf("/realms/%s/authentication/executions/%s/%s", realmId, id, operation), nil)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (keycloakClient *KeycloakClient) DeleteAuthenticationExecution(realmId, id string) error {
+	return keycloakClient.delete(fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), nil)
+}
@@ -0,0 +1,49 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+// the configuration of an authenticator within a flow, such as the role checked by a conditional execution
+type AuthenticationExecutionConfig struct {
+	RealmId     string            `json:"-"`
+	ExecutionId string            `json:"-"`
+	Id          string            `json:"id,omitempty"`
+	Alias       string            `json:"alias"`
+	Config      map[string]string `json:"config"`
+}
+
+func (keycloakClient *KeycloakClient) NewAuthenticationExecutionConfig(config *AuthenticationExecutionConfig) error {
+	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/authentication/executions/%s/config", config.RealmId, config.ExecutionId), config)
+	if err != nil {
+		return err
+	}
+
+	config.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+// GetAuthenticationExecutionConfig returns the config with the given ID. Keycloak doesn't say which execution a config
+// belongs to, so the execution ID is only passed through.
+func (keycloakClient *KeycloakClient) GetAuthenticationExecutionConfig(realmId, executionId, id string) (*AuthenticationExecutionConfig, error) {
+	var config AuthenticationExecutionConfig
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/config/%s", realmId, id), &config, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	config.RealmId = realmId
+	config.ExecutionId = executionId
+
+	return &config, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateAuthenticationExecutionConfig(config *AuthenticationExecutionConfig) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/authentication/config/%s", config.RealmId, config.Id), config)
+}
+
+func (keycloakClient *KeycloakClient) DeleteAuthenticationExecutionConfig(realmId, id string) error {
+	return keycloakClient.delete(fmt.Sprintf("/realms/%s/authentication/config/%s", realmId, id), nil)
+}
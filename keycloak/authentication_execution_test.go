@@ -0,0 +1,124 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// serves the executions of a flow named "flow", which can be reordered with the raise-priority and lower-priority
+// endpoints. the subflow's own executions are included at level 1, like keycloak does
+func newAuthenticationExecutionsHandler(t *testing.T, order *[]string, moves *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/authentication/flows/flow/executions":
+			var executions []*AuthenticationExecutionInfo
+			for i, id := range *order {
+				executions = append(executions, &AuthenticationExecutionInfo{Id: id, Index: i})
+
+				if id == "subflow" {
+					executions = append(executions, &AuthenticationExecutionInfo{Id: "nested", Level: 1, Index: 0})
+				}
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(executions)
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/auth/admin/realms/test/authentication/executions/"):
+			parts := strings.Split(r.URL.Path, "/")
+			id, operation := parts[len(parts)-2], parts[len(parts)-1]
+
+			for i := range *order {
+				if (*order)[i] != id {
+					continue
+				}
+
+				other := i - 1
+				if operation == "lower-priority" {
+					other = i + 1
+				}
+
+				if other >= 0 && other < len(*order) {
+					(*order)[i], (*order)[other] = (*order)[other], (*order)[i]
+				}
+
+				break
+			}
+
+			*moves++
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
+
+func TestKeycloakClient_moveAuthenticationExecution(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		index         int
+		expectedOrder []string
+		expectedMoves int
+	}{
+		{name: "raise", id: "three", index: 0, expectedOrder: []string{"three", "one", "subflow", "two"}, expectedMoves: 3},
+		{name: "lower", id: "one", index: 2, expectedOrder: []string{"subflow", "two", "one", "three"}, expectedMoves: 2},
+		{name: "already in place", id: "two", index: 2, expectedOrder: []string{"one", "subflow", "two", "three"}, expectedMoves: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			order := []string{"one", "subflow", "two", "three"}
+			moves := 0
+
+			keycloakClient, server := newTestKeycloakClient(t, newAuthenticationExecutionsHandler(t, &order, &moves))
+			defer server.Close()
+
+			err := keycloakClient.MoveAuthenticationExecution("test", "flow", test.id, test.index)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(order, test.expectedOrder) {
+				t.Fatalf("expected executions to be ordered %v, got %v", test.expectedOrder, order)
+			}
+
+			if moves != test.expectedMoves {
+				t.Fatalf("expected %d moves, got %d", test.expectedMoves, moves)
+			}
+		})
+	}
+}
+
+func TestKeycloakClient_moveAuthenticationExecutionPastTheEnd(t *testing.T) {
+	order := []string{"one", "subflow", "two", "three"}
+	moves := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, newAuthenticationExecutionsHandler(t, &order, &moves))
+	defer server.Close()
+
+	err := keycloakClient.MoveAuthenticationExecution("test", "flow", "one", 4)
+	if err == nil || !strings.Contains(err.Error(), "only has 4 executions") {
+		t.Fatalf("expected an error for an index past the end of the flow, got %v", err)
+	}
+
+	if moves != 0 {
+		t.Fatalf("expected no moves, got %d", moves)
+	}
+}
+
+func TestKeycloakClient_moveAuthenticationExecutionNotFound(t *testing.T) {
+	order := []string{"one", "two"}
+	moves := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, newAuthenticationExecutionsHandler(t, &order, &moves))
+	defer server.Close()
+
+	// only executions directly within the flow can be moved
+	err := keycloakClient.MoveAuthenticationExecution("test", "flow", "nested", 0)
+	if err == nil {
+		t.Fatal("expected an error when the execution isn't directly within the flow")
+	}
+}
@@ -0,0 +1,114 @@
+package keycloak
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type AuthenticationFlow struct {
+	Id          string `json:"id,omitempty"`
+	RealmId     string `json:"-"`
+	Alias       string `json:"alias"`
+	Description string `json:"description"`
+	ProviderId  string `json:"providerId"` // "basic-flow" or "client-flow"
+	TopLevel    bool   `json:"topLevel"`
+	BuiltIn     bool   `json:"builtIn"`
+}
+
+func (keycloakClient *KeycloakClient) NewAuthenticationFlow(authenticationFlow *AuthenticationFlow) error {
+	authenticationFlow.TopLevel = true
+	authenticationFlow.BuiltIn = false
+
+	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/authentication/flows", authenticationFlow.RealmId), authenticationFlow)
+	if err != nil {
+		return err
+	}
+
+	authenticationFlow.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+// CopyAuthenticationFlow copies the top level flow with the alias `fromAlias`, including its executions and subflows,
+// to a new flow with the alias of `authenticationFlow`. keycloak doesn't respond with the new flow, so it's looked up by
+// its alias afterwards
+func (keycloakClient *KeycloakClient) CopyAuthenticationFlow(authenticationFlow *AuthenticationFlow, fromAlias string) error {
+	body := map[string]string{
+		"newName":     authenticationFlow.Alias,
+		"description": authenticationFlow.Description,
+	}
+
+	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/authentication/flows/%s/copy", authenticationFlow.RealmId, url.PathEscape(fromAlias)), body)
+	if err != nil {
+		return err
+	}
+
+	copiedFlow, err := keycloakClient.GetAuthenticationFlowFromAlias(authenticationFlow.RealmId, authenticationFlow.Alias)
+	if err != nil {
+		return err
+	}
+
+	*authenticationFlow = *copiedFlow
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetAuthenticationFlows(realmId string) ([]*AuthenticationFlow, error) {
+	var authenticationFlows []*AuthenticationFlow
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows", realmId), &authenticationFlows, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, authenticationFlow := range authenticationFlows {
+		authenticationFlow.RealmId = realmId
+	}
+
+	return authenticationFlows, nil
+}
+
+// GetAuthenticationFlowFromAlias returns the top level flow with the given alias. keycloak can't look up flows by their
+// alias, so every flow in the realm is fetched
+func (keycloakClient *KeycloakClient) GetAuthenticationFlowFromAlias(realmId, alias string) (*AuthenticationFlow, error) {
+	authenticationFlows, err := keycloakClient.GetAuthenticationFlows(realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, authenticationFlow := range authenticationFlows {
+		if authenticationFlow.Alias == alias {
+			return authenticationFlow, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("authentication flow with alias %q not found in realm %q", alias, realmId),
+	}
+}
+
+func (keycloakClient *KeycloakClient) GetAuthenticationFlow(realmId, id string) (*AuthenticationFlow, error) {
+	var authenticationFlow AuthenticationFlow
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), &authenticationFlow, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticationFlow.RealmId = realmId
+
+	return &authenticationFlow, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateAuthenticationFlow(authenticationFlow *AuthenticationFlow) error {
+	authenticationFlow.TopLevel = true
+	authenticationFlow.BuiltIn = false
+
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/authentication/flows/%s", authenticationFlow.RealmId, authenticationFlow.Id), authenticationFlow)
+}
+
+func (keycloakClient *KeycloakClient) DeleteAuthenticationFlow(realmId, id string) error {
+	return keycloakClient.delete(fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), nil)
+}
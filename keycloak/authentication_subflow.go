@@ -0,0 +1,101 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+// a flow that is nested within another flow. keycloak manages these through the executions of the parent flow, so
+// ExecutionId is the ID of the execution that runs this flow
+type AuthenticationSubFlow struct {
+	Id              string
+	ExecutionId     string
+	RealmId         string
+	ParentFlowAlias string
+	Alias           string
+	Description     string
+	ProviderId      string // "basic-flow" or "form-flow"
+	Authenticator   string // the form authenticator for a "form-flow", ex: "registration-page-form"
+	Requirement     string
+}
+
+func (keycloakClient *KeycloakClient) NewAuthenticationSubFlow(subFlow *AuthenticationSubFlow) error {
+	_, location, err := keycloakClient.post(authenticationFlowExecutionsPath(subFlow.RealmId, subFlow.ParentFlowAlias)+"/flow", map[string]string{
+		"alias":       subFlow.Alias,
+		"description": subFlow.Description,
+		"type":        subFlow.ProviderId,
+		"provider":    subFlow.Authenticator,
+	})
+	if err != nil {
+		return err
+	}
+
+	subFlow.Id = getIdFromLocationHeader(location)
+
+	execution, err := keycloakClient.getAuthenticationSubFlowExecution(subFlow.RealmId, subFlow.ParentFlowAlias, subFlow.Id)
+	if err != nil {
+		return err
+	}
+
+	subFlow.ExecutionId = execution.Id
+
+	// new subflows are always disabled
+	return keycloakClient.UpdateAuthenticationExecutionRequirement(subFlow.RealmId, subFlow.ParentFlowAlias, subFlow.ExecutionId, subFlow.Requirement)
+}
+
+func (keycloakClient *KeycloakClient) getAuthenticationSubFlowExecution(realmId, parentFlowAlias, id string) (*AuthenticationExecutionInfo, error) {
+	execution, _, err := keycloakClient.getAuthenticationExecutionInfo(realmId, parentFlowAlias, func(execution *AuthenticationExecutionInfo) bool {
+		return execution.AuthenticationFlow && execution.FlowId == id
+	})
+
+	return execution, err
+}
+
+func (keycloakClient *KeycloakClient) GetAuthenticationSubFlow(realmId, parentFlowAlias, id string) (*AuthenticationSubFlow, error) {
+	flow, err := keycloakClient.GetAuthenticationFlow(realmId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	execution, err := keycloakClient.getAuthenticationSubFlowExecution(realmId, parentFlowAlias, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthenticationSubFlow{
+		Id:              flow.Id,
+		ExecutionId:     execution.Id,
+		RealmId:         realmId,
+		ParentFlowAlias: parentFlowAlias,
+		Alias:           flow.Alias,
+		Description:     flow.Description,
+		ProviderId:      flow.ProviderId,
+		Authenticator:   execution.ProviderId,
+		Requirement:     execution.Requirement,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateAuthenticationSubFlow(subFlow *AuthenticationSubFlow) error {
+	err := keycloakClient.put(fmt.Sprintf("/realms/%s/authentication/flows/%s", subFlow.RealmId, subFlow.Id), &AuthenticationFlow{
+		Id:          subFlow.Id,
+		Alias:       subFlow.Alias,
+		Description: subFlow.Description,
+		ProviderId:  subFlow.ProviderId,
+		TopLevel:    false,
+		BuiltIn:     false,
+	})
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.UpdateAuthenticationExecutionRequirement(subFlow.RealmId, subFlow.ParentFlowAlias, subFlow.ExecutionId, subFlow.Requirement)
+}
+
+// DeleteAuthenticationSubFlow removes the subflow from its parent flow, which deletes the subflow as well
+func (keycloakClient *KeycloakClient) DeleteAuthenticationSubFlow(realmId, parentFlowAlias, id string) error {
+	execution, err := keycloakClient.getAuthenticationSubFlowExecution(realmId, parentFlowAlias, id)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.DeleteAuthenticationExecution(realmId, execution.Id)
+}
@@ -0,0 +1,40 @@
+package keycloak
+
+import "fmt"
+
+// client registration policies are components that are children of the realm. their sub type decides whether the policy
+// applies to anonymous registration requests, or to requests that are authenticated with a token
+var clientRegistrationPolicyProviderType = "org.keycloak.services.clientregistration.policy.ClientRegistrationPolicy"
+
+func (keycloakClient *KeycloakClient) newClientRegistrationPolicy(realmId string, component *component) (string, error) {
+	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/components", realmId), component)
+	if err != nil {
+		return "", err
+	}
+
+	return getIdFromLocationHeader(location), nil
+}
+
+func (keycloakClient *KeycloakClient) getClientRegistrationPolicy(realmId, id, providerId string) (*component, error) {
+	var component *component
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the components endpoint returns any kind of component, so make sure this is the kind of policy that was asked for
+	if component.ProviderType != clientRegistrationPolicyProviderType || component.ProviderId != providerId {
+		return nil, fmt.Errorf("component with id %s in realm %s is not a client registration policy of type %s", id, realmId, providerId)
+	}
+
+	return component, nil
+}
+
+func (keycloakClient *KeycloakClient) updateClientRegistrationPolicy(realmId string, component *component) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/components/%s", realmId, component.Id), component)
+}
+
+func (keycloakClient *KeycloakClient) deleteClientRegistrationPolicy(realmId, id string) error {
+	return keycloakClient.delete(fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
@@ -0,0 +1,68 @@
+package keycloak
+
+type ClientRegistrationPolicyAllowedProtocolMappers struct {
+	Id      string
+	Name    string
+	RealmId string
+	SubType string
+
+	AllowedProtocolMapperTypes []string
+}
+
+func convertFromClientRegistrationPolicyAllowedProtocolMappersToComponent(policy *ClientRegistrationPolicyAllowedProtocolMappers) *component {
+	allowedProtocolMapperTypes := policy.AllowedProtocolMapperTypes
+	if allowedProtocolMapperTypes == nil {
+		allowedProtocolMapperTypes = []string{}
+	}
+
+	return &component{
+		Id:           policy.Id,
+		Name:         policy.Name,
+		ProviderId:   "allowed-protocol-mappers",
+		ProviderType: clientRegistrationPolicyProviderType,
+		ParentId:     policy.RealmId,
+		SubType:      policy.SubType,
+		Config: map[string][]string{
+			"allowed-protocol-mapper-types": allowedProtocolMapperTypes,
+		},
+	}
+}
+
+func convertFromComponentToClientRegistrationPolicyAllowedProtocolMappers(component *component, realmId string) *ClientRegistrationPolicyAllowedProtocolMappers {
+	return &ClientRegistrationPolicyAllowedProtocolMappers{
+		Id:      component.Id,
+		Name:    component.Name,
+		RealmId: realmId,
+		SubType: component.SubType,
+
+		AllowedProtocolMapperTypes: component.Config["allowed-protocol-mapper-types"],
+	}
+}
+
+func (keycloakClient *KeycloakClient) NewClientRegistrationPolicyAllowedProtocolMappers(policy *ClientRegistrationPolicyAllowedProtocolMappers) error {
+	id, err := keycloakClient.newClientRegistrationPolicy(policy.RealmId, convertFromClientRegistrationPolicyAllowedProtocolMappersToComponent(policy))
+	if err != nil {
+		return err
+	}
+
+	policy.Id = id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetClientRegistrationPolicyAllowedProtocolMappers(realmId, id string) (*ClientRegistrationPolicyAllowedProtocolMappers, error) {
+	component, err := keycloakClient.getClientRegistrationPolicy(realmId, id, "allowed-protocol-mappers")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToClientRegistrationPolicyAllowedProtocolMappers(component, realmId), nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateClientRegistrationPolicyAllowedProtocolMappers(policy *ClientRegistrationPolicyAllowedProtocolMappers) error {
+	return keycloakClient.updateClientRegistrationPolicy(policy.RealmId, convertFromClientRegistrationPolicyAllowedProtocolMappersToComponent(policy))
+}
+
+func (keycloakClient *KeycloakClient) DeleteClientRegistrationPolicyAllowedProtocolMappers(realmId, id string) error {
+	return keycloakClient.deleteClientRegistrationPolicy(realmId, id)
+}
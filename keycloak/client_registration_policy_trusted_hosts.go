@@ -0,0 +1,90 @@
+package keycloak
+
+import "strconv"
+
+type ClientRegistrationPolicyTrustedHosts struct {
+	Id      string
+	Name    string
+	RealmId string
+	SubType string
+
+	TrustedHosts                            []string
+	HostSendingRegistrationRequestMustMatch bool
+	ClientUrisMustMatch                     bool
+}
+
+func convertFromClientRegistrationPolicyTrustedHostsToComponent(policy *ClientRegistrationPolicyTrustedHosts) *component {
+	trustedHosts := policy.TrustedHosts
+	if trustedHosts == nil {
+		trustedHosts = []string{}
+	}
+
+	return &component{
+		Id:           policy.Id,
+		Name:         policy.Name,
+		ProviderId:   "trusted-hosts",
+		ProviderType: clientRegistrationPolicyProviderType,
+		ParentId:     policy.RealmId,
+		SubType:      policy.SubType,
+		Config: map[string][]string{
+			"trusted-hosts": trustedHosts,
+			"host-sending-registration-request-must-match": {
+				strconv.FormatBool(policy.HostSendingRegistrationRequestMustMatch),
+			},
+			"client-uris-must-match": {
+				strconv.FormatBool(policy.ClientUrisMustMatch),
+			},
+		},
+	}
+}
+
+func convertFromComponentToClientRegistrationPolicyTrustedHosts(component *component, realmId string) (*ClientRegistrationPolicyTrustedHosts, error) {
+	hostSendingRegistrationRequestMustMatch, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("host-sending-registration-request-must-match"))
+	if err != nil {
+		return nil, err
+	}
+
+	clientUrisMustMatch, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("client-uris-must-match"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientRegistrationPolicyTrustedHosts{
+		Id:      component.Id,
+		Name:    component.Name,
+		RealmId: realmId,
+		SubType: component.SubType,
+
+		TrustedHosts:                            component.Config["trusted-hosts"],
+		HostSendingRegistrationRequestMustMatch: hostSendingRegistrationRequestMustMatch,
+		ClientUrisMustMatch:                     clientUrisMustMatch,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) NewClientRegistrationPolicyTrustedHosts(policy *ClientRegistrationPolicyTrustedHosts) error {
+	id, err := keycloakClient.newClientRegistrationPolicy(policy.RealmId, convertFromClientRegistrationPolicyTrustedHostsToComponent(policy))
+	if err != nil {
+		return err
+	}
+
+	policy.Id = id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetClientRegistrationPolicyTrustedHosts(realmId, id string) (*ClientRegistrationPolicyTrustedHosts, error) {
+	component, err := keycloakClient.getClientRegistrationPolicy(realmId, id, "trusted-hosts")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToClientRegistrationPolicyTrustedHosts(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateClientRegistrationPolicyTrustedHosts(policy *ClientRegistrationPolicyTrustedHosts) error {
+	return keycloakClient.updateClientRegistrationPolicy(policy.RealmId, convertFromClientRegistrationPolicyTrustedHostsToComponent(policy))
+}
+
+func (keycloakClient *KeycloakClient) DeleteClientRegistrationPolicyTrustedHosts(realmId, id string) error {
+	return keycloakClient.deleteClientRegistrationPolicy(realmId, id)
+}
@@ -0,0 +1,152 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+// Keycloak 13 replaced the realm's `defaultRoles` array of role names with a `default-roles-<realm>` composite role.
+// Only the fields needed to manage default roles are used here, so the rest of the realm is left untouched.
+type realmDefaultRoles struct {
+	DefaultRole  *Role    `json:"defaultRole,omitempty"`
+	DefaultRoles []string `json:"defaultRoles"`
+}
+
+func (keycloakClient *KeycloakClient) defaultRolesAreComposite() (bool, error) {
+	majorVersion, err := keycloakClient.ServerMajorVersion()
+	if err != nil {
+		return false, err
+	}
+
+	return majorVersion >= 13, nil
+}
+
+func (keycloakClient *KeycloakClient) getDefaultRole(realmId string) (*Role, error) {
+	var realm realmDefaultRoles
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s", realmId), &realm, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if realm.DefaultRole == nil {
+		return nil, fmt.Errorf("realm %s does not have a default role", realmId)
+	}
+
+	realm.DefaultRole.RealmId = realmId
+
+	return realm.DefaultRole, nil
+}
+
+// returns the names of the realm roles that are assigned to every new user. for keycloak 13 and newer, client roles
+// that are part of the default role are not included
+func (keycloakClient *KeycloakClient) GetDefaultRoles(realmId string) ([]string, error) {
+	composite, err := keycloakClient.defaultRolesAreComposite()
+	if err != nil {
+		return nil, err
+	}
+
+	if !composite {
+		var realm realmDefaultRoles
+
+		err := keycloakClient.get(fmt.Sprintf("/realms/%s", realmId), &realm, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return realm.DefaultRoles, nil
+	}
+
+	defaultRole, err := keycloakClient.getDefaultRole(realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	composites, err := keycloakClient.GetRoleComposites(defaultRole)
+	if err != nil {
+		return nil, err
+	}
+
+	var roleNames []string
+	for _, composite := range composites {
+		if !composite.ClientRole {
+			roleNames = append(roleNames, composite.Name)
+		}
+	}
+
+	return roleNames, nil
+}
+
+// replaces the realm roles that are assigned to every new user with `roleNames`
+func (keycloakClient *KeycloakClient) UpdateDefaultRoles(realmId string, roleNames []string) error {
+	composite, err := keycloakClient.defaultRolesAreComposite()
+	if err != nil {
+		return err
+	}
+
+	if !composite {
+		// the realm endpoint ignores fields that aren't specified, so this only updates the default roles
+		realm := realmDefaultRoles{
+			DefaultRoles: append([]string{}, roleNames...),
+		}
+
+		return keycloakClient.put(fmt.Sprintf("/realms/%s", realmId), realm)
+	}
+
+	defaultRole, err := keycloakClient.getDefaultRole(realmId)
+	if err != nil {
+		return err
+	}
+
+	composites, err := keycloakClient.GetRoleComposites(defaultRole)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool)
+	for _, roleName := range roleNames {
+		wanted[roleName] = true
+	}
+
+	var rolesToRemove []*Role
+	for _, composite := range composites {
+		if composite.ClientRole {
+			continue
+		}
+
+		if wanted[composite.Name] {
+			delete(wanted, composite.Name)
+		} else {
+			rolesToRemove = append(rolesToRemove, composite)
+		}
+	}
+
+	var rolesToAdd []*Role
+	for _, roleName := range roleNames {
+		if !wanted[roleName] {
+			continue
+		}
+
+		role, err := keycloakClient.GetRoleByName(realmId, "", roleName)
+		if err != nil {
+			return err
+		}
+
+		rolesToAdd = append(rolesToAdd, role)
+	}
+
+	if len(rolesToAdd) != 0 {
+		err = keycloakClient.AddCompositesToRole(defaultRole, rolesToAdd)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(rolesToRemove) != 0 {
+		err = keycloakClient.RemoveCompositesFromRole(defaultRole, rolesToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package keycloak
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/hashicorp/errwrap"
 	"net/http"
 )
@@ -8,14 +11,62 @@ import (
 type ApiError struct {
 	Code    int
 	Message string
+	Err     error // the error described by keycloak's response body, if there was one
 }
 
 func (e *ApiError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s", e.Message, e.Err)
+	}
+
 	return e.Message
 }
 
+func (e *ApiError) Unwrap() error {
+	return e.Err
+}
+
+// AsApiError finds the ApiError that caused err, which may have been wrapped with either errwrap or fmt.Errorf's %w
+func AsApiError(err error) (*ApiError, bool) {
+	var apiError *ApiError
+	if errors.As(err, &apiError) {
+		return apiError, true
+	}
+
+	apiError, ok := errwrap.GetType(err, &ApiError{}).(*ApiError)
+
+	return apiError, ok && apiError != nil
+}
+
 func ErrorIs404(err error) bool {
-	keycloakError, ok := errwrap.GetType(err, &ApiError{}).(*ApiError)
+	apiError, ok := AsApiError(err)
+
+	return ok && apiError.Code == http.StatusNotFound
+}
+
+// keycloak describes most errors with either `errorMessage` or the OAuth `error` and `error_description` fields
+func getErrorFromResponseBody(body []byte) error {
+	var response struct {
+		ErrorMessage     string `json:"errorMessage"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil
+	}
+
+	if response.ErrorMessage != "" {
+		return errors.New(response.ErrorMessage)
+	}
+
+	if response.ErrorDescription != "" {
+		return errors.New(response.ErrorDescription)
+	}
+
+	if response.Error != "" {
+		return errors.New(response.Error)
+	}
 
-	return ok && keycloakError != nil && keycloakError.Code == http.StatusNotFound
+	return nil
 }
@@ -1,6 +1,9 @@
 package keycloak
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
+)
 
 type GenericClient struct {
 	Id       string `json:"id,omitempty"`
@@ -13,10 +16,10 @@ type GenericClient struct {
 	Description string `json:"description"`
 }
 
-func (keycloakClient *KeycloakClient) listGenericClients(realmId string) ([]*GenericClient, error) {
+func (keycloakClient *KeycloakClient) ListGenericClients(realmId string) ([]*GenericClient, error) {
 	var clients []*GenericClient
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients", realmId), &clients, nil)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/clients", realmId), &clients, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -35,13 +38,13 @@ func (keycloakClient *KeycloakClient) GetGenericClientByClientId(realmId, client
 		"clientId": clientId,
 	}
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients", realmId), &clients, params)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/clients", realmId), &clients, params)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(clients) == 0 {
-		return nil, fmt.Errorf("generic client with name %s does not exist", clientId)
+		return nil, NewClientNotFoundError(realmId, clientId)
 	}
 
 	client := clients[0]
@@ -50,3 +53,12 @@ func (keycloakClient *KeycloakClient) GetGenericClientByClientId(realmId, client
 
 	return &client, nil
 }
+
+// listing clients by `clientId` doesn't 404 when nothing matches, so this error says which client was missing. It's
+// still a 404, so ErrorIs404 works with it.
+func NewClientNotFoundError(realmId, clientId string) error {
+	return &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("client %q not found in realm %q", clientId, realmId),
+	}
+}
@@ -0,0 +1,18 @@
+package keycloak
+
+import (
+	"testing"
+)
+
+func TestNewClientNotFoundError(t *testing.T) {
+	err := NewClientNotFoundError("bar", "foo")
+
+	if !ErrorIs404(err) {
+		t.Errorf("expected a 404 error, got %s", err)
+	}
+
+	expected := `client "foo" not found in realm "bar"`
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+}
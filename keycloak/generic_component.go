@@ -0,0 +1,77 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+// a component of any type, for SPIs that don't have their own resource yet. unlike the other components, the config is
+// passed through as is
+type GenericComponent struct {
+	Id           string
+	Name         string
+	RealmId      string
+	ParentId     string
+	ProviderId   string
+	ProviderType string
+
+	Config map[string][]string
+}
+
+func convertFromGenericComponentToComponent(genericComponent *GenericComponent) *component {
+	config := genericComponent.Config
+	if config == nil {
+		config = map[string][]string{}
+	}
+
+	return &component{
+		Id:           genericComponent.Id,
+		Name:         genericComponent.Name,
+		ProviderId:   genericComponent.ProviderId,
+		ProviderType: genericComponent.ProviderType,
+		ParentId:     genericComponent.ParentId,
+		Config:       config,
+	}
+}
+
+func convertFromComponentToGenericComponent(component *component, realmId string) *GenericComponent {
+	return &GenericComponent{
+		Id:           component.Id,
+		Name:         component.Name,
+		RealmId:      realmId,
+		ParentId:     component.ParentId,
+		ProviderId:   component.ProviderId,
+		ProviderType: component.ProviderType,
+
+		Config: component.Config,
+	}
+}
+
+func (keycloakClient *KeycloakClient) NewGenericComponent(genericComponent *GenericComponent) error {
+	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/components", genericComponent.RealmId), convertFromGenericComponentToComponent(genericComponent))
+	if err != nil {
+		return err
+	}
+
+	genericComponent.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetGenericComponent(realmId, id string) (*GenericComponent, error) {
+	var component *component
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToGenericComponent(component, realmId), nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateGenericComponent(genericComponent *GenericComponent) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/components/%s", genericComponent.RealmId, genericComponent.Id), convertFromGenericComponentToComponent(genericComponent))
+}
+
+func (keycloakClient *KeycloakClient) DeleteGenericComponent(realmId, id string) error {
+	return keycloakClient.delete(fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
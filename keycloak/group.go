@@ -2,6 +2,7 @@ package keycloak
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -94,7 +95,7 @@ func (keycloakClient *KeycloakClient) NewGroup(group *Group) error {
 func (keycloakClient *KeycloakClient) GetGroups(realmId string) ([]*Group, error) {
 	var groups []*Group
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/groups", realmId), &groups, nil)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/groups", realmId), &groups, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +135,7 @@ func (keycloakClient *KeycloakClient) GetGroupByName(realmId, name string) (*Gro
 		"search": name,
 	}
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/groups", realmId), &groups, params)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/groups", realmId), &groups, params)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +164,36 @@ func (keycloakClient *KeycloakClient) GetGroupByName(realmId, name string) (*Gro
 	return nil, fmt.Errorf("no group with name " + name + " found")
 }
 
+// looks up a group by its full path, such as `/parent/child`. a leading slash is optional
+func (keycloakClient *KeycloakClient) GetGroupByPath(realmId, path string) (*Group, error) {
+	var group Group
+
+	var escapedParts []string
+	for _, part := range strings.Split(strings.Trim(path, "/"), "/") {
+		escapedParts = append(escapedParts, url.PathEscape(part))
+	}
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/group-by-path/%s", realmId, strings.Join(escapedParts, "/")), &group, nil)
+	if err != nil {
+		if ErrorIs404(err) {
+			return nil, fmt.Errorf("no group with path %s found in realm %s", path, realmId)
+		}
+
+		return nil, err
+	}
+
+	group.RealmId = realmId // it's important to set RealmId here because fetching the ParentId depends on it
+
+	parentId, err := keycloakClient.groupParentId(&group)
+	if err != nil {
+		return nil, err
+	}
+
+	group.ParentId = parentId
+
+	return &group, nil
+}
+
 func (keycloakClient *KeycloakClient) UpdateGroup(group *Group) error {
 	return keycloakClient.put(fmt.Sprintf("/realms/%s/groups/%s", group.RealmId, group.Id), group)
 }
@@ -178,7 +209,7 @@ func (keycloakClient *KeycloakClient) ListGroupsWithName(realmId, name string) (
 		"search": name,
 	}
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/groups", realmId), &groups, params)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/groups", realmId), &groups, params)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +220,8 @@ func (keycloakClient *KeycloakClient) ListGroupsWithName(realmId, name string) (
 func (keycloakClient *KeycloakClient) GetGroupMembers(realmId, groupId string) ([]*User, error) {
 	var users []*User
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/groups/%s/members?max=-1", realmId, groupId), &users, nil)
+	// groups can have thousands of members, so they're fetched a page at a time
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/groups/%s/members", realmId, groupId), &users, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -228,6 +260,21 @@ func (keycloakClient *KeycloakClient) GetDefaultGroups(realmName string) ([]Grou
 	return defaultGroups, err
 }
 
+// GetGroupRoleMappings returns the realm and client roles that are directly assigned to a group.
+// Client role mappings are keyed by the client's clientId, not its internal ID.
+func (keycloakClient *KeycloakClient) GetGroupRoleMappings(realmId, groupId string) (*RoleMapping, error) {
+	var roleMapping RoleMapping
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/groups/%s/role-mappings", realmId, groupId), &roleMapping, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	roleMapping.setRealmAndClientIds(realmId)
+
+	return &roleMapping, nil
+}
+
 func (keycloakClient *KeycloakClient) AddRealmRolesToGroup(realmId, groupId string, roles []*Role) error {
 	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/groups/%s/role-mappings/realm", realmId, groupId), roles)
 
@@ -0,0 +1,91 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestKeycloakClient_getGroupMembersPagesThroughLargeGroups(t *testing.T) {
+	total := 2*pageSize + 50
+	requests := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.URL.Path != "/auth/admin/realms/my-realm/groups/my-group/members" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+
+		first, err := strconv.Atoi(r.URL.Query().Get("first"))
+		if err != nil {
+			t.Errorf("expected a valid first parameter, got %s", r.URL.Query().Get("first"))
+		}
+
+		max, err := strconv.Atoi(r.URL.Query().Get("max"))
+		if err != nil || max != pageSize {
+			t.Errorf("expected max to be %d, got %s", pageSize, r.URL.Query().Get("max"))
+		}
+
+		users := []*User{}
+		for i := first; i < first+max && i < total; i++ {
+			users = append(users, &User{Id: strconv.Itoa(i), Username: fmt.Sprintf("user-%d", i)})
+		}
+
+		body, _ := json.Marshal(users)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})
+	defer server.Close()
+
+	users, err := keycloakClient.GetGroupMembers("my-realm", "my-group")
+	if err != nil {
+		t.Fatalf("expected group members to be listed, got %s", err)
+	}
+
+	if len(users) != total {
+		t.Fatalf("expected %d users, got %d", total, len(users))
+	}
+
+	for _, user := range users {
+		if user.RealmId != "my-realm" {
+			t.Fatalf("expected realm id to be set on every user, got %q", user.RealmId)
+		}
+	}
+
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestKeycloakClient_getGroupRoleMappingsKeepsRoleIds(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/auth/admin/realms/my-realm/groups/my-group/role-mappings" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"realmMappings": [{"id": "realm-role-id", "name": "realm-role"}],
+			"clientMappings": {"my-client": {"id": "client-uuid", "client": "my-client", "mappings": [{"id": "client-role-id", "name": "client-role", "clientRole": true}]}}
+		}`))
+	})
+	defer server.Close()
+
+	roleMapping, err := keycloakClient.GetGroupRoleMappings("my-realm", "my-group")
+	if err != nil {
+		t.Fatalf("expected role mappings to be read, got %s", err)
+	}
+
+	if len(roleMapping.RealmMappings) != 1 || roleMapping.RealmMappings[0].Id != "realm-role-id" || roleMapping.RealmMappings[0].RealmId != "my-realm" {
+		t.Fatalf("expected the realm role with its id, got %v", roleMapping.RealmMappings)
+	}
+
+	clientRoles := roleMapping.ClientMappings["my-client"].Mappings
+	if len(clientRoles) != 1 || clientRoles[0].Id != "client-role-id" || clientRoles[0].ClientId != "client-uuid" {
+		t.Fatalf("expected the client role with its id and the client's internal id, got %v", clientRoles)
+	}
+}
@@ -113,8 +113,41 @@ func (f *IdentityProviderConfig) MarshalJSON() ([]byte, error) {
 	return json.Marshal(out)
 }
 
+// keycloak responds with a 400 that doesn't say what's wrong when the broker login flows don't exist, so they are
+// checked beforehand
+func (keycloakClient *KeycloakClient) ValidateIdentityProvider(identityProvider *IdentityProvider) error {
+	var flowAliases []string
+	for _, flowAlias := range []string{identityProvider.FirstBrokerLoginFlowAlias, identityProvider.PostBrokerLoginFlowAlias} {
+		if flowAlias != "" {
+			flowAliases = append(flowAliases, flowAlias)
+		}
+	}
+
+	if len(flowAliases) == 0 {
+		return nil
+	}
+
+	authenticationFlows, err := keycloakClient.GetAuthenticationFlows(identityProvider.Realm)
+	if err != nil {
+		return err
+	}
+
+	existingAliases := make(map[string]bool)
+	for _, authenticationFlow := range authenticationFlows {
+		existingAliases[authenticationFlow.Alias] = true
+	}
+
+	for _, flowAlias := range flowAliases {
+		if !existingAliases[flowAlias] {
+			return fmt.Errorf("validation error: authentication flow with alias %s does not exist in realm %s", flowAlias, identityProvider.Realm)
+		}
+	}
+
+	return nil
+}
+
 func (keycloakClient *KeycloakClient) NewIdentityProvider(identityProvider *IdentityProvider) error {
-	log.Printf("[WARN] Realm: %s", identityProvider.Realm)
+	log.Printf("[DEBUG] Creating identity provider %s in realm %s", identityProvider.Alias, identityProvider.Realm)
 	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/identity-provider/instances", identityProvider.Realm), identityProvider)
 	if err != nil {
 		return err
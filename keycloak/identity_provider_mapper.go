@@ -5,16 +5,26 @@ import (
 	"log"
 )
 
+const OidcAdvancedRoleIdentityProviderMapperType = "oidc-advanced-role-idp-mapper"
+
+// a single claim matched by the advanced claim to role mapper. keycloak expects these to be sent as a JSON encoded string
+type IdentityProviderMapperClaim struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type IdentityProviderMapperConfig struct {
-	UserAttribute         string `json:"user.attribute,omitempty"`
-	Claim                 string `json:"claim,omitempty"`
-	ClaimValue            string `json:"claim.value,omitempty"`
-	HardcodedAttribute    string `json:"attribute,omitempty"`
-	Attribute             string `json:"attribute.name,omitempty"`
-	AttributeValue        string `json:"attribute.value,omitempty"`
-	AttributeFriendlyName string `json:"attribute.friendly.name,omitempty"`
-	Template              string `json:"template,omitempty"`
-	Role                  string `json:"role,omitempty"`
+	UserAttribute         string             `json:"user.attribute,omitempty"`
+	Claim                 string             `json:"claim,omitempty"`
+	ClaimValue            string             `json:"claim.value,omitempty"`
+	HardcodedAttribute    string             `json:"attribute,omitempty"`
+	Attribute             string             `json:"attribute.name,omitempty"`
+	AttributeValue        string             `json:"attribute.value,omitempty"`
+	AttributeFriendlyName string             `json:"attribute.friendly.name,omitempty"`
+	Template              string             `json:"template,omitempty"`
+	Role                  string             `json:"role,omitempty"`
+	Claims                string             `json:"claims,omitempty"`
+	AreClaimValuesRegex   KeycloakBoolQuoted `json:"are.claim.values.regex,omitempty"`
 }
 
 type IdentityProviderMapper struct {
@@ -28,7 +38,7 @@ type IdentityProviderMapper struct {
 }
 
 func (keycloakClient *KeycloakClient) NewIdentityProviderMapper(identityProviderMapper *IdentityProviderMapper) error {
-	log.Printf("[WARN] Realm: %s", identityProviderMapper.Realm)
+	log.Printf("[DEBUG] Creating mapper %s for identity provider %s in realm %s", identityProviderMapper.Name, identityProviderMapper.IdentityProviderAlias, identityProviderMapper.Realm)
 	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers", identityProviderMapper.Realm, identityProviderMapper.IdentityProviderAlias), identityProviderMapper)
 	if err != nil {
 		return err
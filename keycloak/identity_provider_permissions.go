@@ -0,0 +1,38 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+// like OpenidClientPermissions, but for a single identity provider. keycloak only creates a `token-exchange` scope
+// permission for identity providers, which is also created on the realm's `realm-management` client
+type IdentityProviderPermissions struct {
+	RealmId          string            `json:"-"`
+	ProviderAlias    string            `json:"-"`
+	Enabled          bool              `json:"enabled"`
+	Resource         string            `json:"resource,omitempty"`
+	ScopePermissions map[string]string `json:"scopePermissions,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) GetIdentityProviderPermissions(realmId, providerAlias string) (*IdentityProviderPermissions, error) {
+	var identityProviderPermissions IdentityProviderPermissions
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/identity-provider/instances/%s/management/permissions", realmId, providerAlias), &identityProviderPermissions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	identityProviderPermissions.RealmId = realmId
+	identityProviderPermissions.ProviderAlias = providerAlias
+
+	return &identityProviderPermissions, nil
+}
+
+func (keycloakClient *KeycloakClient) EnableIdentityProviderPermissions(realmId, providerAlias string) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/identity-provider/instances/%s/management/permissions", realmId, providerAlias), &IdentityProviderPermissions{Enabled: true})
+}
+
+// disabling these permissions causes keycloak to delete the scope permission that was created for them
+func (keycloakClient *KeycloakClient) DisableIdentityProviderPermissions(realmId, providerAlias string) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/identity-provider/instances/%s/management/permissions", realmId, providerAlias), &IdentityProviderPermissions{Enabled: false})
+}
@@ -0,0 +1,80 @@
+package keycloak
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestKeycloakClient_validateIdentityProviderFlowAliases(t *testing.T) {
+	flowRequests := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/auth/admin/realms/test/authentication/flows" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		flowRequests++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "1", "alias": "first broker login"}, {"id": "2", "alias": "custom first broker login"}, {"id": "3", "alias": "otp"}]`))
+	})
+	defer server.Close()
+
+	tests := map[string]struct {
+		firstBrokerLoginFlowAlias string
+		postBrokerLoginFlowAlias  string
+		expectedError             string
+	}{
+		"default flows": {
+			firstBrokerLoginFlowAlias: "first broker login",
+		},
+		"custom flows": {
+			firstBrokerLoginFlowAlias: "custom first broker login",
+			postBrokerLoginFlowAlias:  "otp",
+		},
+		"missing first broker login flow": {
+			firstBrokerLoginFlowAlias: "missing",
+			expectedError:             "authentication flow with alias missing does not exist in realm test",
+		},
+		"missing post broker login flow": {
+			firstBrokerLoginFlowAlias: "first broker login",
+			postBrokerLoginFlowAlias:  "missing",
+			expectedError:             "authentication flow with alias missing does not exist in realm test",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := keycloakClient.ValidateIdentityProvider(&IdentityProvider{
+				Realm:                     "test",
+				Alias:                     "idp",
+				FirstBrokerLoginFlowAlias: test.firstBrokerLoginFlowAlias,
+				PostBrokerLoginFlowAlias:  test.postBrokerLoginFlowAlias,
+			})
+
+			if test.expectedError == "" && err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if test.expectedError != "" && (err == nil || !strings.Contains(err.Error(), test.expectedError)) {
+				t.Fatalf("expected error containing %q, got %v", test.expectedError, err)
+			}
+		})
+	}
+
+	// there's nothing to check when neither flow is set
+	flowRequests = 0
+
+	err := keycloakClient.ValidateIdentityProvider(&IdentityProvider{Realm: "test", Alias: "idp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if flowRequests != 0 {
+		t.Fatalf("expected no requests for an identity provider without flows, got %d", flowRequests)
+	}
+}
@@ -2,6 +2,7 @@ package keycloak
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"golang.org/x/net/publicsuffix"
@@ -10,18 +11,34 @@ import (
 	"log"
 	"net/http"
 	"net/http/cookiejar"
-	"net/http/httputil"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 type KeycloakClient struct {
 	baseUrl           string
+	basePath          string
 	realm             string
 	clientCredentials *ClientCredentials
 	httpClient        *http.Client
 	initialLogin      bool
+	retryCount        int
+	retryWait         time.Duration
+	requestTimeout    time.Duration
+	maxConcurrency    int
+	serverVersion     string
+	readCache         *readCache
+	existingRealms    sync.Map
+	userMutexes       sync.Map
+	flowMutexes       sync.Map
+	userRoleClaims    map[string]string
+	credentialsMutex  sync.RWMutex
+	refreshMutex      sync.Mutex
+	roleClaimsMutex   sync.Mutex
+	versionMutex      sync.Mutex
 }
 
 type ClientCredentials struct {
@@ -36,11 +53,14 @@ type ClientCredentials struct {
 }
 
 const (
-	apiUrl   = "/auth/admin"
-	tokenUrl = "%s/auth/realms/%s/protocol/openid-connect/token"
+	apiUrl   = "/admin"
+	tokenUrl = "%s%s/realms/%s/protocol/openid-connect/token"
+
+	// the longest we'll wait when a response includes a `Retry-After` header
+	maxRetryAfter = 30 * time.Second
 )
 
-func NewKeycloakClient(baseUrl, clientId, clientSecret, realm, username, password string, initialLogin bool, clientTimeout int) (*KeycloakClient, error) {
+func NewKeycloakClient(baseUrl, clientId, clientSecret, realm, username, password string, initialLogin bool, clientTimeout, retryCount, retryWait, maxConcurrency int, enableReadCache bool, requestTimeout int, basePath string) (*KeycloakClient, error) {
 	cookieJar, err := cookiejar.New(&cookiejar.Options{
 		PublicSuffixList: publicsuffix.List,
 	})
@@ -69,10 +89,19 @@ func NewKeycloakClient(baseUrl, clientId, clientSecret, realm, username, passwor
 
 	keycloakClient := KeycloakClient{
 		baseUrl:           baseUrl,
+		basePath:          normalizeBasePath(basePath),
 		clientCredentials: clientCredentials,
 		httpClient:        httpClient,
 		initialLogin:      initialLogin,
 		realm:             realm,
+		retryCount:        retryCount,
+		retryWait:         time.Second * time.Duration(retryWait),
+		requestTimeout:    time.Second * time.Duration(requestTimeout),
+		maxConcurrency:    maxConcurrency,
+	}
+
+	if enableReadCache {
+		keycloakClient.readCache = newReadCache()
 	}
 
 	if keycloakClient.initialLogin {
@@ -85,8 +114,29 @@ func NewKeycloakClient(baseUrl, clientId, clientSecret, realm, username, passwor
 	return &keycloakClient, nil
 }
 
+// normalizeBasePath makes sure the path that keycloak is served under, such as `/auth`, starts with a slash and doesn't
+// end with one. an empty path is used for keycloak servers that are served from the root, like newer quarkus releases
+func normalizeBasePath(basePath string) string {
+	basePath = strings.Trim(basePath, "/")
+	if basePath == "" {
+		return ""
+	}
+
+	return "/" + basePath
+}
+
+// MaxConcurrency is the maximum number of requests that should be sent in parallel when a single operation needs to
+// make many independent requests
+func (keycloakClient *KeycloakClient) MaxConcurrency() int {
+	if keycloakClient.maxConcurrency < 1 {
+		return 1
+	}
+
+	return keycloakClient.maxConcurrency
+}
+
 func (keycloakClient *KeycloakClient) login() error {
-	accessTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.realm)
+	accessTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.basePath, keycloakClient.realm)
 	accessTokenData := url.Values{}
 	accessTokenData.Set("client_id", keycloakClient.clientCredentials.ClientId)
 	accessTokenData.Set("grant_type", keycloakClient.clientCredentials.GrantType)
@@ -103,7 +153,8 @@ func (keycloakClient *KeycloakClient) login() error {
 		accessTokenData.Set("client_secret", keycloakClient.clientCredentials.ClientSecret)
 	}
 
-	log.Printf("[DEBUG] Login request: %s", accessTokenData.Encode())
+	// the request and response contain credentials and tokens, so only their context is logged
+	log.Printf("[DEBUG] Logging in to realm %s as client %s using the %s grant", keycloakClient.realm, keycloakClient.clientCredentials.ClientId, keycloakClient.clientCredentials.GrantType)
 
 	accessTokenRequest, _ := http.NewRequest(http.MethodPost, accessTokenUrl, strings.NewReader(accessTokenData.Encode()))
 	accessTokenRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -117,7 +168,7 @@ func (keycloakClient *KeycloakClient) login() error {
 
 	body, _ := ioutil.ReadAll(accessTokenResponse.Body)
 
-	log.Printf("[DEBUG] Login response: %s", body)
+	log.Printf("[DEBUG] Login response: %s", accessTokenResponse.Status)
 
 	var clientCredentials ClientCredentials
 	err = json.Unmarshal(body, &clientCredentials)
@@ -126,15 +177,13 @@ func (keycloakClient *KeycloakClient) login() error {
 		return err
 	}
 
-	keycloakClient.clientCredentials.AccessToken = clientCredentials.AccessToken
-	keycloakClient.clientCredentials.RefreshToken = clientCredentials.RefreshToken
-	keycloakClient.clientCredentials.TokenType = clientCredentials.TokenType
+	keycloakClient.setTokens(&clientCredentials)
 
 	return nil
 }
 
 func (keycloakClient *KeycloakClient) refresh() error {
-	refreshTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.realm)
+	refreshTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.basePath, keycloakClient.realm)
 	refreshTokenData := url.Values{}
 	refreshTokenData.Set("client_id", keycloakClient.clientCredentials.ClientId)
 	refreshTokenData.Set("grant_type", keycloakClient.clientCredentials.GrantType)
@@ -146,7 +195,7 @@ func (keycloakClient *KeycloakClient) refresh() error {
 		refreshTokenData.Set("client_secret", keycloakClient.clientCredentials.ClientSecret)
 	}
 
-	log.Printf("[DEBUG] Refresh request: %s", refreshTokenData.Encode())
+	log.Printf("[DEBUG] Refreshing credentials for client %s in realm %s", keycloakClient.clientCredentials.ClientId, keycloakClient.realm)
 
 	accessTokenRequest, _ := http.NewRequest(http.MethodPost, refreshTokenUrl, strings.NewReader(refreshTokenData.Encode()))
 	accessTokenRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -160,7 +209,7 @@ func (keycloakClient *KeycloakClient) refresh() error {
 
 	body, _ := ioutil.ReadAll(refreshTokenResponse.Body)
 
-	log.Printf("[DEBUG] Refresh response: %s", body)
+	log.Printf("[DEBUG] Refresh response: %s", refreshTokenResponse.Status)
 
 	// Handle 401 "User or client no longer has role permissions for client key" until I better understand why that happens in the first place
 	if refreshTokenResponse.StatusCode == http.StatusBadRequest {
@@ -175,16 +224,59 @@ func (keycloakClient *KeycloakClient) refresh() error {
 		return err
 	}
 
+	keycloakClient.setTokens(&clientCredentials)
+
+	return nil
+}
+
+// requests are sent from many goroutines at once, so the tokens are only read and written while holding credentialsMutex
+func (keycloakClient *KeycloakClient) setTokens(clientCredentials *ClientCredentials) {
+	keycloakClient.credentialsMutex.Lock()
+	defer keycloakClient.credentialsMutex.Unlock()
+
 	keycloakClient.clientCredentials.AccessToken = clientCredentials.AccessToken
 	keycloakClient.clientCredentials.RefreshToken = clientCredentials.RefreshToken
 	keycloakClient.clientCredentials.TokenType = clientCredentials.TokenType
+}
 
-	return nil
+// refreshes the credentials, unless another request already did so after `accessToken` was sent. when the token expires
+// while many requests are in flight, they all fail, but only the first one to get here needs to refresh it
+func (keycloakClient *KeycloakClient) refreshUnlessRefreshed(accessToken string) error {
+	keycloakClient.refreshMutex.Lock()
+	defer keycloakClient.refreshMutex.Unlock()
+
+	keycloakClient.credentialsMutex.RLock()
+	currentAccessToken := keycloakClient.clientCredentials.AccessToken
+	keycloakClient.credentialsMutex.RUnlock()
+
+	if currentAccessToken != accessToken {
+		return nil
+	}
+
+	return keycloakClient.refresh()
 }
 
-func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) {
+// logs in when the provider was configured without `initial_login` and this is the first request. requests can be sent
+// from many goroutines at once, so this holds refreshMutex, which also keeps a login and a refresh from overlapping
+func (keycloakClient *KeycloakClient) loginUnlessLoggedIn() error {
+	keycloakClient.refreshMutex.Lock()
+	defer keycloakClient.refreshMutex.Unlock()
+
+	if keycloakClient.initialLogin {
+		return nil
+	}
+
+	keycloakClient.initialLogin = true
+
+	return keycloakClient.login()
+}
+
+// returns the access token that was added to the request, so a failed request can tell whether it was already refreshed
+func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) string {
+	keycloakClient.credentialsMutex.RLock()
 	tokenType := keycloakClient.clientCredentials.TokenType
 	accessToken := keycloakClient.clientCredentials.AccessToken
+	keycloakClient.credentialsMutex.RUnlock()
 
 	request.Header.Set("Authorization", fmt.Sprintf("%s %s", tokenType, accessToken))
 	request.Header.Set("Accept", "application/json")
@@ -192,26 +284,37 @@ func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) {
 	if request.Method == http.MethodPost || request.Method == http.MethodPut || request.Method == http.MethodDelete {
 		request.Header.Set("Content-type", "application/json")
 	}
+
+	return accessToken
 }
 
 /**
 Sends an HTTP request and refreshes credentials on 403 or 401 errors
 */
 func (keycloakClient *KeycloakClient) sendRequest(request *http.Request) ([]byte, string, error) {
-	if !keycloakClient.initialLogin {
-		keycloakClient.initialLogin = true
-		err := keycloakClient.login()
-		if err != nil {
-			return nil, "", fmt.Errorf("error logging in: %s", err)
-		}
+	err := keycloakClient.loginUnlessLoggedIn()
+	if err != nil {
+		return nil, "", fmt.Errorf("error logging in: %s", err)
 	}
 	requestMethod := request.Method
 	requestPath := request.URL.Path
 
+	// the deadline covers every attempt, including retries and the time spent waiting between them, so a server that
+	// keeps stalling fails the request instead of blocking terraform indefinitely
+	if keycloakClient.requestTimeout > 0 {
+		ctx, cancel := context.WithTimeout(request.Context(), keycloakClient.requestTimeout)
+		defer cancel()
+
+		request = request.WithContext(ctx)
+	}
+
+	// invalidate once the write has finished, so a read that happens at the same time can't cache the old response
+	if keycloakClient.readCache != nil && requestMethod != http.MethodGet {
+		defer keycloakClient.readCache.invalidate(realmFromRequestPath(requestPath))
+	}
+
 	log.Printf("[DEBUG] Sending %s to %s", requestMethod, requestPath)
-	showBody := false
 	if request.Body != nil {
-		showBody = true
 		requestBody, err := request.GetBody()
 		if err != nil {
 			return nil, "", err
@@ -220,18 +323,12 @@ func (keycloakClient *KeycloakClient) sendRequest(request *http.Request) ([]byte
 		requestBodyBuffer := new(bytes.Buffer)
 		requestBodyBuffer.ReadFrom(requestBody)
 
-		log.Printf("[DEBUG] Request body: %s", requestBodyBuffer.String())
+		log.Printf("[DEBUG] Request body: %s", redactSecrets(requestBodyBuffer.Bytes()))
 	}
 
-	keycloakClient.addRequestHeaders(request)
+	accessToken := keycloakClient.addRequestHeaders(request)
 
-	dump, err := httputil.DumpRequest(request, showBody)
-	if err != nil {
-		return nil, "", err
-	}
-	log.Printf("[DEBUG] %s", dump)
-
-	response, err := keycloakClient.httpClient.Do(request)
+	response, err := keycloakClient.doWithRetry(request)
 	if err != nil {
 		return nil, "", err
 	}
@@ -239,22 +336,24 @@ func (keycloakClient *KeycloakClient) sendRequest(request *http.Request) ([]byte
 	// Unauthorized: Token could have expired
 	// Forbidden: After creating a realm, following GETs for the realm return 403 until you refresh
 	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
-		log.Printf("[DEBUG] Response: %s.  Attempting refresh", response.Status)
+		log.Printf("[DEBUG] Response to %s %s: %s. Attempting refresh", requestMethod, requestPath, response.Status)
+
+		response.Body.Close()
 
-		err := keycloakClient.refresh()
+		err := keycloakClient.refreshUnlessRefreshed(accessToken)
 		if err != nil {
 			return nil, "", fmt.Errorf("error refreshing credentials: %s", err)
 		}
 
 		keycloakClient.addRequestHeaders(request)
 
-		response, err = keycloakClient.httpClient.Do(request)
+		response, err = keycloakClient.doWithRetry(request)
 		if err != nil {
 			return nil, "", err
 		}
 	}
 
-	log.Printf("[DEBUG] Response: %s", response.Status)
+	log.Printf("[DEBUG] Response to %s %s: %s", requestMethod, requestPath, response.Status)
 
 	defer response.Body.Close()
 
@@ -264,21 +363,159 @@ func (keycloakClient *KeycloakClient) sendRequest(request *http.Request) ([]byte
 	}
 
 	if len(body) != 0 {
-		log.Printf("[DEBUG] Response body: %s", body)
+		log.Printf("[DEBUG] Response body: %s", redactSecrets(body))
 	}
 
 	if response.StatusCode >= 400 {
 		return nil, "", &ApiError{
 			Code:    response.StatusCode,
 			Message: fmt.Sprintf("error sending %s request to %s: %s", request.Method, request.URL.Path, response.Status),
+			Err:     getErrorFromResponseBody(body),
 		}
 	}
 
 	return body, response.Header.Get("Location"), nil
 }
 
+// the keys that hold passwords, client secrets, LDAP bind credentials and private keys in the bodies sent to and
+// received from keycloak. they're matched in nested objects as well, such as a component's config
+var secretBodyKeys = map[string]bool{
+	"value":                    true,
+	"secret":                   true,
+	"password":                 true,
+	"bindCredential":           true,
+	"privateKey":               true,
+	"clientSecret":             true,
+	"saml.signing.private.key": true,
+}
+
+// returns a body for logging, with the values of secretBodyKeys replaced. bodies that aren't JSON can't be redacted, so
+// only their size is logged
+func redactSecrets(body []byte) string {
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+
+	redacted, err := json.Marshal(redactSecretValues(decoded))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+
+	return string(redacted)
+}
+
+func redactSecretValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if secretBodyKeys[key] {
+				v[key] = "**********"
+			} else {
+				v[key] = redactSecretValues(nested)
+			}
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactSecretValues(nested)
+		}
+	}
+
+	return value
+}
+
+// Gateways in front of Keycloak will occasionally return one of these status codes while Keycloak itself is fine
+func isRetryableStatusCode(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusBadGateway || statusCode == http.StatusServiceUnavailable || statusCode == http.StatusGatewayTimeout
+}
+
+// POSTs are only retried when we know that sending them twice has no additional effect:
+// - a 429 or 503 means the request was never handled
+// - adding a role mapping that already exists is a no-op
+func isRetryableRequest(request *http.Request, statusCode int) bool {
+	if request.Method != http.MethodPost {
+		return true
+	}
+
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable || strings.Contains(request.URL.Path, "/role-mappings/")
+}
+
+// Returns how long the server asked us to wait before retrying, which is given either in seconds or as an HTTP date.
+// The wait is capped so that a misbehaving gateway can't stall terraform indefinitely
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	header := response.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(header); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		wait = time.Until(date)
+	} else {
+		return 0, false
+	}
+
+	if wait < 0 {
+		wait = 0
+	}
+
+	if wait > maxRetryAfter {
+		wait = maxRetryAfter
+	}
+
+	return wait, true
+}
+
+// Sends an HTTP request, retrying with exponential backoff if a transient error is returned
+func (keycloakClient *KeycloakClient) doWithRetry(request *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		// the body may have been consumed by a previous attempt, so it needs to be reset before each one
+		if request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			request.Body = body
+		}
+
+		response, err := keycloakClient.httpClient.Do(request)
+		if err != nil {
+			if request.Context().Err() == context.DeadlineExceeded {
+				return nil, requestTimeoutError(request, keycloakClient.requestTimeout)
+			}
+
+			return nil, err
+		}
+
+		if attempt >= keycloakClient.retryCount || !isRetryableStatusCode(response.StatusCode) || !isRetryableRequest(request, response.StatusCode) {
+			return response, nil
+		}
+
+		response.Body.Close()
+
+		wait, ok := retryAfter(response)
+		if !ok {
+			wait = keycloakClient.retryWait * time.Duration(1<<uint(attempt))
+		}
+
+		log.Printf("[DEBUG] Response: %s.  Retrying in %s (attempt %d of %d)", response.Status, wait, attempt+1, keycloakClient.retryCount)
+
+		select {
+		case <-request.Context().Done():
+			return nil, requestTimeoutError(request, keycloakClient.requestTimeout)
+		case <-time.After(wait):
+		}
+	}
+}
+
+func requestTimeoutError(request *http.Request, timeout time.Duration) error {
+	return fmt.Errorf("%s request to %s timed out after %s", request.Method, request.URL.Path, timeout)
+}
+
 func (keycloakClient *KeycloakClient) get(path string, resource interface{}, params map[string]string) error {
-	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+	resourceUrl := keycloakClient.baseUrl + keycloakClient.basePath + apiUrl + path
 
 	request, err := http.NewRequest(http.MethodGet, resourceUrl, nil)
 	if err != nil {
@@ -293,16 +530,85 @@ func (keycloakClient *KeycloakClient) get(path string, resource interface{}, par
 		request.URL.RawQuery = query.Encode()
 	}
 
+	cacheable := keycloakClient.readCache != nil && isCacheablePath(path)
+
+	var generation uint64
+	if cacheable {
+		generation = keycloakClient.readCache.currentGeneration()
+
+		if body, ok := keycloakClient.readCache.get(request.URL.String()); ok {
+			log.Printf("[DEBUG] Using cached response for %s", request.URL.Path)
+
+			return json.Unmarshal(body, resource)
+		}
+	}
+
 	body, _, err := keycloakClient.sendRequest(request)
 	if err != nil {
 		return err
 	}
 
+	if cacheable {
+		keycloakClient.readCache.set(request.URL.String(), realmFromRequestPath(request.URL.Path), body, generation)
+	}
+
+	return json.Unmarshal(body, resource)
+}
+
+// the number of results requested per page by `getAllPages`
+const pageSize = 100
+
+// like `get`, but for list endpoints that support `first` and `max`. every page is fetched and the combined results are
+// unmarshalled into `resource`, which should be a pointer to a slice
+func (keycloakClient *KeycloakClient) getAllPages(path string, resource interface{}, params map[string]string) error {
+	var results []json.RawMessage
+
+	for first := 0; ; first += pageSize {
+		pageParams := map[string]string{
+			"first": strconv.Itoa(first),
+			"max":   strconv.Itoa(pageSize),
+		}
+		for k, v := range params {
+			pageParams[k] = v
+		}
+
+		var page []json.RawMessage
+
+		err := keycloakClient.get(path, &page, pageParams)
+		if err != nil {
+			return err
+		}
+
+		// older versions of keycloak don't support pagination for some endpoints, and return everything every time
+		if len(page) > pageSize || (first > 0 && len(page) != 0 && bytes.Equal(page[0], results[0])) {
+			if first == 0 {
+				results = page
+			}
+
+			break
+		}
+
+		results = append(results, page...)
+
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	if results == nil {
+		results = []json.RawMessage{}
+	}
+
+	body, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+
 	return json.Unmarshal(body, resource)
 }
 
 func (keycloakClient *KeycloakClient) post(path string, requestBody interface{}) ([]byte, string, error) {
-	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+	resourceUrl := keycloakClient.baseUrl + keycloakClient.basePath + apiUrl + path
 
 	payload, err := json.Marshal(requestBody)
 	if err != nil {
@@ -320,7 +626,7 @@ func (keycloakClient *KeycloakClient) post(path string, requestBody interface{})
 }
 
 func (keycloakClient *KeycloakClient) put(path string, requestBody interface{}) error {
-	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+	resourceUrl := keycloakClient.baseUrl + keycloakClient.basePath + apiUrl + path
 
 	payload, err := json.Marshal(requestBody)
 	if err != nil {
@@ -338,7 +644,7 @@ func (keycloakClient *KeycloakClient) put(path string, requestBody interface{})
 }
 
 func (keycloakClient *KeycloakClient) delete(path string, requestBody interface{}) error {
-	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+	resourceUrl := keycloakClient.baseUrl + keycloakClient.basePath + apiUrl + path
 
 	var body io.Reader
 
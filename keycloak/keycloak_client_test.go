@@ -1,11 +1,23 @@
 package keycloak
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 var requiredEnvironmentVariables = []string{
@@ -44,7 +56,7 @@ func TestAccKeycloakApiClientRefresh(t *testing.T) {
 		defer log.SetOutput(os.Stdout)
 	}
 
-	keycloakClient, err := NewKeycloakClient(os.Getenv("KEYCLOAK_URL"), os.Getenv("KEYCLOAK_CLIENT_ID"), os.Getenv("KEYCLOAK_CLIENT_SECRET"), os.Getenv("KEYCLOAK_REALM"), os.Getenv("KEYCLOAK_USER"), os.Getenv("KEYCLOAK_PASSWORD"), true, 5)
+	keycloakClient, err := NewKeycloakClient(os.Getenv("KEYCLOAK_URL"), os.Getenv("KEYCLOAK_CLIENT_ID"), os.Getenv("KEYCLOAK_CLIENT_SECRET"), os.Getenv("KEYCLOAK_REALM"), os.Getenv("KEYCLOAK_USER"), os.Getenv("KEYCLOAK_PASSWORD"), true, 5, 0, 0, 1, false, 30, "/auth")
 	if err != nil {
 		t.Fatalf("%s", err)
 	}
@@ -98,3 +110,660 @@ func TestAccKeycloakApiClientRefresh(t *testing.T) {
 		}
 	}
 }
+
+// Starts a server that responds to token requests the same way Keycloak does, and passes all other requests to `handler`.
+// The returned client is configured to talk to this server
+func newTestKeycloakClient(t *testing.T, handler http.HandlerFunc) (*KeycloakClient, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/realms/master/protocol/openid-connect/token" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token": "access-token", "refresh_token": "refresh-token", "token_type": "bearer"}`))
+
+			return
+		}
+
+		handler(w, r)
+	}))
+
+	keycloakClient, err := NewKeycloakClient(server.URL, "terraform", "secret", "master", "", "", true, 5, 3, 0, 1, false, 30, "/auth")
+	if err != nil {
+		server.Close()
+		t.Fatalf("%s", err)
+	}
+
+	// keep the tests fast
+	keycloakClient.retryWait = time.Millisecond
+
+	return keycloakClient, server
+}
+
+func TestKeycloakClient_basePath(t *testing.T) {
+	tests := []struct {
+		basePath       string
+		expectedPrefix string
+	}{
+		{basePath: "/auth", expectedPrefix: "/auth"},
+		{basePath: "auth/", expectedPrefix: "/auth"},
+		{basePath: "", expectedPrefix: ""},
+		{basePath: "/", expectedPrefix: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%q", test.basePath), func(t *testing.T) {
+			var paths []string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+
+				if strings.HasSuffix(r.URL.Path, "/protocol/openid-connect/token") {
+					w.Write([]byte(`{"access_token": "access-token", "refresh_token": "refresh-token", "token_type": "bearer"}`))
+
+					return
+				}
+
+				w.Write([]byte(`{"id": "my-realm", "realm": "my-realm"}`))
+			}))
+			defer server.Close()
+
+			keycloakClient, err := NewKeycloakClient(server.URL, "terraform", "secret", "master", "", "", true, 5, 0, 0, 1, false, 30, test.basePath)
+			if err != nil {
+				t.Fatalf("expected login to succeed, got %s", err)
+			}
+
+			_, err = keycloakClient.GetRealm("my-realm")
+			if err != nil {
+				t.Fatalf("expected request to succeed, got %s", err)
+			}
+
+			expected := []string{
+				test.expectedPrefix + "/realms/master/protocol/openid-connect/token",
+				test.expectedPrefix + "/admin/realms/my-realm",
+			}
+
+			if !reflect.DeepEqual(paths, expected) {
+				t.Fatalf("expected requests to %v, got %v", expected, paths)
+			}
+		})
+	}
+}
+
+// serves a new access token each time one is requested, and rejects admin requests that don't use the latest one, as if
+// every earlier token had expired
+func newExpiringTokenServer(t *testing.T, tokenRequests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/auth/realms/master/protocol/openid-connect/token" {
+			token := atomic.AddInt32(tokenRequests, 1)
+			w.Write([]byte(fmt.Sprintf(`{"access_token": "access-token-%d", "refresh_token": "refresh-token-%d", "token_type": "bearer"}`, token, token)))
+
+			return
+		}
+
+		if r.URL.Path != "/auth/admin/realms/my-realm" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+
+		if r.Header.Get("Authorization") != fmt.Sprintf("bearer access-token-%d", atomic.LoadInt32(tokenRequests)) {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Write([]byte(`{"id": "my-realm", "realm": "my-realm"}`))
+	}))
+}
+
+func TestKeycloakClient_refreshesExpiredToken(t *testing.T) {
+	var tokenRequests int32
+
+	server := newExpiringTokenServer(t, &tokenRequests)
+	defer server.Close()
+
+	keycloakClient, err := NewKeycloakClient(server.URL, "terraform", "secret", "master", "", "", true, 5, 0, 0, 1, false, 30, "/auth")
+	if err != nil {
+		t.Fatalf("expected login to succeed, got %s", err)
+	}
+
+	// a new token is issued to someone else, so the client's token is no longer accepted
+	atomic.AddInt32(&tokenRequests, 1)
+
+	_, err = keycloakClient.GetRealm("my-realm")
+	if err != nil {
+		t.Fatalf("expected request to succeed after refreshing the token, got %s", err)
+	}
+
+	if tokenRequests != 3 {
+		t.Fatalf("expected the token to be refreshed once, got %d token requests", tokenRequests)
+	}
+}
+
+func TestKeycloakClient_refreshesExpiredTokenOnceForParallelRequests(t *testing.T) {
+	var tokenRequests int32
+
+	server := newExpiringTokenServer(t, &tokenRequests)
+	defer server.Close()
+
+	keycloakClient, err := NewKeycloakClient(server.URL, "terraform", "secret", "master", "", "", true, 5, 0, 0, 1, false, 30, "/auth")
+	if err != nil {
+		t.Fatalf("expected login to succeed, got %s", err)
+	}
+
+	atomic.AddInt32(&tokenRequests, 1)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := keycloakClient.GetRealm("my-realm")
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("expected every request to succeed after refreshing the token, got %s", err)
+		}
+	}
+
+	if tokenRequests != 3 {
+		t.Fatalf("expected the token to be refreshed once, got %d token requests", tokenRequests)
+	}
+}
+
+// without initial_login, the first requests log in. when many of them are sent at once, only one of them should
+func TestKeycloakClient_logsInOnceForParallelRequests(t *testing.T) {
+	var tokenRequests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/auth/realms/master/protocol/openid-connect/token" {
+			atomic.AddInt32(&tokenRequests, 1)
+			w.Write([]byte(`{"access_token": "access-token", "refresh_token": "refresh-token", "token_type": "bearer"}`))
+
+			return
+		}
+
+		if r.Header.Get("Authorization") != "bearer access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Write([]byte(`{"id": "my-realm", "realm": "my-realm"}`))
+	}))
+	defer server.Close()
+
+	keycloakClient, err := NewKeycloakClient(server.URL, "terraform", "secret", "master", "", "", false, 5, 0, 0, 1, false, 30, "/auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := keycloakClient.GetRealm("my-realm")
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("expected every request to succeed after logging in, got %s", err)
+		}
+	}
+
+	if tokenRequests != 1 {
+		t.Fatalf("expected to log in once, got %d token requests", tokenRequests)
+	}
+}
+
+func TestKeycloakClient_retriesTransientErrors(t *testing.T) {
+	requests := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if requests < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		w.Write([]byte(`{"id": "my-realm", "realm": "my-realm"}`))
+	})
+	defer server.Close()
+
+	realm, err := keycloakClient.GetRealm("my-realm")
+	if err != nil {
+		t.Fatalf("expected request to succeed after retrying, got %s", err)
+	}
+
+	if realm.Realm != "my-realm" {
+		t.Fatalf("expected realm my-realm, got %s", realm.Realm)
+	}
+
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestKeycloakClient_stopsRetryingAfterRetryCount(t *testing.T) {
+	requests := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+	defer server.Close()
+
+	_, err := keycloakClient.GetRealm("my-realm")
+	if err == nil {
+		t.Fatalf("expected request to fail")
+	}
+
+	if requests != keycloakClient.retryCount+1 {
+		t.Fatalf("expected %d requests, got %d", keycloakClient.retryCount+1, requests)
+	}
+}
+
+func TestKeycloakClient_doesNotRetryUnsafePosts(t *testing.T) {
+	requests := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer server.Close()
+
+	err := keycloakClient.NewUser(&User{RealmId: "my-realm", Username: "user"})
+	if err == nil {
+		t.Fatalf("expected request to fail")
+	}
+
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestKeycloakClient_retriesRoleMappingPosts(t *testing.T) {
+	requests := 0
+	var bodies []string
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	err := keycloakClient.AddRealmRolesToUser("my-realm", "user-id", []*Role{{Id: "role-id", Name: "role"}})
+	if err != nil {
+		t.Fatalf("expected request to succeed after retrying, got %s", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+
+	if bodies[0] != bodies[1] {
+		t.Fatalf("expected retried request to send the same body, got %s and %s", bodies[0], bodies[1])
+	}
+}
+
+func TestKeycloakClient_honorsRetryAfter(t *testing.T) {
+	requests := 0
+	var firstRequestAt, secondRequestAt time.Time
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if requests == 1 {
+			firstRequestAt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		secondRequestAt = time.Now()
+		w.Write([]byte(`{"id": "my-realm", "realm": "my-realm"}`))
+	})
+	defer server.Close()
+
+	_, err := keycloakClient.GetRealm("my-realm")
+	if err != nil {
+		t.Fatalf("expected request to succeed after retrying, got %s", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+
+	// `retryWait` is a millisecond in these tests, so anything close to a second means the header was used
+	if waited := secondRequestAt.Sub(firstRequestAt); waited < 900*time.Millisecond {
+		t.Fatalf("expected client to wait about 1s before retrying, waited %s", waited)
+	}
+}
+
+func TestKeycloakClient_requestTimeoutIncludesRetries(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer server.Close()
+
+	// without a deadline, these retries would take over a minute
+	keycloakClient.retryWait = 10 * time.Second
+	keycloakClient.requestTimeout = 100 * time.Millisecond
+
+	start := time.Now()
+
+	_, err := keycloakClient.GetRealm("my-realm")
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected request to time out, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected request to be aborted shortly after the timeout, took %s", elapsed)
+	}
+}
+
+func TestKeycloakClient_requestTimeoutAbortsSlowResponses(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		w.Write([]byte(`{"id": "my-realm", "realm": "my-realm"}`))
+	})
+	defer server.Close()
+
+	keycloakClient.requestTimeout = 50 * time.Millisecond
+
+	_, err := keycloakClient.GetRealm("my-realm")
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected request to time out, got %v", err)
+	}
+}
+
+func TestKeycloakClient_capsRetryAfter(t *testing.T) {
+	response := &http.Response{Header: http.Header{}}
+	response.Header.Set("Retry-After", "3600")
+
+	wait, ok := retryAfter(response)
+	if !ok {
+		t.Fatalf("expected Retry-After header to be parsed")
+	}
+
+	if wait != maxRetryAfter {
+		t.Fatalf("expected wait to be capped at %s, got %s", maxRetryAfter, wait)
+	}
+}
+
+// responds to role list requests with `total` roles, paginated with `first` and `max` unless `ignorePagination` is set
+func newPaginatedRolesHandler(t *testing.T, total int, ignorePagination bool, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		first, max := 0, total
+		if !ignorePagination {
+			var err error
+
+			first, err = strconv.Atoi(r.URL.Query().Get("first"))
+			if err != nil {
+				t.Errorf("expected a valid first parameter, got %s", r.URL.Query().Get("first"))
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			max, err = strconv.Atoi(r.URL.Query().Get("max"))
+			if err != nil {
+				t.Errorf("expected a valid max parameter, got %s", r.URL.Query().Get("max"))
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+		}
+
+		var roles []*Role
+		for i := first; i < first+max && i < total; i++ {
+			roles = append(roles, &Role{Id: strconv.Itoa(i), Name: fmt.Sprintf("role-%d", i)})
+		}
+
+		if roles == nil {
+			roles = []*Role{}
+		}
+
+		body, _ := json.Marshal(roles)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}
+}
+
+func TestKeycloakClient_getAllPagesFetchesEveryPage(t *testing.T) {
+	requests := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, newPaginatedRolesHandler(t, 2*pageSize+50, false, &requests))
+	defer server.Close()
+
+	roles, err := keycloakClient.ListRoles("test", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if len(roles) != 2*pageSize+50 {
+		t.Fatalf("expected %d roles, got %d", 2*pageSize+50, len(roles))
+	}
+
+	for i, role := range roles {
+		if role.Id != strconv.Itoa(i) {
+			t.Fatalf("expected role %d to have id %d, got %s", i, i, role.Id)
+		}
+	}
+
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestKeycloakClient_getAllPagesStopsOnExactPage(t *testing.T) {
+	requests := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, newPaginatedRolesHandler(t, pageSize, false, &requests))
+	defer server.Close()
+
+	roles, err := keycloakClient.ListRoles("test", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if len(roles) != pageSize {
+		t.Fatalf("expected %d roles, got %d", pageSize, len(roles))
+	}
+
+	// the second request returns an empty page
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestKeycloakClient_getAllPagesWhenPaginationIsIgnored(t *testing.T) {
+	for _, total := range []int{pageSize, pageSize + 50} {
+		requests := 0
+
+		keycloakClient, server := newTestKeycloakClient(t, newPaginatedRolesHandler(t, total, true, &requests))
+
+		roles, err := keycloakClient.ListRoles("test", "")
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(roles) != total {
+			t.Fatalf("expected %d roles, got %d", total, len(roles))
+		}
+	}
+}
+
+func TestKeycloakClient_apiErrorExposesStatusCode(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"errorMessage": "User exists with same username"}`))
+	})
+	defer server.Close()
+
+	err := keycloakClient.NewUser(&User{RealmId: "my-realm", Username: "user"})
+
+	var apiError *ApiError
+	if !errors.As(fmt.Errorf("error creating user: %w", err), &apiError) {
+		t.Fatalf("expected an ApiError, got %v", err)
+	}
+
+	if apiError.Code != http.StatusConflict {
+		t.Errorf("expected status code %d, got %d", http.StatusConflict, apiError.Code)
+	}
+
+	if apiError.Err == nil || apiError.Err.Error() != "User exists with same username" {
+		t.Errorf("expected the error message from the response body, got %v", apiError.Err)
+	}
+
+	if ErrorIs404(err) {
+		t.Errorf("expected a 409 not to be treated as a 404")
+	}
+}
+
+func TestErrorIs404(t *testing.T) {
+	notFound := &ApiError{Code: http.StatusNotFound, Message: "not found"}
+
+	tests := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil":             {err: nil, expected: false},
+		"other error":     {err: errors.New("not found"), expected: false},
+		"bad request":     {err: &ApiError{Code: http.StatusBadRequest}, expected: false},
+		"not found":       {err: notFound, expected: true},
+		"fmt wrapped":     {err: fmt.Errorf("error getting user: %w", notFound), expected: true},
+		"errwrap wrapped": {err: errwrap.Wrapf("error getting user: {{err}}", notFound), expected: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := ErrorIs404(test.err); actual != test.expected {
+				t.Errorf("expected ErrorIs404 to return %t, got %t", test.expected, actual)
+			}
+		})
+	}
+}
+
+// like keycloak, only the first page of clients is returned when `max` isn't set
+func newPaginatedClientsHandler(t *testing.T, total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/auth/admin/realms/test/clients" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		first, _ := strconv.Atoi(r.URL.Query().Get("first"))
+		max, err := strconv.Atoi(r.URL.Query().Get("max"))
+		if err != nil {
+			max = pageSize
+		}
+
+		clients := []*GenericClient{}
+		for i := first; i < first+max && i < total; i++ {
+			clients = append(clients, &GenericClient{Id: strconv.Itoa(i), ClientId: fmt.Sprintf("client-%d", i+1)})
+		}
+
+		body, _ := json.Marshal(clients)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}
+}
+
+func TestKeycloakClient_listsEveryClient(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, newPaginatedClientsHandler(t, 150))
+	defer server.Close()
+
+	genericClients, err := keycloakClient.ListGenericClients("test")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	openidClients, err := keycloakClient.GetOpenidClients("test", false)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if len(genericClients) != 150 || len(openidClients) != 150 {
+		t.Fatalf("expected 150 clients, got %d generic clients and %d openid clients", len(genericClients), len(openidClients))
+	}
+
+	if genericClients[149].ClientId != "client-150" || openidClients[149].ClientId != "client-150" {
+		t.Errorf("expected the 150th client to be client-150, got %s and %s", genericClients[149].ClientId, openidClients[149].ClientId)
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	bodies := map[string]string{
+		"password reset":    `{"type": "password", "value": "hunter2", "temporary": false}`,
+		"client secret":     `{"clientId": "my-client", "secret": "hunter2"}`,
+		"ldap component":    `{"name": "ldap", "config": {"bindDn": ["cn=admin,dc=example,dc=org"], "bindCredential": ["hunter2"]}}`,
+		"rsa keystore":      `{"name": "rsa", "config": {"privateKey": ["hunter2"], "certificate": ["cert"]}}`,
+		"smtp server":       `{"smtpServer": {"user": "admin", "password": "hunter2"}}`,
+		"partial import":    `{"users": [{"username": "user", "credentials": [{"type": "password", "value": "hunter2"}]}]}`,
+		"top level array":   `[{"value": "hunter2"}]`,
+		"identity provider": `{"alias": "oidc", "providerId": "oidc", "config": {"clientId": "my-client", "clientSecret": "hunter2"}}`,
+		"saml client":       `{"clientId": "saml", "protocol": "saml", "attributes": {"saml.signing.certificate": "cert", "saml.signing.private.key": "hunter2"}}`,
+	}
+
+	for name, body := range bodies {
+		redacted := redactSecrets([]byte(body))
+
+		if strings.Contains(redacted, "hunter2") {
+			t.Errorf("expected the secret to be redacted from the %s body, got %s", name, redacted)
+		}
+
+		if !strings.Contains(redacted, "**********") {
+			t.Errorf("expected the %s body to show where a secret was redacted, got %s", name, redacted)
+		}
+	}
+
+	if redacted := redactSecrets([]byte(`{"bindDn": ["cn=admin,dc=example,dc=org"]}`)); !strings.Contains(redacted, "cn=admin,dc=example,dc=org") {
+		t.Errorf("expected values that aren't secrets to be kept, got %s", redacted)
+	}
+
+	if redacted := redactSecrets([]byte("grant_type=password&password=hunter2")); redacted != "<36 bytes>" {
+		t.Errorf("expected a body that isn't JSON to be replaced by its size, got %s", redacted)
+	}
+}
@@ -41,6 +41,12 @@ type LdapUserFederation struct {
 	ChangedSyncPeriod int // either a number, in milliseconds, or -1 if changed sync is disabled
 
 	CachePolicy string
+
+	AllowKerberosAuthentication          bool
+	KerberosRealm                        string
+	ServerPrincipal                      string
+	KeyTab                               string
+	UseKerberosForPasswordAuthentication bool
 }
 
 func convertFromLdapUserFederationToComponent(ldap *LdapUserFederation) (*component, error) {
@@ -102,6 +108,21 @@ func convertFromLdapUserFederationToComponent(ldap *LdapUserFederation) (*compon
 		"changedSyncPeriod": {
 			strconv.Itoa(ldap.ChangedSyncPeriod),
 		},
+		"allowKerberosAuthentication": {
+			strconv.FormatBool(ldap.AllowKerberosAuthentication),
+		},
+		"kerberosRealm": {
+			ldap.KerberosRealm,
+		},
+		"serverPrincipal": {
+			ldap.ServerPrincipal,
+		},
+		"keyTab": {
+			ldap.KeyTab,
+		},
+		"useKerberosForPasswordAuthentication": {
+			strconv.FormatBool(ldap.UseKerberosForPasswordAuthentication),
+		},
 	}
 
 	if ldap.BindDn != "" && ldap.BindCredential != "" {
@@ -209,6 +230,16 @@ func convertFromComponentToLdapUserFederation(component *component) (*LdapUserFe
 		return nil, err
 	}
 
+	allowKerberosAuthentication, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("allowKerberosAuthentication"))
+	if err != nil {
+		return nil, err
+	}
+
+	useKerberosForPasswordAuthentication, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("useKerberosForPasswordAuthentication"))
+	if err != nil {
+		return nil, err
+	}
+
 	ldap := &LdapUserFederation{
 		Id:      component.Id,
 		Name:    component.Name,
@@ -242,6 +273,12 @@ func convertFromComponentToLdapUserFederation(component *component) (*LdapUserFe
 		ChangedSyncPeriod: changedSyncPeriod,
 
 		CachePolicy: component.getConfig("cachePolicy"),
+
+		AllowKerberosAuthentication:          allowKerberosAuthentication,
+		KerberosRealm:                        component.getConfig("kerberosRealm"),
+		ServerPrincipal:                      component.getConfig("serverPrincipal"),
+		KeyTab:                               component.getConfig("keyTab"),
+		UseKerberosForPasswordAuthentication: useKerberosForPasswordAuthentication,
 	}
 
 	if bindDn := component.getConfig("bindDn"); bindDn != "" {
@@ -360,7 +397,11 @@ func (keycloakClient *KeycloakClient) GetLdapUserFederationMappers(realmId, id s
 			}
 			ldapUserFederationMappers = append(ldapUserFederationMappers, mapper)
 		case "role-ldap-mapper":
-			// Not supported
+			mapper, err := convertFromComponentToLdapRoleMapper(component, realmId)
+			if err != nil {
+				return nil, err
+			}
+			ldapUserFederationMappers = append(ldapUserFederationMappers, mapper)
 		}
 	}
 
@@ -12,8 +12,9 @@ type OpenIdAudienceProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
-	AddToIdToken     bool
-	AddToAccessToken bool
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToIntrospectionToken bool
 
 	IncludedClientAudience string
 	IncludedCustomAudience string
@@ -26,10 +27,11 @@ func (mapper *OpenIdAudienceProtocolMapper) convertToGenericProtocolMapper() *pr
 		Protocol:       "openid-connect",
 		ProtocolMapper: "oidc-audience-mapper",
 		Config: map[string]string{
-			addToIdTokenField:           strconv.FormatBool(mapper.AddToIdToken),
-			addToAccessTokenField:       strconv.FormatBool(mapper.AddToAccessToken),
-			includedClientAudienceField: mapper.IncludedClientAudience,
-			includedCustomAudienceField: mapper.IncludedCustomAudience,
+			addToIdTokenField:            strconv.FormatBool(mapper.AddToIdToken),
+			addToAccessTokenField:        strconv.FormatBool(mapper.AddToAccessToken),
+			addToIntrospectionTokenField: strconv.FormatBool(mapper.AddToIntrospectionToken),
+			includedClientAudienceField:  mapper.IncludedClientAudience,
+			includedCustomAudienceField:  mapper.IncludedCustomAudience,
 		},
 	}
 }
@@ -45,6 +47,15 @@ func (protocolMapper *protocolMapper) convertToOpenIdAudienceProtocolMapper(real
 		return nil, err
 	}
 
+	// older versions of keycloak don't have this setting, and always include the audience in introspection responses
+	addToIntrospectionToken := true
+	if v, ok := protocolMapper.Config[addToIntrospectionTokenField]; ok && v != "" {
+		addToIntrospectionToken, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &OpenIdAudienceProtocolMapper{
 		Id:            protocolMapper.Id,
 		Name:          protocolMapper.Name,
@@ -52,8 +63,9 @@ func (protocolMapper *protocolMapper) convertToOpenIdAudienceProtocolMapper(real
 		ClientId:      clientId,
 		ClientScopeId: clientScopeId,
 
-		AddToIdToken:     addToIdToken,
-		AddToAccessToken: addToAccessToken,
+		AddToIdToken:            addToIdToken,
+		AddToAccessToken:        addToAccessToken,
+		AddToIntrospectionToken: addToIntrospectionToken,
 
 		IncludedClientAudience: protocolMapper.Config[includedClientAudienceField],
 		IncludedCustomAudience: protocolMapper.Config[includedCustomAudienceField],
@@ -123,7 +135,7 @@ func (keycloakClient *KeycloakClient) ValidateOpenIdAudienceProtocolMapper(mappe
 	}
 
 	if mapper.IncludedClientAudience != "" {
-		clients, err := keycloakClient.listGenericClients(mapper.RealmId)
+		clients, err := keycloakClient.ListGenericClients(mapper.RealmId)
 		if err != nil {
 			return err
 		}
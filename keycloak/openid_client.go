@@ -1,7 +1,11 @@
 package keycloak
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 )
 
 type OpenidClientRole struct {
@@ -24,6 +28,12 @@ type OpenidClientAuthorizationSettings struct {
 	KeepDefaults                  bool   `json:"-"`
 }
 
+// a flow that is left empty falls back to the realm's binding for it
+type OpenidAuthenticationFlowBindingOverrides struct {
+	BrowserId     string `json:"browser"`
+	DirectGrantId string `json:"direct_grant"`
+}
+
 type OpenidClient struct {
 	Id                           string                             `json:"id,omitempty"`
 	ClientId                     string                             `json:"clientId"`
@@ -44,13 +54,79 @@ type OpenidClient struct {
 	ValidRedirectUris            []string                           `json:"redirectUris"`
 	WebOrigins                   []string                           `json:"webOrigins"`
 	FullScopeAllowed             bool                               `json:"fullScopeAllowed"`
+	ConsentRequired              bool                               `json:"consentRequired"`
 	Attributes                   OpenidClientAttributes             `json:"attributes"`
 	AuthorizationSettings        *OpenidClientAuthorizationSettings `json:"authorizationSettings,omitempty"`
+	// both flows are always sent, since keycloak only removes an override when its value is empty
+	AuthenticationFlowBindingOverrides OpenidAuthenticationFlowBindingOverrides `json:"authenticationFlowBindingOverrides"`
 }
 
+// lifespans and timeouts are in seconds, and fall back to the realm's setting when they're empty
 type OpenidClientAttributes struct {
-	PkceCodeChallengeMethod             string             `json:"pkce.code.challenge.method"`
-	ExcludeSessionStateFromAuthResponse KeycloakBoolQuoted `json:"exclude.session.state.from.auth.response"`
+	PkceCodeChallengeMethod             string                 `json:"pkce.code.challenge.method"`
+	ExcludeSessionStateFromAuthResponse KeycloakBoolQuoted     `json:"exclude.session.state.from.auth.response"`
+	AccessTokenLifespan                 string                 `json:"access.token.lifespan"`
+	ClientSessionIdleTimeout            string                 `json:"client.session.idle.timeout"`
+	ClientSessionMaxLifespan            string                 `json:"client.session.max.lifespan"`
+	BackchannelLogoutUrl                string                 `json:"backchannel.logout.url"`
+	BackchannelLogoutSessionRequired    KeycloakBoolQuoted     `json:"backchannel.logout.session.required"`
+	DisplayOnConsentScreen              KeycloakBoolQuoted     `json:"display.on.consent.screen"`
+	ConsentScreenText                   string                 `json:"consent.screen.text"`
+	ExtraConfig                         map[string]interface{} `json:"-"`
+}
+
+// attributes that don't have a field of their own are kept in ExtraConfig, so they aren't lost when the client is updated
+func (attributes *OpenidClientAttributes) UnmarshalJSON(data []byte) error {
+	attributes.ExtraConfig = map[string]interface{}{}
+	err := json.Unmarshal(data, &attributes.ExtraConfig)
+	if err != nil {
+		return err
+	}
+	v := reflect.ValueOf(attributes).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		structField := v.Type().Field(i)
+		jsonKey := strings.Split(structField.Tag.Get("json"), ",")[0]
+		if jsonKey == "-" {
+			continue
+		}
+		value, ok := attributes.ExtraConfig[jsonKey].(string)
+		if !ok {
+			continue
+		}
+		field := v.Field(i)
+		if field.Kind() == reflect.String {
+			field.SetString(value)
+		} else if field.Kind() == reflect.Bool {
+			boolVal, err := strconv.ParseBool(value)
+			if err == nil {
+				field.SetBool(boolVal)
+			}
+		}
+		delete(attributes.ExtraConfig, jsonKey)
+	}
+	return nil
+}
+
+func (attributes OpenidClientAttributes) MarshalJSON() ([]byte, error) {
+	out := map[string]interface{}{}
+
+	for k, v := range attributes.ExtraConfig {
+		out[k] = v
+	}
+	v := reflect.ValueOf(attributes)
+	for i := 0; i < v.NumField(); i++ {
+		jsonKey := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+		if jsonKey == "-" {
+			continue
+		}
+		field := v.Field(i)
+		if field.Kind() == reflect.String {
+			out[jsonKey] = field.String()
+		} else if field.Kind() == reflect.Bool {
+			out[jsonKey] = KeycloakBoolQuoted(field.Bool())
+		}
+	}
+	return json.Marshal(out)
 }
 
 func (keycloakClient *KeycloakClient) GetOpenidClientServiceAccountUserId(realmId, clientId string) (*User, error) {
@@ -79,6 +155,11 @@ func (keycloakClient *KeycloakClient) ValidateOpenidClient(client *OpenidClient)
 		return fmt.Errorf("validation error: service accounts (client credentials flow) cannot be enabled on public clients")
 	}
 
+	// keycloak silently enables service accounts for clients with authorization services, which would show up as a change
+	if client.AuthorizationServicesEnabled && !client.ServiceAccountsEnabled {
+		return fmt.Errorf("validation error: authorization services require service accounts to be enabled")
+	}
+
 	return nil
 }
 
@@ -113,7 +194,7 @@ func (keycloakClient *KeycloakClient) GetOpenidClients(realmId string, withSecre
 	var clients []*OpenidClient
 	var clientSecret OpenidClientSecret
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients", realmId), &clients, nil)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/clients", realmId), &clients, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +244,7 @@ func (keycloakClient *KeycloakClient) GetOpenidClientByClientId(realmId, clientI
 		"clientId": clientId,
 	}
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients", realmId), &clients, params)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/clients", realmId), &clients, params)
 	if err != nil {
 		return nil, err
 	}
@@ -14,6 +14,7 @@ type OpenidClientAuthorizationPermission struct {
 	DecisionStrategy string   `json:"decisionStrategy"`
 	Policies         []string `json:"policies"`
 	Resources        []string `json:"resources"`
+	Scopes           []string `json:"scopes,omitempty"`
 	Type             string   `json:"type"`
 }
 
@@ -76,3 +77,64 @@ func (keycloakClient *KeycloakClient) UpdateOpenidClientAuthorizationPermission(
 func (keycloakClient *KeycloakClient) DeleteOpenidClientAuthorizationPermission(realmId, resourceServerId, permissionId string) error {
 	return keycloakClient.delete(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s", realmId, resourceServerId, permissionId), nil)
 }
+
+func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationScopePermission(realm, resourceServerId, id string) (*OpenidClientAuthorizationPermission, error) {
+	permission := OpenidClientAuthorizationPermission{
+		RealmId:          realm,
+		ResourceServerId: resourceServerId,
+		Id:               id,
+	}
+
+	policies := []OpenidClientAuthorizationPolicy{}
+	resources := []OpenidClientAuthorizationResource{}
+	scopes := []OpenidClientAuthorizationScope{}
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", realm, resourceServerId, id), &permission, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s/associatedPolicies", realm, resourceServerId, id), &policies, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/resources", realm, resourceServerId, id), &resources, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/scopes", realm, resourceServerId, id), &scopes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies {
+		permission.Policies = append(permission.Policies, policy.Id)
+	}
+
+	for _, resource := range resources {
+		permission.Resources = append(permission.Resources, resource.Id)
+	}
+
+	for _, scope := range scopes {
+		permission.Scopes = append(permission.Scopes, scope.Id)
+	}
+
+	return &permission, nil
+}
+
+func (keycloakClient *KeycloakClient) NewOpenidClientAuthorizationScopePermission(permission *OpenidClientAuthorizationPermission) error {
+	permission.Type = "scope"
+
+	body, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope", permission.RealmId, permission.ResourceServerId), permission)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, &permission)
+}
+
+func (keycloakClient *KeycloakClient) UpdateOpenidClientAuthorizationScopePermission(permission *OpenidClientAuthorizationPermission) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", permission.RealmId, permission.ResourceServerId, permission.Id), permission)
+}
@@ -21,13 +21,17 @@ type OpenidClientAuthorizationPolicy struct {
 func (keycloakClient *KeycloakClient) GetClientAuthorizationPolicyByName(realmId, resourceServerId, name string) (*OpenidClientAuthorizationPolicy, error) {
 	policies := []OpenidClientAuthorizationPolicy{}
 	params := map[string]string{"name": name}
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy", realmId, resourceServerId), &policies, params)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy", realmId, resourceServerId), &policies, params)
 	if err != nil {
 		return nil, err
 	}
-	policy := policies[0]
-	policy.RealmId = realmId
-	policy.ResourceServerId = resourceServerId
-	policy.Name = name
-	return &policy, nil
+	// the name is matched partially, so the search can return other policies as well
+	for _, policy := range policies {
+		if policy.Name == name {
+			policy.RealmId = realmId
+			policy.ResourceServerId = resourceServerId
+			return &policy, nil
+		}
+	}
+	return nil, fmt.Errorf("no authorization policy with name %s found", name)
 }
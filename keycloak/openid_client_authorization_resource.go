@@ -46,15 +46,19 @@ func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationResource(realm
 func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationResourceByName(realmId, resourceServerId, name string) (*OpenidClientAuthorizationResource, error) {
 	resources := []OpenidClientAuthorizationResource{}
 	params := map[string]string{"name": name}
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource", realmId, resourceServerId), &resources, params)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource", realmId, resourceServerId), &resources, params)
 	if err != nil {
 		return nil, err
 	}
-	resource := resources[0]
-	resource.RealmId = realmId
-	resource.ResourceServerId = resourceServerId
-	resource.Name = name
-	return &resource, nil
+	// the name is matched partially, so the search can return other resources as well
+	for _, resource := range resources {
+		if resource.Name == name {
+			resource.RealmId = realmId
+			resource.ResourceServerId = resourceServerId
+			return &resource, nil
+		}
+	}
+	return nil, fmt.Errorf("no authorization resource with name %s found", name)
 }
 
 func (keycloakClient *KeycloakClient) UpdateOpenidClientAuthorizationResource(resource *OpenidClientAuthorizationResource) error {
@@ -0,0 +1,38 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+// like UsersPermissions, but for a single client. the scope permissions are also created on the realm's
+// `realm-management` client
+type OpenidClientPermissions struct {
+	RealmId          string            `json:"-"`
+	ClientId         string            `json:"-"`
+	Enabled          bool              `json:"enabled"`
+	Resource         string            `json:"resource,omitempty"`
+	ScopePermissions map[string]string `json:"scopePermissions,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) GetOpenidClientPermissions(realmId, clientId string) (*OpenidClientPermissions, error) {
+	var openidClientPermissions OpenidClientPermissions
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/management/permissions", realmId, clientId), &openidClientPermissions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	openidClientPermissions.RealmId = realmId
+	openidClientPermissions.ClientId = clientId
+
+	return &openidClientPermissions, nil
+}
+
+func (keycloakClient *KeycloakClient) EnableOpenidClientPermissions(realmId, clientId string) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/clients/%s/management/permissions", realmId, clientId), &OpenidClientPermissions{Enabled: true})
+}
+
+// disabling these permissions causes keycloak to delete the scope permissions that were created for them
+func (keycloakClient *KeycloakClient) DisableOpenidClientPermissions(realmId, clientId string) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/clients/%s/management/permissions", realmId, clientId), &OpenidClientPermissions{Enabled: false})
+}
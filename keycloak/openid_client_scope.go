@@ -13,6 +13,8 @@ type OpenidClientScope struct {
 	Attributes  struct {
 		DisplayOnConsentScreen string `json:"display.on.consent.screen"` // boolean in string form
 		ConsentScreenText      string `json:"consent.screen.text"`
+		GuiOrder               string `json:"gui.order"`
+		IncludeInTokenScope    string `json:"include.in.token.scope"` // boolean in string form
 	} `json:"attributes"`
 }
 
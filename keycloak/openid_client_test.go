@@ -0,0 +1,70 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOpenidClientAttributes_roundTrip(t *testing.T) {
+	body := `{
+		"pkce.code.challenge.method": "S256",
+		"exclude.session.state.from.auth.response": "true",
+		"access.token.lifespan": "300",
+		"display.on.consent.screen": "true",
+		"login_theme": "keycloak"
+	}`
+
+	var attributes OpenidClientAttributes
+
+	err := json.Unmarshal([]byte(body), &attributes)
+	if err != nil {
+		t.Fatalf("expected attributes to unmarshal, got %s", err)
+	}
+
+	if attributes.PkceCodeChallengeMethod != "S256" {
+		t.Errorf("expected pkce code challenge method S256, got %q", attributes.PkceCodeChallengeMethod)
+	}
+
+	if !attributes.ExcludeSessionStateFromAuthResponse {
+		t.Errorf("expected exclude session state from auth response to be true")
+	}
+
+	if attributes.AccessTokenLifespan != "300" {
+		t.Errorf("expected access token lifespan 300, got %q", attributes.AccessTokenLifespan)
+	}
+
+	if !attributes.DisplayOnConsentScreen {
+		t.Errorf("expected display on consent screen to be true")
+	}
+
+	if len(attributes.ExtraConfig) != 1 || attributes.ExtraConfig["login_theme"] != "keycloak" {
+		t.Errorf("expected only attributes without a field to be kept in extra config, got %v", attributes.ExtraConfig)
+	}
+
+	payload, err := json.Marshal(attributes)
+	if err != nil {
+		t.Fatalf("expected attributes to marshal, got %s", err)
+	}
+
+	var sent map[string]string
+
+	err = json.Unmarshal(payload, &sent)
+	if err != nil {
+		t.Fatalf("expected attributes to be sent as a map of strings, got %s", err)
+	}
+
+	expected := map[string]string{
+		"pkce.code.challenge.method":               "S256",
+		"exclude.session.state.from.auth.response": "true",
+		"access.token.lifespan":                    "300",
+		"display.on.consent.screen":                "true",
+		"login_theme":                              "keycloak",
+		"backchannel.logout.session.required":      "",
+	}
+
+	for key, value := range expected {
+		if sent[key] != value {
+			t.Errorf("expected attribute %s to be sent as %q, got %q", key, value, sent[key])
+		}
+	}
+}
@@ -12,7 +12,10 @@ type OpenIdHardcodedRoleProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
+	// the role can be given either by ID, or by name. client roles are named `<clientId>.<roleName>`, which is the
+	// format keycloak stores in the mapper's config
 	RoleId string
+	Role   string
 }
 
 var roleField = "role"
@@ -61,9 +64,24 @@ func (protocolMapper *protocolMapper) convertToOpenIdHardcodedRoleProtocolMapper
 		ClientScopeId: clientScopeId,
 
 		RoleId: roleId,
+		Role:   protocolMapper.Config[roleField],
 	}, nil
 }
 
+// returns the value of the mapper's `role` config, looking up the role when it was given by ID
+func (keycloakClient *KeycloakClient) getOpenIdHardcodedRoleProtocolMapperRoleProp(mapper *OpenIdHardcodedRoleProtocolMapper) (string, error) {
+	if mapper.RoleId == "" {
+		return mapper.Role, nil
+	}
+
+	role, err := keycloakClient.GetRole(mapper.RealmId, mapper.RoleId)
+	if err != nil {
+		return "", err
+	}
+
+	return keycloakClient.getRolePropFromRole(role)
+}
+
 func (keycloakClient *KeycloakClient) GetOpenIdHardcodedRoleProtocolMapper(realmId, clientId, clientScopeId, mapperId string) (*OpenIdHardcodedRoleProtocolMapper, error) {
 	var protocolMapper *protocolMapper
 
@@ -84,12 +102,19 @@ func (keycloakClient *KeycloakClient) GetOpenIdHardcodedRoleProtocolMapper(realm
 		roleClientUId = client.Id
 	}
 
+	// keycloak doesn't check that the role exists when the mapper is configured by name, so a missing role only means
+	// that the role ID can't be resolved
 	role, err := keycloakClient.GetRoleByName(realmId, roleClientUId, roleName)
-	if err != nil {
+	if err != nil && !ErrorIs404(err) {
 		return nil, err
 	}
 
-	return protocolMapper.convertToOpenIdHardcodedRoleProtocolMapper(realmId, clientId, clientScopeId, role.Id)
+	var roleId string
+	if role != nil {
+		roleId = role.Id
+	}
+
+	return protocolMapper.convertToOpenIdHardcodedRoleProtocolMapper(realmId, clientId, clientScopeId, roleId)
 }
 
 func (keycloakClient *KeycloakClient) DeleteOpenIdHardcodedRoleProtocolMapper(realmId, clientId, clientScopeId, mapperId string) error {
@@ -97,12 +122,7 @@ func (keycloakClient *KeycloakClient) DeleteOpenIdHardcodedRoleProtocolMapper(re
 }
 
 func (keycloakClient *KeycloakClient) NewOpenIdHardcodedRoleProtocolMapper(mapper *OpenIdHardcodedRoleProtocolMapper) error {
-	role, err := keycloakClient.GetRole(mapper.RealmId, mapper.RoleId)
-	if err != nil {
-		return err
-	}
-
-	roleProp, err := keycloakClient.getRolePropFromRole(role)
+	roleProp, err := keycloakClient.getOpenIdHardcodedRoleProtocolMapperRoleProp(mapper)
 	if err != nil {
 		return err
 	}
@@ -120,12 +140,7 @@ func (keycloakClient *KeycloakClient) NewOpenIdHardcodedRoleProtocolMapper(mappe
 }
 
 func (keycloakClient *KeycloakClient) UpdateOpenIdHardcodedRoleProtocolMapper(mapper *OpenIdHardcodedRoleProtocolMapper) error {
-	role, err := keycloakClient.GetRole(mapper.RealmId, mapper.RoleId)
-	if err != nil {
-		return err
-	}
-
-	roleProp, err := keycloakClient.getRolePropFromRole(role)
+	roleProp, err := keycloakClient.getOpenIdHardcodedRoleProtocolMapperRoleProp(mapper)
 	if err != nil {
 		return err
 	}
@@ -140,6 +155,10 @@ func (keycloakClient *KeycloakClient) ValidateOpenIdHardcodedRoleProtocolMapper(
 		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
 	}
 
+	if (mapper.RoleId == "") == (mapper.Role == "") {
+		return fmt.Errorf("validation error: exactly one of RoleId or Role must be set")
+	}
+
 	protocolMappers, err := keycloakClient.listGenericProtocolMappers(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
 	if err != nil {
 		return err
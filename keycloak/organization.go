@@ -0,0 +1,93 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+type OrganizationDomain struct {
+	Name     string `json:"name"`
+	Verified bool   `json:"verified"`
+}
+
+type Organization struct {
+	Id          string               `json:"id,omitempty"`
+	RealmId     string               `json:"-"`
+	Name        string               `json:"name"`
+	Alias       string               `json:"alias,omitempty"`
+	Enabled     bool                 `json:"enabled"`
+	Description string               `json:"description"`
+	Domains     []OrganizationDomain `json:"domains"`
+	Attributes  map[string][]string  `json:"attributes"`
+}
+
+// ValidateOrganizationsSupported returns an error for servers older than Keycloak 25, which don't have organizations
+func (keycloakClient *KeycloakClient) ValidateOrganizationsSupported() error {
+	majorVersion, err := keycloakClient.ServerMajorVersion()
+	if err != nil {
+		return err
+	}
+
+	if majorVersion < 25 {
+		return fmt.Errorf("organizations are not supported by keycloak %d, they were added in keycloak 25", majorVersion)
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) NewOrganization(organization *Organization) error {
+	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/organizations", organization.RealmId), organization)
+	if err != nil {
+		return err
+	}
+
+	organization.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetOrganization(realmId, id string) (*Organization, error) {
+	var organization Organization
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/organizations/%s", realmId, id), &organization, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	organization.RealmId = realmId
+
+	return &organization, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateOrganization(organization *Organization) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/organizations/%s", organization.RealmId, organization.Id), organization)
+}
+
+func (keycloakClient *KeycloakClient) DeleteOrganization(realmId, id string) error {
+	return keycloakClient.delete(fmt.Sprintf("/realms/%s/organizations/%s", realmId, id), nil)
+}
+
+func (keycloakClient *KeycloakClient) GetOrganizationMembers(realmId, organizationId string) ([]*User, error) {
+	var users []*User
+
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/organizations/%s/members", realmId, organizationId), &users, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		user.RealmId = realmId
+	}
+
+	return users, nil
+}
+
+// the body of this request is the user's ID as a JSON string, rather than an object
+func (keycloakClient *KeycloakClient) AddUserToOrganization(realmId, organizationId, userId string) error {
+	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/organizations/%s/members", realmId, organizationId), userId)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) RemoveUserFromOrganization(realmId, organizationId, userId string) error {
+	return keycloakClient.delete(fmt.Sprintf("/realms/%s/organizations/%s/members/%s", realmId, organizationId, userId), nil)
+}
@@ -0,0 +1,59 @@
+package keycloak
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestKeycloakClient_validateOrganizationsSupported(t *testing.T) {
+	tests := []struct {
+		version   string
+		supported bool
+	}{
+		{"24.0.5", false},
+		{"25.0.0", true},
+		{"26.1.2", true},
+	}
+
+	for _, test := range tests {
+		keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(fmt.Sprintf(`{"systemInfo": {"version": "%s"}}`, test.version)))
+		})
+
+		err := keycloakClient.ValidateOrganizationsSupported()
+		server.Close()
+
+		if test.supported && err != nil {
+			t.Errorf("expected organizations to be supported by keycloak %s, got %s", test.version, err)
+		}
+
+		if !test.supported && err == nil {
+			t.Errorf("expected organizations to not be supported by keycloak %s", test.version)
+		}
+	}
+}
+
+// the organization members endpoint expects the user's ID as a JSON string
+func TestKeycloakClient_addUserToOrganizationSendsUserIdAsString(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/auth/admin/realms/my-realm/organizations/my-organization/members" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `"my-user"` {
+			t.Errorf("expected the body to be the user's ID as a JSON string, got %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	})
+	defer server.Close()
+
+	err := keycloakClient.AddUserToOrganization("my-realm", "my-organization", "my-user")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
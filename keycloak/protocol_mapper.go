@@ -14,6 +14,7 @@ type protocolMapper struct {
 var (
 	addToAccessTokenField               = "access.token.claim"
 	addToIdTokenField                   = "id.token.claim"
+	addToIntrospectionTokenField        = "introspection.token.claim"
 	addToUserInfoField                  = "userinfo.token.claim"
 	attributeNameField                  = "attribute.name"
 	attributeNameFormatField            = "attribute.nameformat"
@@ -0,0 +1,111 @@
+package keycloak
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// how long a cached response can be used before it needs to be fetched again
+const readCacheTtl = time.Minute
+
+// only role and client listings are cached. these are looked up repeatedly when many resources reference the same
+// roles and clients, and are otherwise only changed by requests that go through this client
+var cacheablePaths = regexp.MustCompile(`^/realms/[^/]+/(roles|clients|clients/[^/]+/roles)$`)
+
+// realmPattern extracts the realm from an admin API path, ex: /auth/admin/realms/foo/users => foo. the path keycloak is
+// served under can be anything, so it isn't anchored to the start of the path
+var realmPattern = regexp.MustCompile(`^.*?` + apiUrl + `/realms/([^/]+)`)
+
+type readCacheEntry struct {
+	realm   string
+	body    []byte
+	expires time.Time
+}
+
+// readCache is an in-memory cache of GET responses, used when the provider's `enable_read_cache` attribute is set.
+// Any write within a realm invalidates all cached responses for that realm.
+// A response is only stored if nothing was invalidated while it was being fetched, which is tracked by `generation`.
+// Otherwise a read that was sent before a write, but finished after it, could store what the write had just changed.
+type readCache struct {
+	mutex      sync.Mutex
+	entries    map[string]readCacheEntry
+	generation uint64
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		entries: make(map[string]readCacheEntry),
+	}
+}
+
+func isCacheablePath(path string) bool {
+	return cacheablePaths.MatchString(path)
+}
+
+func realmFromRequestPath(requestPath string) string {
+	matches := realmPattern.FindStringSubmatch(requestPath)
+	if matches == nil {
+		return ""
+	}
+
+	return matches[1]
+}
+
+func (c *readCache) get(key string) ([]byte, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+
+		return nil, false
+	}
+
+	return entry.body, true
+}
+
+// returns the current generation, which is passed to `set` once the response has been fetched
+func (c *readCache) currentGeneration() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.generation
+}
+
+// stores a response, unless the cache was invalidated since `generation` was returned by `currentGeneration`
+func (c *readCache) set(key, realm string, body []byte, generation uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	c.entries[key] = readCacheEntry{
+		realm:   realm,
+		body:    body,
+		expires: time.Now().Add(readCacheTtl),
+	}
+}
+
+// removes every cached response for `realm`. if `realm` is empty, the write wasn't scoped to a realm (such as creating
+// a new realm), so everything is removed
+func (c *readCache) invalidate(realm string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.generation++
+
+	for key, entry := range c.entries {
+		if realm == "" || strings.EqualFold(entry.realm, realm) {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package keycloak
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func newRolesHandler(requests map[string]int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`[{"id": "role-id", "name": "role"}]`))
+		} else {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+func TestReadCache_cachesRoleLists(t *testing.T) {
+	requests := make(map[string]int)
+
+	keycloakClient, server := newTestKeycloakClient(t, newRolesHandler(requests))
+	defer server.Close()
+
+	keycloakClient.readCache = newReadCache()
+
+	for i := 0; i < 3; i++ {
+		roles, err := keycloakClient.ListRoles("foo", "")
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(roles) != 1 || roles[0].Id != "role-id" {
+			t.Fatalf("expected the cached role to be returned, got %v", roles)
+		}
+	}
+
+	if requests["GET /auth/admin/realms/foo/roles"] != 1 {
+		t.Fatalf("expected 1 request to list roles, got %d", requests["GET /auth/admin/realms/foo/roles"])
+	}
+}
+
+func TestReadCache_invalidatedByWrites(t *testing.T) {
+	requests := make(map[string]int)
+
+	keycloakClient, server := newTestKeycloakClient(t, newRolesHandler(requests))
+	defer server.Close()
+
+	keycloakClient.readCache = newReadCache()
+
+	_, err := keycloakClient.ListRoles("foo", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	_, err = keycloakClient.ListRoles("bar", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	// a write in one realm should only invalidate that realm's responses
+	err = keycloakClient.AddRealmRolesToUser("foo", "user-id", []*Role{{Id: "role-id", Name: "role"}})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	_, err = keycloakClient.ListRoles("foo", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	_, err = keycloakClient.ListRoles("bar", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if requests["GET /auth/admin/realms/foo/roles"] != 2 {
+		t.Fatalf("expected roles for realm foo to be fetched again after a write, got %d requests", requests["GET /auth/admin/realms/foo/roles"])
+	}
+
+	if requests["GET /auth/admin/realms/bar/roles"] != 1 {
+		t.Fatalf("expected roles for realm bar to remain cached, got %d requests", requests["GET /auth/admin/realms/bar/roles"])
+	}
+}
+
+// a read that is sent before a write, but finishes after the write invalidated the cache, may have returned what the
+// write just changed, so its response isn't stored
+func TestReadCache_invalidatedDuringRead(t *testing.T) {
+	var requests int32
+	started := make(chan struct{})
+	proceed := make(chan struct{})
+	var once sync.Once
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		// only the first request waits for the cache to be invalidated
+		once.Do(func() {
+			close(started)
+			<-proceed
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "role-id", "name": "role"}]`))
+	})
+	defer server.Close()
+
+	keycloakClient.readCache = newReadCache()
+
+	errs := make(chan error)
+	go func() {
+		_, err := keycloakClient.ListRoles("foo", "")
+		errs <- err
+	}()
+
+	<-started
+	keycloakClient.readCache.invalidate("foo")
+	close(proceed)
+
+	if err := <-errs; err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	_, err := keycloakClient.ListRoles("foo", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if atomic.LoadInt32(&requests) != 2 {
+		t.Fatalf("expected the response that was fetched during the invalidation not to be cached, got %d requests", requests)
+	}
+}
+
+func TestReadCache_onlyCachesRolesAndClients(t *testing.T) {
+	cacheable := map[string]bool{
+		"/realms/foo/roles":                   true,
+		"/realms/foo/clients":                 true,
+		"/realms/foo/clients/abc/roles":       true,
+		"/realms/foo/users":                   false,
+		"/realms/foo/users/abc":               false,
+		"/realms/foo/roles-by-id/abc":         false,
+		"/realms/foo/clients/abc":             false,
+		"/realms/foo/users/abc/role-mappings": false,
+	}
+
+	for path, expected := range cacheable {
+		if isCacheablePath(path) != expected {
+			t.Fatalf("expected isCacheablePath(%s) to be %t", path, expected)
+		}
+	}
+}
@@ -26,6 +26,9 @@ type Realm struct {
 	Enabled     bool   `json:"enabled"`
 	DisplayName string `json:"displayName"`
 
+	// not managed by the keycloak_realm resource, so it's only sent when it's set
+	DefaultSignatureAlgorithm string `json:"defaultSignatureAlgorithm,omitempty"`
+
 	// Login Config
 	RegistrationAllowed         bool   `json:"registrationAllowed"`
 	RegistrationEmailAsUsername bool   `json:"registrationEmailAsUsername"`
@@ -82,6 +85,38 @@ type Realm struct {
 
 	PasswordPolicy string `json:"passwordPolicy"`
 
+	//otp policy
+	OtpPolicyType            string   `json:"otpPolicyType,omitempty"`
+	OtpPolicyAlgorithm       string   `json:"otpPolicyAlgorithm,omitempty"`
+	OtpPolicyDigits          int      `json:"otpPolicyDigits,omitempty"`
+	OtpPolicyPeriod          int      `json:"otpPolicyPeriod,omitempty"`
+	OtpPolicyLookAheadWindow int      `json:"otpPolicyLookAheadWindow"`
+	OtpPolicyInitialCounter  int      `json:"otpPolicyInitialCounter"`
+	OtpSupportedApplications []string `json:"otpSupportedApplications,omitempty"` // computed by keycloak from the policy
+
+	//webauthn policies. keycloak uses its own defaults for the values that aren't sent
+	WebAuthnPolicyRpEntityName                    string   `json:"webAuthnPolicyRpEntityName,omitempty"`
+	WebAuthnPolicySignatureAlgorithms             []string `json:"webAuthnPolicySignatureAlgorithms,omitempty"`
+	WebAuthnPolicyRpId                            string   `json:"webAuthnPolicyRpId,omitempty"`
+	WebAuthnPolicyAttestationConveyancePreference string   `json:"webAuthnPolicyAttestationConveyancePreference,omitempty"`
+	WebAuthnPolicyAuthenticatorAttachment         string   `json:"webAuthnPolicyAuthenticatorAttachment,omitempty"`
+	WebAuthnPolicyRequireResidentKey              string   `json:"webAuthnPolicyRequireResidentKey,omitempty"`
+	WebAuthnPolicyUserVerificationRequirement     string   `json:"webAuthnPolicyUserVerificationRequirement,omitempty"`
+	WebAuthnPolicyCreateTimeout                   int      `json:"webAuthnPolicyCreateTimeout,omitempty"`
+	WebAuthnPolicyAvoidSameAuthenticatorRegister  bool     `json:"webAuthnPolicyAvoidSameAuthenticatorRegister,omitempty"`
+	WebAuthnPolicyAcceptableAaguids               []string `json:"webAuthnPolicyAcceptableAaguids,omitempty"`
+
+	WebAuthnPolicyPasswordlessRpEntityName                    string   `json:"webAuthnPolicyPasswordlessRpEntityName,omitempty"`
+	WebAuthnPolicyPasswordlessSignatureAlgorithms             []string `json:"webAuthnPolicyPasswordlessSignatureAlgorithms,omitempty"`
+	WebAuthnPolicyPasswordlessRpId                            string   `json:"webAuthnPolicyPasswordlessRpId,omitempty"`
+	WebAuthnPolicyPasswordlessAttestationConveyancePreference string   `json:"webAuthnPolicyPasswordlessAttestationConveyancePreference,omitempty"`
+	WebAuthnPolicyPasswordlessAuthenticatorAttachment         string   `json:"webAuthnPolicyPasswordlessAuthenticatorAttachment,omitempty"`
+	WebAuthnPolicyPasswordlessRequireResidentKey              string   `json:"webAuthnPolicyPasswordlessRequireResidentKey,omitempty"`
+	WebAuthnPolicyPasswordlessUserVerificationRequirement     string   `json:"webAuthnPolicyPasswordlessUserVerificationRequirement,omitempty"`
+	WebAuthnPolicyPasswordlessCreateTimeout                   int      `json:"webAuthnPolicyPasswordlessCreateTimeout,omitempty"`
+	WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister  bool     `json:"webAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister,omitempty"`
+	WebAuthnPolicyPasswordlessAcceptableAaguids               []string `json:"webAuthnPolicyPasswordlessAcceptableAaguids,omitempty"`
+
 	//flow bindings
 	BrowserFlow              string `json:"browserFlow,omitempty"`
 	RegistrationFlow         string `json:"registrationFlow,omitempty"`
@@ -132,6 +167,27 @@ func (keycloakClient *KeycloakClient) GetRealm(id string) (*Realm, error) {
 	return &realm, nil
 }
 
+// ValidateRealmExists returns a clear error when the realm doesn't exist, instead of the 404s that every other request
+// within it would return. Realms that exist are remembered, so this only makes a request once per realm.
+func (keycloakClient *KeycloakClient) ValidateRealmExists(realmId string) error {
+	if _, ok := keycloakClient.existingRealms.Load(realmId); ok {
+		return nil
+	}
+
+	_, err := keycloakClient.GetRealm(realmId)
+	if err != nil {
+		if ErrorIs404(err) {
+			return fmt.Errorf("realm %s does not exist", realmId)
+		}
+
+		return err
+	}
+
+	keycloakClient.existingRealms.Store(realmId, true)
+
+	return nil
+}
+
 func (keycloakClient *KeycloakClient) GetRealms() ([]*Realm, error) {
 	var realms []*Realm
 
@@ -159,6 +215,8 @@ func (keycloakClient *KeycloakClient) UpdateRealm(realm *Realm) error {
 }
 
 func (keycloakClient *KeycloakClient) DeleteRealm(id string) error {
+	keycloakClient.existingRealms.Delete(id)
+
 	err := keycloakClient.delete(fmt.Sprintf("/realms/%s", id), nil)
 	if err != nil {
 		// For whatever reason, this fails sometimes with a 500 during acceptance tests. try again
@@ -0,0 +1,84 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+type RealmClientPolicyExecutor struct {
+	Executor      string                 `json:"executor"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type RealmClientProfile struct {
+	Name        string                       `json:"name"`
+	Description string                       `json:"description,omitempty"`
+	Executors   []*RealmClientPolicyExecutor `json:"executors"`
+}
+
+// the global profiles are built into keycloak and can't be changed, so they're only ever read
+type RealmClientProfiles struct {
+	Profiles       []*RealmClientProfile `json:"profiles"`
+	GlobalProfiles []*RealmClientProfile `json:"globalProfiles,omitempty"`
+}
+
+type RealmClientPolicyCondition struct {
+	Condition     string                 `json:"condition"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type RealmClientPolicy struct {
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	Enabled     bool                          `json:"enabled"`
+	Conditions  []*RealmClientPolicyCondition `json:"conditions"`
+	Profiles    []string                      `json:"profiles"`
+}
+
+type RealmClientPolicies struct {
+	Policies       []*RealmClientPolicy `json:"policies"`
+	GlobalPolicies []*RealmClientPolicy `json:"globalPolicies,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) GetRealmClientProfiles(realmId string) (*RealmClientProfiles, error) {
+	var realmClientProfiles RealmClientProfiles
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/client-policies/profiles", realmId), &realmClientProfiles, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realmClientProfiles, nil
+}
+
+// the profiles are replaced as a whole, so any profile that isn't passed here is deleted
+func (keycloakClient *KeycloakClient) UpdateRealmClientProfiles(realmId string, profiles []*RealmClientProfile) error {
+	if profiles == nil {
+		profiles = []*RealmClientProfile{}
+	}
+
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/client-policies/profiles", realmId), &RealmClientProfiles{
+		Profiles: profiles,
+	})
+}
+
+func (keycloakClient *KeycloakClient) GetRealmClientPolicies(realmId string) (*RealmClientPolicies, error) {
+	var realmClientPolicies RealmClientPolicies
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/client-policies/policies", realmId), &realmClientPolicies, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realmClientPolicies, nil
+}
+
+// the policies are replaced as a whole, so any policy that isn't passed here is deleted
+func (keycloakClient *KeycloakClient) UpdateRealmClientPolicies(realmId string, policies []*RealmClientPolicy) error {
+	if policies == nil {
+		policies = []*RealmClientPolicy{}
+	}
+
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/client-policies/policies", realmId), &RealmClientPolicies{
+		Policies: policies,
+	})
+}
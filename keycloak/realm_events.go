@@ -0,0 +1,29 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+type RealmEventsConfig struct {
+	EventsEnabled             bool     `json:"eventsEnabled"`
+	EventsExpiration          int      `json:"eventsExpiration,omitempty"` // in seconds. events are kept forever when this is 0
+	EnabledEventTypes         []string `json:"enabledEventTypes"`
+	AdminEventsEnabled        bool     `json:"adminEventsEnabled"`
+	AdminEventsDetailsEnabled bool     `json:"adminEventsDetailsEnabled"`
+	EventsListeners           []string `json:"eventsListeners"`
+}
+
+func (keycloakClient *KeycloakClient) GetRealmEventsConfig(realmId string) (*RealmEventsConfig, error) {
+	var realmEventsConfig RealmEventsConfig
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/events/config", realmId), &realmEventsConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realmEventsConfig, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmEventsConfig(realmId string, realmEventsConfig *RealmEventsConfig) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/events/config", realmId), realmEventsConfig)
+}
@@ -0,0 +1,86 @@
+package keycloak
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// realm keys are managed by key provider components, which are children of the realm they provide keys for
+var keyProviderType = "org.keycloak.keys.KeyProvider"
+
+// every key provider has these settings, alongside the settings that are specific to the kind of key it provides
+type realmKeystoreSettings struct {
+	Active   bool
+	Enabled  bool
+	Priority int
+}
+
+func (settings realmKeystoreSettings) toComponentConfig() map[string][]string {
+	return map[string][]string{
+		"active": {
+			strconv.FormatBool(settings.Active),
+		},
+		"enabled": {
+			strconv.FormatBool(settings.Enabled),
+		},
+		"priority": {
+			strconv.Itoa(settings.Priority),
+		},
+	}
+}
+
+func getRealmKeystoreSettingsFromComponent(component *component) (realmKeystoreSettings, error) {
+	active, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("active"))
+	if err != nil {
+		return realmKeystoreSettings{}, err
+	}
+
+	enabled, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("enabled"))
+	if err != nil {
+		return realmKeystoreSettings{}, err
+	}
+
+	priority, err := atoiAndTreatEmptyStringAsZero(component.getConfig("priority"))
+	if err != nil {
+		return realmKeystoreSettings{}, err
+	}
+
+	return realmKeystoreSettings{
+		Active:   active,
+		Enabled:  enabled,
+		Priority: priority,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) newRealmKeystore(realmId string, component *component) (string, error) {
+	_, location, err := keycloakClient.post(fmt.Sprintf("/realms/%s/components", realmId), component)
+	if err != nil {
+		return "", err
+	}
+
+	return getIdFromLocationHeader(location), nil
+}
+
+func (keycloakClient *KeycloakClient) getRealmKeystore(realmId, id, providerId string) (*component, error) {
+	var component *component
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the components endpoint returns any kind of component, so make sure this is the kind of key provider that was asked for
+	if component.ProviderType != keyProviderType || component.ProviderId != providerId {
+		return nil, fmt.Errorf("component with id %s in realm %s is not a key provider of type %s", id, realmId, providerId)
+	}
+
+	return component, nil
+}
+
+func (keycloakClient *KeycloakClient) updateRealmKeystore(realmId string, component *component) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/components/%s", realmId, component.Id), component)
+}
+
+func (keycloakClient *KeycloakClient) deleteRealmKeystore(realmId, id string) error {
+	return keycloakClient.delete(fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
@@ -0,0 +1,87 @@
+package keycloak
+
+import "strconv"
+
+type RealmKeystoreAesGenerated struct {
+	Id      string
+	Name    string
+	RealmId string
+
+	Active   bool
+	Enabled  bool
+	Priority int
+
+	SecretSize int
+}
+
+func convertFromRealmKeystoreAesGeneratedToComponent(keystore *RealmKeystoreAesGenerated) *component {
+	settings := realmKeystoreSettings{
+		Active:   keystore.Active,
+		Enabled:  keystore.Enabled,
+		Priority: keystore.Priority,
+	}
+
+	config := settings.toComponentConfig()
+	config["secretSize"] = []string{strconv.Itoa(keystore.SecretSize)}
+
+	return &component{
+		Id:           keystore.Id,
+		Name:         keystore.Name,
+		ProviderId:   "aes-generated",
+		ProviderType: keyProviderType,
+		ParentId:     keystore.RealmId,
+		Config:       config,
+	}
+}
+
+func convertFromComponentToRealmKeystoreAesGenerated(component *component, realmId string) (*RealmKeystoreAesGenerated, error) {
+	settings, err := getRealmKeystoreSettingsFromComponent(component)
+	if err != nil {
+		return nil, err
+	}
+
+	secretSize, err := atoiAndTreatEmptyStringAsZero(component.getConfig("secretSize"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &RealmKeystoreAesGenerated{
+		Id:      component.Id,
+		Name:    component.Name,
+		RealmId: realmId,
+
+		Active:   settings.Active,
+		Enabled:  settings.Enabled,
+		Priority: settings.Priority,
+
+		SecretSize: secretSize,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmKeystoreAesGenerated(keystore *RealmKeystoreAesGenerated) error {
+	id, err := keycloakClient.newRealmKeystore(keystore.RealmId, convertFromRealmKeystoreAesGeneratedToComponent(keystore))
+	if err != nil {
+		return err
+	}
+
+	keystore.Id = id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmKeystoreAesGenerated(realmId, id string) (*RealmKeystoreAesGenerated, error) {
+	component, err := keycloakClient.getRealmKeystore(realmId, id, "aes-generated")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmKeystoreAesGenerated(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmKeystoreAesGenerated(keystore *RealmKeystoreAesGenerated) error {
+	return keycloakClient.updateRealmKeystore(keystore.RealmId, convertFromRealmKeystoreAesGeneratedToComponent(keystore))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmKeystoreAesGenerated(realmId, id string) error {
+	return keycloakClient.deleteRealmKeystore(realmId, id)
+}
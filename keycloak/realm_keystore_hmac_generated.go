@@ -0,0 +1,90 @@
+package keycloak
+
+import "strconv"
+
+type RealmKeystoreHmacGenerated struct {
+	Id      string
+	Name    string
+	RealmId string
+
+	Active   bool
+	Enabled  bool
+	Priority int
+
+	Algorithm  string
+	SecretSize int
+}
+
+func convertFromRealmKeystoreHmacGeneratedToComponent(keystore *RealmKeystoreHmacGenerated) *component {
+	settings := realmKeystoreSettings{
+		Active:   keystore.Active,
+		Enabled:  keystore.Enabled,
+		Priority: keystore.Priority,
+	}
+
+	config := settings.toComponentConfig()
+	config["algorithm"] = []string{keystore.Algorithm}
+	config["secretSize"] = []string{strconv.Itoa(keystore.SecretSize)}
+
+	return &component{
+		Id:           keystore.Id,
+		Name:         keystore.Name,
+		ProviderId:   "hmac-generated",
+		ProviderType: keyProviderType,
+		ParentId:     keystore.RealmId,
+		Config:       config,
+	}
+}
+
+func convertFromComponentToRealmKeystoreHmacGenerated(component *component, realmId string) (*RealmKeystoreHmacGenerated, error) {
+	settings, err := getRealmKeystoreSettingsFromComponent(component)
+	if err != nil {
+		return nil, err
+	}
+
+	secretSize, err := atoiAndTreatEmptyStringAsZero(component.getConfig("secretSize"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &RealmKeystoreHmacGenerated{
+		Id:      component.Id,
+		Name:    component.Name,
+		RealmId: realmId,
+
+		Active:   settings.Active,
+		Enabled:  settings.Enabled,
+		Priority: settings.Priority,
+
+		Algorithm:  component.getConfig("algorithm"),
+		SecretSize: secretSize,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmKeystoreHmacGenerated(keystore *RealmKeystoreHmacGenerated) error {
+	id, err := keycloakClient.newRealmKeystore(keystore.RealmId, convertFromRealmKeystoreHmacGeneratedToComponent(keystore))
+	if err != nil {
+		return err
+	}
+
+	keystore.Id = id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmKeystoreHmacGenerated(realmId, id string) (*RealmKeystoreHmacGenerated, error) {
+	component, err := keycloakClient.getRealmKeystore(realmId, id, "hmac-generated")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmKeystoreHmacGenerated(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmKeystoreHmacGenerated(keystore *RealmKeystoreHmacGenerated) error {
+	return keycloakClient.updateRealmKeystore(keystore.RealmId, convertFromRealmKeystoreHmacGeneratedToComponent(keystore))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmKeystoreHmacGenerated(realmId, id string) error {
+	return keycloakClient.deleteRealmKeystore(realmId, id)
+}
@@ -0,0 +1,88 @@
+package keycloak
+
+type RealmKeystoreRsa struct {
+	Id      string
+	Name    string
+	RealmId string
+
+	Active   bool
+	Enabled  bool
+	Priority int
+
+	Algorithm   string
+	PrivateKey  string
+	Certificate string
+}
+
+func convertFromRealmKeystoreRsaToComponent(keystore *RealmKeystoreRsa) *component {
+	settings := realmKeystoreSettings{
+		Active:   keystore.Active,
+		Enabled:  keystore.Enabled,
+		Priority: keystore.Priority,
+	}
+
+	config := settings.toComponentConfig()
+	config["algorithm"] = []string{keystore.Algorithm}
+	config["privateKey"] = []string{keystore.PrivateKey}
+	if keystore.Certificate != "" {
+		config["certificate"] = []string{keystore.Certificate}
+	}
+
+	return &component{
+		Id:           keystore.Id,
+		Name:         keystore.Name,
+		ProviderId:   "rsa",
+		ProviderType: keyProviderType,
+		ParentId:     keystore.RealmId,
+		Config:       config,
+	}
+}
+
+// keycloak never returns the private key, so it is left empty here
+func convertFromComponentToRealmKeystoreRsa(component *component, realmId string) (*RealmKeystoreRsa, error) {
+	settings, err := getRealmKeystoreSettingsFromComponent(component)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RealmKeystoreRsa{
+		Id:      component.Id,
+		Name:    component.Name,
+		RealmId: realmId,
+
+		Active:   settings.Active,
+		Enabled:  settings.Enabled,
+		Priority: settings.Priority,
+
+		Algorithm:   component.getConfig("algorithm"),
+		Certificate: component.getConfig("certificate"),
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmKeystoreRsa(keystore *RealmKeystoreRsa) error {
+	id, err := keycloakClient.newRealmKeystore(keystore.RealmId, convertFromRealmKeystoreRsaToComponent(keystore))
+	if err != nil {
+		return err
+	}
+
+	keystore.Id = id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmKeystoreRsa(realmId, id string) (*RealmKeystoreRsa, error) {
+	component, err := keycloakClient.getRealmKeystore(realmId, id, "rsa")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmKeystoreRsa(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmKeystoreRsa(keystore *RealmKeystoreRsa) error {
+	return keycloakClient.updateRealmKeystore(keystore.RealmId, convertFromRealmKeystoreRsaToComponent(keystore))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmKeystoreRsa(realmId, id string) error {
+	return keycloakClient.deleteRealmKeystore(realmId, id)
+}
@@ -0,0 +1,90 @@
+package keycloak
+
+import "strconv"
+
+type RealmKeystoreRsaGenerated struct {
+	Id      string
+	Name    string
+	RealmId string
+
+	Active   bool
+	Enabled  bool
+	Priority int
+
+	Algorithm string
+	KeySize   int
+}
+
+func convertFromRealmKeystoreRsaGeneratedToComponent(keystore *RealmKeystoreRsaGenerated) *component {
+	settings := realmKeystoreSettings{
+		Active:   keystore.Active,
+		Enabled:  keystore.Enabled,
+		Priority: keystore.Priority,
+	}
+
+	config := settings.toComponentConfig()
+	config["algorithm"] = []string{keystore.Algorithm}
+	config["keySize"] = []string{strconv.Itoa(keystore.KeySize)}
+
+	return &component{
+		Id:           keystore.Id,
+		Name:         keystore.Name,
+		ProviderId:   "rsa-generated",
+		ProviderType: keyProviderType,
+		ParentId:     keystore.RealmId,
+		Config:       config,
+	}
+}
+
+func convertFromComponentToRealmKeystoreRsaGenerated(component *component, realmId string) (*RealmKeystoreRsaGenerated, error) {
+	settings, err := getRealmKeystoreSettingsFromComponent(component)
+	if err != nil {
+		return nil, err
+	}
+
+	keySize, err := atoiAndTreatEmptyStringAsZero(component.getConfig("keySize"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &RealmKeystoreRsaGenerated{
+		Id:      component.Id,
+		Name:    component.Name,
+		RealmId: realmId,
+
+		Active:   settings.Active,
+		Enabled:  settings.Enabled,
+		Priority: settings.Priority,
+
+		Algorithm: component.getConfig("algorithm"),
+		KeySize:   keySize,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmKeystoreRsaGenerated(keystore *RealmKeystoreRsaGenerated) error {
+	id, err := keycloakClient.newRealmKeystore(keystore.RealmId, convertFromRealmKeystoreRsaGeneratedToComponent(keystore))
+	if err != nil {
+		return err
+	}
+
+	keystore.Id = id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmKeystoreRsaGenerated(realmId, id string) (*RealmKeystoreRsaGenerated, error) {
+	component, err := keycloakClient.getRealmKeystore(realmId, id, "rsa-generated")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmKeystoreRsaGenerated(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmKeystoreRsaGenerated(keystore *RealmKeystoreRsaGenerated) error {
+	return keycloakClient.updateRealmKeystore(keystore.RealmId, convertFromRealmKeystoreRsaGeneratedToComponent(keystore))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmKeystoreRsaGenerated(realmId, id string) error {
+	return keycloakClient.deleteRealmKeystore(realmId, id)
+}
@@ -0,0 +1,54 @@
+package keycloak
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestKeycloakClient_getRealmKeystore(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/auth/admin/realms/test/components/rsa-generated-id":
+			w.Write([]byte(`{"id": "rsa-generated-id", "name": "rsa", "providerId": "rsa-generated", "providerType": "org.keycloak.keys.KeyProvider", "parentId": "test", "config": {"active": ["true"], "enabled": ["false"], "priority": ["100"], "algorithm": ["RS256"], "keySize": ["2048"]}}`))
+		case "/auth/admin/realms/test/components/ldap-id":
+			w.Write([]byte(`{"id": "ldap-id", "name": "ldap", "providerId": "ldap", "providerType": "org.keycloak.storage.UserStorageProvider", "parentId": "test", "config": {}}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	keystore, err := keycloakClient.GetRealmKeystoreRsaGenerated("test", "rsa-generated-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := RealmKeystoreRsaGenerated{
+		Id:        "rsa-generated-id",
+		Name:      "rsa",
+		RealmId:   "test",
+		Active:    true,
+		Enabled:   false,
+		Priority:  100,
+		Algorithm: "RS256",
+		KeySize:   2048,
+	}
+	if *keystore != expected {
+		t.Fatalf("expected %+v, got %+v", expected, *keystore)
+	}
+
+	// a keystore of another kind, or a component that isn't a keystore at all, can't be read as this kind of keystore
+	_, err = keycloakClient.GetRealmKeystoreHmacGenerated("test", "rsa-generated-id")
+	if err == nil || !strings.Contains(err.Error(), "is not a key provider of type hmac-generated") {
+		t.Fatalf("expected an error for a keystore of another type, got %v", err)
+	}
+
+	_, err = keycloakClient.GetRealmKeystoreRsa("test", "ldap-id")
+	if err == nil || !strings.Contains(err.Error(), "is not a key provider of type rsa") {
+		t.Fatalf("expected an error for a component that isn't a keystore, got %v", err)
+	}
+}
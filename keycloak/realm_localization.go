@@ -0,0 +1,38 @@
+package keycloak
+
+import (
+	"fmt"
+	"net/url"
+)
+
+func realmLocalizationUrl(realmId, locale string) string {
+	return fmt.Sprintf("/realms/%s/localization/%s", realmId, url.PathEscape(locale))
+}
+
+// returns every custom text for the locale, keyed by message key. locales without any texts return an empty map
+func (keycloakClient *KeycloakClient) GetRealmLocalizationTexts(realmId, locale string) (map[string]string, error) {
+	texts := make(map[string]string)
+
+	err := keycloakClient.get(realmLocalizationUrl(realmId, locale), &texts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return texts, nil
+}
+
+// adds or replaces the given texts in a single request. texts for other keys are left as they are
+func (keycloakClient *KeycloakClient) UpdateRealmLocalizationTexts(realmId, locale string, texts map[string]string) error {
+	_, _, err := keycloakClient.post(realmLocalizationUrl(realmId, locale), texts)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmLocalizationText(realmId, locale, key string) error {
+	return keycloakClient.delete(fmt.Sprintf("%s/%s", realmLocalizationUrl(realmId, locale), url.PathEscape(key)), nil)
+}
+
+// removes every custom text for the locale
+func (keycloakClient *KeycloakClient) DeleteRealmLocalizationTexts(realmId, locale string) error {
+	return keycloakClient.delete(realmLocalizationUrl(realmId, locale), nil)
+}
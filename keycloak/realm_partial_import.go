@@ -0,0 +1,64 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// https://www.keycloak.org/docs-api/8.0/rest-api/index.html#_partialimportrepresentation
+
+type PartialImportUser struct {
+	Username   string              `json:"username"`
+	Email      string              `json:"email,omitempty"`
+	FirstName  string              `json:"firstName,omitempty"`
+	LastName   string              `json:"lastName,omitempty"`
+	Enabled    bool                `json:"enabled"`
+	Attributes map[string][]string `json:"attributes,omitempty"`
+	RealmRoles []string            `json:"realmRoles,omitempty"`
+	Groups     []string            `json:"groups,omitempty"`
+}
+
+type PartialImportRoles struct {
+	Realm []*Role `json:"realm,omitempty"`
+}
+
+type PartialImport struct {
+	RealmId string `json:"-"`
+
+	// one of FAIL, SKIP, or OVERWRITE
+	IfResourceExists string               `json:"ifResourceExists"`
+	Users            []*PartialImportUser `json:"users,omitempty"`
+	Groups           []*Group             `json:"groups,omitempty"`
+	Roles            *PartialImportRoles  `json:"roles,omitempty"`
+}
+
+type PartialImportResourceResult struct {
+	Action       string `json:"action"`
+	ResourceType string `json:"resourceType"`
+	ResourceName string `json:"resourceName"`
+	Id           string `json:"id"`
+}
+
+type PartialImportResult struct {
+	Added       int                            `json:"added"`
+	Skipped     int                            `json:"skipped"`
+	Overwritten int                            `json:"overwritten"`
+	Results     []*PartialImportResourceResult `json:"results"`
+}
+
+// imports many users, groups, and roles into a realm with a single request. with FAIL, nothing is imported if any of
+// them already exist
+func (keycloakClient *KeycloakClient) PartialImport(partialImport *PartialImport) (*PartialImportResult, error) {
+	body, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/partialImport", partialImport.RealmId), partialImport)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *PartialImportResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
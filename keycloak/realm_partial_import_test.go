@@ -0,0 +1,72 @@
+package keycloak
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestKeycloakClient_partialImport(t *testing.T) {
+	var requestBody string
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/auth/admin/realms/test/partialImport" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		requestBody = string(body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"overwritten": 0, "added": 2, "skipped": 1, "results": [
+			{"action": "ADDED", "resourceType": "USER", "resourceName": "alice", "id": "alice-id"},
+			{"action": "ADDED", "resourceType": "REALM_ROLE", "resourceName": "admin", "id": "admin-id"},
+			{"action": "SKIPPED", "resourceType": "GROUP", "resourceName": "admins", "id": "admins-id"}
+		]}`))
+	})
+	defer server.Close()
+
+	result, err := keycloakClient.PartialImport(&PartialImport{
+		RealmId:          "test",
+		IfResourceExists: "SKIP",
+		Users: []*PartialImportUser{
+			{
+				Username:   "alice",
+				Enabled:    true,
+				RealmRoles: []string{"admin"},
+				Groups:     []string{"/admins"},
+			},
+		},
+		Groups: []*Group{
+			{
+				Name: "admins",
+			},
+		},
+		Roles: &PartialImportRoles{
+			Realm: []*Role{
+				{
+					Name: "admin",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedBody := `{"ifResourceExists":"SKIP","users":[{"username":"alice","enabled":true,"realmRoles":["admin"],"groups":["/admins"]}],"groups":[{"name":"admins","attributes":null}],"roles":{"realm":[{"name":"admin","description":"","clientRole":false,"containerId":"","composite":false}]}}`
+	if requestBody != expectedBody {
+		t.Fatalf("expected request body %s, got %s", expectedBody, requestBody)
+	}
+
+	if result.Added != 2 || result.Skipped != 1 || result.Overwritten != 0 || len(result.Results) != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	if result.Results[0].ResourceType != "USER" || result.Results[0].Id != "alice-id" {
+		t.Fatalf("unexpected result for alice: %+v", result.Results[0])
+	}
+}
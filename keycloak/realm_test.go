@@ -0,0 +1,47 @@
+package keycloak
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestKeycloakClient_validateRealmExistsCachesExistingRealms(t *testing.T) {
+	requests := make(map[string]int)
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+
+		switch r.URL.Path {
+		case "/auth/admin/realms/test":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id": "test", "realm": "test"}`))
+		case "/auth/admin/realms/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	for i := 0; i < 3; i++ {
+		if err := keycloakClient.ValidateRealmExists("test"); err != nil {
+			t.Fatalf("expected realm test to exist, got %s", err)
+		}
+
+		err := keycloakClient.ValidateRealmExists("missing")
+		if err == nil || !strings.Contains(err.Error(), "realm missing does not exist") {
+			t.Fatalf("expected an error saying realm missing does not exist, got %v", err)
+		}
+	}
+
+	if requests["/auth/admin/realms/test"] != 1 {
+		t.Fatalf("expected realm test to be fetched once, got %d requests", requests["/auth/admin/realms/test"])
+	}
+
+	// realms that don't exist aren't cached, since they could be created later in the same run
+	if requests["/auth/admin/realms/missing"] != 3 {
+		t.Fatalf("expected realm missing to be fetched on every call, got %d requests", requests["/auth/admin/realms/missing"])
+	}
+}
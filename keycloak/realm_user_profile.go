@@ -0,0 +1,58 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+type RealmUserProfilePermissions struct {
+	View []string `json:"view"`
+	Edit []string `json:"edit"`
+}
+
+type RealmUserProfileRequired struct {
+	Roles  []string `json:"roles,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+type RealmUserProfileSelector struct {
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+type RealmUserProfileAttribute struct {
+	Name        string                            `json:"name"`
+	DisplayName string                            `json:"displayName,omitempty"`
+	Group       string                            `json:"group,omitempty"`
+	Annotations map[string]interface{}            `json:"annotations,omitempty"`
+	Validations map[string]map[string]interface{} `json:"validations,omitempty"`
+	Permissions *RealmUserProfilePermissions      `json:"permissions,omitempty"`
+	Required    *RealmUserProfileRequired         `json:"required,omitempty"`
+	Selector    *RealmUserProfileSelector         `json:"selector,omitempty"`
+}
+
+type RealmUserProfileGroup struct {
+	Name               string                 `json:"name"`
+	DisplayHeader      string                 `json:"displayHeader,omitempty"`
+	DisplayDescription string                 `json:"displayDescription,omitempty"`
+	Annotations        map[string]interface{} `json:"annotations,omitempty"`
+}
+
+type RealmUserProfile struct {
+	Attributes               []*RealmUserProfileAttribute `json:"attributes"`
+	Groups                   []*RealmUserProfileGroup     `json:"groups,omitempty"`
+	UnmanagedAttributePolicy string                       `json:"unmanagedAttributePolicy,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) GetRealmUserProfile(realmId string) (*RealmUserProfile, error) {
+	var realmUserProfile RealmUserProfile
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users/profile", realmId), &realmUserProfile, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realmUserProfile, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmUserProfile(realmId string, realmUserProfile *RealmUserProfile) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/users/profile", realmId), realmUserProfile)
+}
@@ -3,28 +3,14 @@ package keycloak
 import "fmt"
 
 type RequiredAction struct {
-	Id            string              `json:"-"`
-	RealmId       string              `json:"-"`
-	Alias         string              `json:"alias"`
-	Name          string              `json:"name"`
-	Enabled       bool                `json:"enabled"`
-	DefaultAction bool                `json:"defaultAction"`
-	Priority      int                 `json:"priority"`
-	Config        map[string][]string `json:"config"`
-}
-
-func (requiredActions *RequiredAction) getConfig(val string) string {
-	if len(requiredActions.Config[val]) == 0 {
-		return ""
-	}
-	return requiredActions.Config[val][0]
-}
-
-func (requiredActions *RequiredAction) getConfigOk(val string) (string, bool) {
-	if v, ok := requiredActions.Config[val]; ok {
-		return v[0], true
-	}
-	return "", false
+	Id            string            `json:"-"`
+	RealmId       string            `json:"-"`
+	Alias         string            `json:"alias"`
+	Name          string            `json:"name"`
+	Enabled       bool              `json:"enabled"`
+	DefaultAction bool              `json:"defaultAction"`
+	Priority      int               `json:"priority"`
+	Config        map[string]string `json:"config"`
 }
 
 func (keycloakClient *KeycloakClient) GetRequiredActions(realmId string) ([]*RequiredAction, error) {
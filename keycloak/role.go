@@ -3,6 +3,7 @@ package keycloak
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 )
 
@@ -62,15 +63,24 @@ func (keycloakClient *KeycloakClient) CreateRole(role *Role) error {
 }
 
 func (keycloakClient *KeycloakClient) GetRealmRoles(realmId string) ([]*Role, error) {
+	return keycloakClient.ListRoles(realmId, "")
+}
+
+// ListRoles returns every realm role when clientId is empty, otherwise every role that belongs to the client with that ID
+func (keycloakClient *KeycloakClient) ListRoles(realmId, clientId string) ([]*Role, error) {
 	var roles []*Role
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/roles", realmId), &roles, nil)
+	err := keycloakClient.getAllPages(roleByNameUrl(realmId, clientId), &roles, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, role := range roles {
 		role.RealmId = realmId
+
+		if role.ClientRole {
+			role.ClientId = clientId
+		}
 	}
 
 	return roles, nil
@@ -82,7 +92,7 @@ func (keycloakClient *KeycloakClient) GetClientRoles(realmId string, clients []*
 	for _, client := range clients {
 		var rolesClient []*Role
 
-		err := keycloakClient.get(fmt.Sprintf("/realms/%s/clients/%s/roles", realmId, client.Id), &rolesClient, nil)
+		err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/clients/%s/roles", realmId, client.Id), &rolesClient, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -135,12 +145,39 @@ func (keycloakClient *KeycloakClient) GetRole(realmId, id string) (*Role, error)
 	return &role, nil
 }
 
+// Keycloak's own 404 doesn't say which role was missing, or whether it was looked for in the realm or in a client.
+// `clientId` is the client's `clientId` attribute, or empty for realm roles. The error is still a 404, so ErrorIs404
+// works with it.
+func NewRoleNotFoundError(realmId, clientId, name string) error {
+	message := fmt.Sprintf("role %q not found in realm %q", name, realmId)
+	if clientId != "" {
+		message = fmt.Sprintf("role %q not found on client %q in realm %q", name, clientId, realmId)
+	}
+
+	return &ApiError{
+		Code:    http.StatusNotFound,
+		Message: message,
+	}
+}
+
 func (keycloakClient *KeycloakClient) GetRoleByName(realmId, clientId, name string) (*Role, error) {
 	var role Role
 	var roleName = strings.Replace(name, "/", "%2F", -2)
 
 	err := keycloakClient.get(fmt.Sprintf("%s/%s", roleByNameUrl(realmId, clientId), roleName), &role, nil)
 	if err != nil {
+		if ErrorIs404(err) {
+			if clientId == "" {
+				return nil, NewRoleNotFoundError(realmId, "", name)
+			}
+
+			// only the client's ID is known here, callers that know its `clientId` can use NewRoleNotFoundError instead
+			return nil, &ApiError{
+				Code:    http.StatusNotFound,
+				Message: fmt.Sprintf("role %q not found on client with id %q in realm %q", name, clientId, realmId),
+			}
+		}
+
 		return nil, err
 	}
 
@@ -160,7 +197,7 @@ func (keycloakClient *KeycloakClient) UpdateRole(role *Role) error {
 func (keycloakClient *KeycloakClient) DeleteRole(realmId, id string) error {
 	err := keycloakClient.delete(fmt.Sprintf("/realms/%s/roles-by-id/%s", realmId, id), nil)
 	if err != nil {
-		log.Printf("[DEBUG] Failed to delete role with id %s. Trying again...", id)
+		log.Printf("[DEBUG] Failed to delete role with id %s in realm %s. Trying again...", id, realmId)
 
 		return keycloakClient.delete(fmt.Sprintf("/realms/%s/roles-by-id/%s", realmId, id), nil)
 	}
@@ -194,5 +231,9 @@ func (keycloakClient *KeycloakClient) GetRoleComposites(role *Role) ([]*Role, er
 		return nil, err
 	}
 
+	for _, composite := range composites {
+		composite.RealmId = role.RealmId
+	}
+
 	return composites, nil
 }
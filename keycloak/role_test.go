@@ -0,0 +1,49 @@
+package keycloak
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestKeycloakClient_getRoleByNameNotFound(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer server.Close()
+
+	tests := []struct {
+		clientId string
+		expected string
+	}{
+		{"", `role "foo" not found in realm "baz"`},
+		{"1234", `role "foo" not found on client with id "1234" in realm "baz"`},
+	}
+
+	for _, test := range tests {
+		_, err := keycloakClient.GetRoleByName("baz", test.clientId, "foo")
+		if err == nil {
+			t.Fatalf("expected lookup with client id %q to fail", test.clientId)
+		}
+
+		if !ErrorIs404(err) {
+			t.Errorf("expected a 404 error, got %s", err)
+		}
+
+		if err.Error() != test.expected {
+			t.Errorf("expected error %q, got %q", test.expected, err.Error())
+		}
+	}
+}
+
+func TestNewRoleNotFoundError(t *testing.T) {
+	err := NewRoleNotFoundError("baz", "bar", "foo")
+
+	if !ErrorIs404(err) {
+		t.Errorf("expected a 404 error, got %s", err)
+	}
+
+	expected := `role "foo" not found on client "bar" in realm "baz"`
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+}
@@ -10,16 +10,19 @@ type SamlClientAttributes struct {
 	SignAssertions          *string `json:"saml.assertion.signature"`
 	ClientSignatureRequired *string `json:"saml.client.signature"`
 	ForcePostBinding        *string `json:"saml.force.post.binding"`
+	DisplayOnConsentScreen  *string `json:"display.on.consent.screen"`
 	// attributes above are actually booleans, but the Keycloak API expects strings
 	NameIdFormat                    string  `json:"saml_name_id_format"`
 	SigningCertificate              *string `json:"saml.signing.certificate,omitempty"`
 	SigningPrivateKey               *string `json:"saml.signing.private.key"`
+	EncryptionCertificate           *string `json:"saml.encryption.certificate,omitempty"`
 	IDPInitiatedSSOURLName          string  `json:"saml_idp_initiated_sso_url_name"`
 	IDPInitiatedSSORelayState       string  `json:"saml_idp_initiated_sso_relay_state"`
 	AssertionConsumerPostURL        string  `json:"saml_assertion_consumer_url_post"`
 	AssertionConsumerRedirectURL    string  `json:"saml_assertion_consumer_url_redirect"`
 	LogoutServicePostBindingURL     string  `json:"saml_single_logout_service_url_post"`
 	LogoutServiceRedirectBindingURL string  `json:"saml_single_logout_service_url_redirect"`
+	ConsentScreenText               string  `json:"consent.screen.text"`
 }
 
 type SamlClient struct {
@@ -41,6 +44,7 @@ type SamlClient struct {
 	MasterSamlProcessingUrl string   `json:"adminUrl"`
 
 	FullScopeAllowed bool `json:"fullScopeAllowed"`
+	ConsentRequired  bool `json:"consentRequired"`
 
 	Attributes *SamlClientAttributes `json:"attributes"`
 }
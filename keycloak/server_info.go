@@ -1,5 +1,11 @@
 package keycloak
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 type ComponentType struct {
 	Id string `json:"id"`
 }
@@ -12,10 +18,22 @@ type ProviderType struct {
 	Providers map[string]Provider `json:"providers"`
 }
 
+type SystemInfo struct {
+	ServerVersion string `json:"version"`
+}
+
+// only reported by newer versions of keycloak
+type Feature struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
 type ServerInfo struct {
+	SystemInfo     SystemInfo                 `json:"systemInfo"`
 	ComponentTypes map[string][]ComponentType `json:"componentTypes"`
 	ProviderTypes  map[string]ProviderType    `json:"providers"`
 	Themes         map[string][]Theme         `json:"themes"`
+	Features       []Feature                  `json:"features"`
 }
 
 type Theme struct {
@@ -66,6 +84,20 @@ func (serverInfo *ServerInfo) providerInstalled(providerType, providerName strin
 	return false
 }
 
+// returns the major version of the keycloak server, ex: "12.0.4" => 12
+func (serverInfo *ServerInfo) MajorVersion() (int, error) {
+	return parseMajorVersion(serverInfo.SystemInfo.ServerVersion)
+}
+
+func parseMajorVersion(version string) (int, error) {
+	majorVersion, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse keycloak server version %q", version)
+	}
+
+	return majorVersion, nil
+}
+
 func (keycloakClient *KeycloakClient) GetServerInfo() (*ServerInfo, error) {
 	var serverInfo ServerInfo
 
@@ -76,3 +108,33 @@ func (keycloakClient *KeycloakClient) GetServerInfo() (*ServerInfo, error) {
 
 	return &serverInfo, nil
 }
+
+// ServerVersion returns the version of the keycloak server. It's only fetched once, either when the provider is
+// configured or when it's first needed, since it can't change while terraform is running.
+func (keycloakClient *KeycloakClient) ServerVersion() (string, error) {
+	keycloakClient.versionMutex.Lock()
+	defer keycloakClient.versionMutex.Unlock()
+
+	if keycloakClient.serverVersion != "" {
+		return keycloakClient.serverVersion, nil
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo()
+	if err != nil {
+		return "", err
+	}
+
+	keycloakClient.serverVersion = serverInfo.SystemInfo.ServerVersion
+
+	return keycloakClient.serverVersion, nil
+}
+
+// ServerMajorVersion is like ServerVersion, but only returns the major version, ex: "12.0.4" => 12
+func (keycloakClient *KeycloakClient) ServerMajorVersion() (int, error) {
+	version, err := keycloakClient.ServerVersion()
+	if err != nil {
+		return 0, err
+	}
+
+	return parseMajorVersion(version)
+}
@@ -0,0 +1,37 @@
+package keycloak
+
+import (
+	"testing"
+)
+
+func TestServerInfo_majorVersion(t *testing.T) {
+	versions := map[string]int{
+		"8.0.1":           8,
+		"12.0.4":          12,
+		"13.0.0":          13,
+		"15.0.2.Final":    15,
+		"21.1.1-SNAPSHOT": 21,
+	}
+
+	for version, expectedMajorVersion := range versions {
+		serverInfo := &ServerInfo{SystemInfo: SystemInfo{ServerVersion: version}}
+
+		majorVersion, err := serverInfo.MajorVersion()
+		if err != nil {
+			t.Fatalf("unexpected error parsing version %s: %s", version, err)
+		}
+
+		if majorVersion != expectedMajorVersion {
+			t.Fatalf("expected major version of %s to be %d, got %d", version, expectedMajorVersion, majorVersion)
+		}
+	}
+}
+
+func TestServerInfo_majorVersionInvalid(t *testing.T) {
+	serverInfo := &ServerInfo{SystemInfo: SystemInfo{ServerVersion: "unknown"}}
+
+	_, err := serverInfo.MajorVersion()
+	if err == nil {
+		t.Fatal("expected an error when parsing an invalid version")
+	}
+}
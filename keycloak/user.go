@@ -2,6 +2,10 @@ package keycloak
 
 import (
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
 )
 
 type FederatedIdentity struct {
@@ -23,6 +27,9 @@ type User struct {
 	Enabled             bool                `json:"enabled"`
 	Attributes          map[string][]string `json:"attributes"`
 	FederatedIdentities FederatedIdentities `json:"federatedIdentities"`
+
+	// set by keycloak when this user is the service account for a client. this is the client's `clientId`, not its ID
+	ServiceAccountClientId string `json:"serviceAccountClientId,omitempty"`
 }
 
 type PasswordCredentials struct {
@@ -59,7 +66,7 @@ func (keycloakClient *KeycloakClient) ResetUserPassword(realmId, userId string,
 func (keycloakClient *KeycloakClient) GetUsers(realmId string) ([]*User, error) {
 	var users []*User
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users", realmId), &users, nil)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/users", realmId), &users, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +82,7 @@ func (keycloakClient *KeycloakClient) GetUsersRoles(realmId string) ([]*Role, er
 	var roles []*Role
 	var users []*User
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users", realmId), &users, nil)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/users", realmId), &users, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -122,21 +129,39 @@ func (keycloakClient *KeycloakClient) GetUserByUsername(realmId, username string
 
 	params := map[string]string{
 		"username": username,
+		"exact":    "true",
 	}
 
-	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users", realmId), &users, params)
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/users", realmId), &users, params)
 	if err != nil {
 		return nil, err
 	}
 
 	// more than one user could be returned so we need to search through all of the results and return the correct one
 	// ex: foo and foo-user could both exist, but searching for "foo" will return both
+	var matches []*User
 	for _, user := range users {
 		if user.Username == username {
 			user.RealmId = realmId
 
 			return user, nil
 		}
+
+		// keycloak stores usernames in lowercase, so "Foo" in a config refers to the user "foo"
+		if strings.EqualFold(user.Username, username) {
+			matches = append(matches, user)
+		}
+	}
+
+	if len(matches) == 1 {
+		matches[0].RealmId = realmId
+
+		return matches[0], nil
+	}
+
+	// this can happen when usernames are stored with different cases, such as users imported from a federation provider
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("username %s matches %d users in realm %s when ignoring case. use the user's ID instead", username, len(matches), realmId)
 	}
 
 	// the requested user does not exist
@@ -144,7 +169,84 @@ func (keycloakClient *KeycloakClient) GetUserByUsername(realmId, username string
 	return nil, nil
 }
 
-func (keycloakClient *KeycloakClient) addUserToGroup(user *User, groupId string) error {
+// GetUserByUsername returns nil rather than an error when no user matches, so this error says which user was missing.
+// It's still a 404, so ErrorIs404 works with it.
+func NewUserNotFoundError(realmId, username string) error {
+	return &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("user with username %q not found in realm %q", username, realmId),
+	}
+}
+
+// returns every user whose email matches `email`. more than one user can be returned if the realm allows duplicate emails
+func (keycloakClient *KeycloakClient) GetUsersByEmail(realmId, email string) ([]*User, error) {
+	var users []*User
+	var matchingUsers []*User
+
+	params := map[string]string{
+		"email": email,
+		"exact": "true",
+	}
+
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/users", realmId), &users, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// older versions of keycloak ignore `exact` and return partial matches, so these need to be filtered out
+	// keycloak stores emails in lowercase, so the comparison is case insensitive
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			user.RealmId = realmId
+			matchingUsers = append(matchingUsers, user)
+		}
+	}
+
+	return matchingUsers, nil
+}
+
+// returns every user matching `search`, which keycloak matches against the username, email, first and last name, and
+// having every attribute in `attributes`. either filter can be empty
+func (keycloakClient *KeycloakClient) SearchUsers(realmId, search string, attributes map[string]string) ([]*User, error) {
+	var users []*User
+
+	params := make(map[string]string)
+
+	if search != "" {
+		params["search"] = search
+	}
+
+	if len(attributes) != 0 {
+		params["q"] = userAttributesQuery(attributes)
+	}
+
+	err := keycloakClient.getAllPages(fmt.Sprintf("/realms/%s/users", realmId), &users, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		user.RealmId = realmId
+	}
+
+	return users, nil
+}
+
+// formats `attributes` for the `q` query parameter, which expects space separated `key:value` pairs
+func userAttributesQuery(attributes map[string]string) string {
+	var filters []string
+
+	for key, value := range attributes {
+		filters = append(filters, fmt.Sprintf("%s:%s", key, value))
+	}
+
+	// sorted so the same filters always produce the same request
+	sort.Strings(filters)
+
+	return strings.Join(filters, " ")
+}
+
+func (keycloakClient *KeycloakClient) AddUserToGroup(user *User, groupId string) error {
 	return keycloakClient.put(fmt.Sprintf("/realms/%s/users/%s/groups/%s", user.RealmId, user.Id, groupId), nil)
 }
 
@@ -158,7 +260,7 @@ func (keycloakClient *KeycloakClient) AddUsersToGroup(realmId, groupId string, u
 			return fmt.Errorf("user with username %s does not exist", username.(string))
 		}
 
-		err = keycloakClient.addUserToGroup(user, groupId)
+		err = keycloakClient.AddUserToGroup(user, groupId)
 		if err != nil {
 			return err
 		}
@@ -167,6 +269,21 @@ func (keycloakClient *KeycloakClient) AddUsersToGroup(realmId, groupId string, u
 	return nil
 }
 
+func (keycloakClient *KeycloakClient) GetUserGroups(realmId, userId string) ([]*Group, error) {
+	var groups []*Group
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users/%s/groups", realmId, userId), &groups, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		group.RealmId = realmId
+	}
+
+	return groups, nil
+}
+
 func (keycloakClient *KeycloakClient) RemoveUserFromGroup(user *User, groupId string) error {
 	return keycloakClient.delete(fmt.Sprintf("/realms/%s/users/%s/groups/%s", user.RealmId, user.Id, groupId), nil)
 }
@@ -189,3 +306,197 @@ func (keycloakClient *KeycloakClient) RemoveUsersFromGroup(realmId, groupId stri
 
 	return nil
 }
+
+type ClientRoleMapping struct {
+	Client   string  `json:"client"`
+	Id       string  `json:"id"`
+	Mappings []*Role `json:"mappings"`
+}
+
+type RoleMapping struct {
+	ClientMappings map[string]*ClientRoleMapping `json:"clientMappings"`
+	RealmMappings  []*Role                       `json:"realmMappings"`
+}
+
+// GetUserRoleMappings returns the realm and client roles that are directly assigned to a user.
+// Client role mappings are keyed by the client's clientId, not its internal ID.
+func (keycloakClient *KeycloakClient) GetUserRoleMappings(realmId, userId string) (*RoleMapping, error) {
+	var roleMapping RoleMapping
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users/%s/role-mappings", realmId, userId), &roleMapping, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	roleMapping.setRealmAndClientIds(realmId)
+
+	return &roleMapping, nil
+}
+
+func (roleMapping *RoleMapping) setRealmAndClientIds(realmId string) {
+	for _, realmRole := range roleMapping.RealmMappings {
+		realmRole.RealmId = realmId
+	}
+
+	for _, clientRoleMapping := range roleMapping.ClientMappings {
+		for _, clientRole := range clientRoleMapping.Mappings {
+			clientRole.RealmId = realmId
+			clientRole.ClientId = clientRoleMapping.Id
+		}
+	}
+}
+
+// GetUserRealmRoleMappings returns the realm roles that are directly assigned to a user
+func (keycloakClient *KeycloakClient) GetUserRealmRoleMappings(realmId, userId string) ([]*Role, error) {
+	var roles []*Role
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users/%s/role-mappings/realm", realmId, userId), &roles, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		role.RealmId = realmId
+	}
+
+	return roles, nil
+}
+
+// GetUserClientRoleMappings is like GetUserRealmRoleMappings, but for the roles of the client with the given ID
+func (keycloakClient *KeycloakClient) GetUserClientRoleMappings(realmId, userId, clientId string) ([]*Role, error) {
+	var roles []*Role
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users/%s/role-mappings/clients/%s", realmId, userId, clientId), &roles, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		role.RealmId = realmId
+		role.ClientId = clientId
+	}
+
+	return roles, nil
+}
+
+// GetUserEffectiveRealmRoles returns every realm role the user has, including roles granted by the user's groups and
+// roles contained in composite roles
+func (keycloakClient *KeycloakClient) GetUserEffectiveRealmRoles(realmId, userId string) ([]*Role, error) {
+	var roles []*Role
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users/%s/role-mappings/realm/composite", realmId, userId), &roles, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		role.RealmId = realmId
+	}
+
+	return roles, nil
+}
+
+// GetUserEffectiveClientRoles is like GetUserEffectiveRealmRoles, but for the roles of the client with the given ID
+func (keycloakClient *KeycloakClient) GetUserEffectiveClientRoles(realmId, userId, clientId string) ([]*Role, error) {
+	var roles []*Role
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users/%s/role-mappings/clients/%s/composite", realmId, userId, clientId), &roles, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		role.RealmId = realmId
+		role.ClientId = clientId
+	}
+
+	return roles, nil
+}
+
+// LockUser blocks until no other caller holds the lock for the user, and returns a function that releases it.
+// Resources that read a user's role mappings and then change them hold this lock for the whole operation, otherwise two
+// resources managing roles for the same user could be applied in parallel and undo each other's changes.
+func (keycloakClient *KeycloakClient) LockUser(realmId, userId string) func() {
+	mutex, _ := keycloakClient.userMutexes.LoadOrStore(realmId+"/"+userId, &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock()
+
+	return mutex.(*sync.Mutex).Unlock
+}
+
+// ClaimUserRoles records that the caller manages every role of the user, as described by `claim`. It returns false when
+// the user was already claimed by this client with anything other than `claim` or `previousClaim`, which means two
+// resources both expect to be the only one managing the user's roles and would undo each other's changes on every apply.
+// Callers that change their claim pass the one they made before as `previousClaim`, so they don't conflict with themselves.
+func (keycloakClient *KeycloakClient) ClaimUserRoles(realmId, userId, previousClaim, claim string) bool {
+	keycloakClient.roleClaimsMutex.Lock()
+	defer keycloakClient.roleClaimsMutex.Unlock()
+
+	if keycloakClient.userRoleClaims == nil {
+		keycloakClient.userRoleClaims = make(map[string]string)
+	}
+
+	key := realmId + "/" + userId
+	if existingClaim, ok := keycloakClient.userRoleClaims[key]; ok && existingClaim != claim && existingClaim != previousClaim {
+		return false
+	}
+
+	keycloakClient.userRoleClaims[key] = claim
+
+	return true
+}
+
+// ReleaseUserRoles removes a claim made with ClaimUserRoles, unless the user has been claimed with something else since
+func (keycloakClient *KeycloakClient) ReleaseUserRoles(realmId, userId, claim string) {
+	keycloakClient.roleClaimsMutex.Lock()
+	defer keycloakClient.roleClaimsMutex.Unlock()
+
+	key := realmId + "/" + userId
+	if keycloakClient.userRoleClaims[key] == claim {
+		delete(keycloakClient.userRoleClaims, key)
+	}
+}
+
+func (keycloakClient *KeycloakClient) AddRealmRolesToUser(realmId, userId string, roles []*Role) error {
+	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/users/%s/role-mappings/realm", realmId, userId), roles)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) AddClientRolesToUser(realmId, userId, clientId string, roles []*Role) error {
+	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/users/%s/role-mappings/clients/%s", realmId, userId, clientId), roles)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) RemoveRealmRolesFromUser(realmId, userId string, roles []*Role) error {
+	err := keycloakClient.delete(fmt.Sprintf("/realms/%s/users/%s/role-mappings/realm", realmId, userId), roles)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) RemoveClientRolesFromUser(realmId, userId, clientId string, roles []*Role) error {
+	err := keycloakClient.delete(fmt.Sprintf("/realms/%s/users/%s/role-mappings/clients/%s", realmId, userId, clientId), roles)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) CreateFederatedIdentity(realmId, userId string, federatedIdentity *FederatedIdentity) error {
+	_, _, err := keycloakClient.post(fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, federatedIdentity.IdentityProvider), federatedIdentity)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) GetFederatedIdentities(realmId, userId string) (FederatedIdentities, error) {
+	var federatedIdentities FederatedIdentities
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users/%s/federated-identity", realmId, userId), &federatedIdentities, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return federatedIdentities, nil
+}
+
+func (keycloakClient *KeycloakClient) RemoveFederatedIdentity(realmId, userId, identityProvider string) error {
+	return keycloakClient.delete(fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, identityProvider), nil)
+}
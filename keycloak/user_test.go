@@ -0,0 +1,130 @@
+package keycloak
+
+import (
+	"net/http"
+	"testing"
+)
+
+func newUsersHandler(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}
+}
+
+func TestKeycloakClient_getUserByUsernameIgnoresCase(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, newUsersHandler(`[{"id": "1", "username": "foo"}, {"id": "2", "username": "foo-user"}]`))
+	defer server.Close()
+
+	user, err := keycloakClient.GetUserByUsername("my-realm", "Foo")
+	if err != nil {
+		t.Fatalf("expected lookup to succeed, got %s", err)
+	}
+
+	if user == nil || user.Id != "1" {
+		t.Fatalf("expected user with id 1, got %v", user)
+	}
+}
+
+func TestKeycloakClient_getUserByUsernameErrorsOnMultipleMatches(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, newUsersHandler(`[{"id": "1", "username": "Foo"}, {"id": "2", "username": "FOO"}]`))
+	defer server.Close()
+
+	_, err := keycloakClient.GetUserByUsername("my-realm", "foo")
+	if err == nil {
+		t.Fatalf("expected lookup to fail when multiple users match")
+	}
+}
+
+func TestKeycloakClient_getUserByUsernameNotFound(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, newUsersHandler(`[{"id": "2", "username": "foo-user"}]`))
+	defer server.Close()
+
+	user, err := keycloakClient.GetUserByUsername("my-realm", "foo")
+	if err != nil {
+		t.Fatalf("expected lookup to succeed, got %s", err)
+	}
+
+	if user != nil {
+		t.Fatalf("expected no user to be found, got %v", user)
+	}
+}
+
+func TestKeycloakClient_searchUsersByAttributes(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if q := r.URL.Query().Get("q"); q != "department:engineering team:platform" {
+			t.Errorf("expected attributes to be sent as a query, got %q", q)
+		}
+
+		if search := r.URL.Query().Get("search"); search != "jane" {
+			t.Errorf("expected search to be sent, got %q", search)
+		}
+
+		newUsersHandler(`[{"id": "1", "username": "jane"}]`)(w, r)
+	})
+	defer server.Close()
+
+	users, err := keycloakClient.SearchUsers("my-realm", "jane", map[string]string{
+		"team":       "platform",
+		"department": "engineering",
+	})
+	if err != nil {
+		t.Fatalf("expected search to succeed, got %s", err)
+	}
+
+	if len(users) != 1 || users[0].Id != "1" || users[0].RealmId != "my-realm" {
+		t.Fatalf("expected user with id 1 in realm my-realm, got %v", users)
+	}
+}
+
+func TestKeycloakClient_getUserClientRoleMappings(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/auth/admin/realms/my-realm/users/my-user/role-mappings/clients/client-uuid" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "client-role-id", "name": "client-role", "clientRole": true}]`))
+	})
+	defer server.Close()
+
+	roles, err := keycloakClient.GetUserClientRoleMappings("my-realm", "my-user", "client-uuid")
+	if err != nil {
+		t.Fatalf("expected role mappings to be read, got %s", err)
+	}
+
+	if len(roles) != 1 || roles[0].Id != "client-role-id" || roles[0].RealmId != "my-realm" || roles[0].ClientId != "client-uuid" {
+		t.Fatalf("expected the client role with its id, got %v", roles)
+	}
+}
+
+func TestKeycloakClient_claimUserRoles(t *testing.T) {
+	keycloakClient := &KeycloakClient{}
+
+	if !keycloakClient.ClaimUserRoles("my-realm", "user-id", "", "roles-a") {
+		t.Fatal("expected the first claim for a user to succeed")
+	}
+
+	if !keycloakClient.ClaimUserRoles("my-realm", "other-user-id", "", "roles-b") {
+		t.Fatal("expected a claim for a different user to succeed")
+	}
+
+	if keycloakClient.ClaimUserRoles("my-realm", "user-id", "", "roles-b") {
+		t.Fatal("expected a different claim for the same user to fail")
+	}
+
+	// the resource that made the first claim changes its roles
+	if !keycloakClient.ClaimUserRoles("my-realm", "user-id", "roles-a", "roles-c") {
+		t.Fatal("expected a claim replacing the previous one to succeed")
+	}
+
+	keycloakClient.ReleaseUserRoles("my-realm", "user-id", "roles-a")
+	if keycloakClient.ClaimUserRoles("my-realm", "user-id", "", "roles-b") {
+		t.Fatal("expected releasing an outdated claim to keep the current one")
+	}
+
+	keycloakClient.ReleaseUserRoles("my-realm", "user-id", "roles-c")
+	if !keycloakClient.ClaimUserRoles("my-realm", "user-id", "", "roles-b") {
+		t.Fatal("expected a claim to succeed once the previous one was released")
+	}
+}
@@ -0,0 +1,42 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+// fine-grained admin permissions are implemented as scope permissions on the realm's `realm-management` client. when
+// they're enabled, keycloak creates one permission per scope, and returns their IDs in `ScopePermissions`
+type UsersPermissions struct {
+	RealmId          string            `json:"-"`
+	Enabled          bool              `json:"enabled"`
+	Resource         string            `json:"resource,omitempty"`
+	ScopePermissions map[string]string `json:"scopePermissions,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) GetUsersPermissions(realmId string) (*UsersPermissions, error) {
+	var usersPermissions UsersPermissions
+
+	err := keycloakClient.get(fmt.Sprintf("/realms/%s/users-management-permissions", realmId), &usersPermissions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	usersPermissions.RealmId = realmId
+
+	return &usersPermissions, nil
+}
+
+func (keycloakClient *KeycloakClient) EnableUsersPermissions(realmId string) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/users-management-permissions", realmId), &UsersPermissions{Enabled: true})
+}
+
+// disabling these permissions causes keycloak to delete the scope permissions that were created for them
+func (keycloakClient *KeycloakClient) DisableUsersPermissions(realmId string) error {
+	return keycloakClient.put(fmt.Sprintf("/realms/%s/users-management-permissions", realmId), &UsersPermissions{Enabled: false})
+}
+
+// returns the `realm-management` client, whose resource server holds the scope permissions for fine-grained admin
+// permissions
+func (keycloakClient *KeycloakClient) GetRealmManagementClient(realmId string) (*GenericClient, error) {
+	return keycloakClient.GetGenericClientByClientId(realmId, "realm-management")
+}
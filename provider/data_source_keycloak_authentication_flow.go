@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakAuthenticationFlow() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakAuthenticationFlowRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakAuthenticationFlowRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	alias := data.Get("alias").(string)
+
+	authenticationFlow, err := keycloakClient.GetAuthenticationFlowFromAlias(realmId, alias)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(authenticationFlow.Id)
+	data.Set("realm_id", realmId)
+	data.Set("alias", authenticationFlow.Alias)
+	data.Set("provider_id", authenticationFlow.ProviderId)
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccKeycloakDataSourceAuthenticationFlow_basic(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	alias := "terraform-flow-" + acctest.RandString(10)
+
+	resourceName := "keycloak_authentication_flow.flow"
+	dataSourceName := "data.keycloak_authentication_flow.flow"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakAuthenticationFlow_basic(realm, alias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias", resourceName, "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "provider_id", resourceName, "provider_id"),
+					resource.TestCheckResourceAttr("data.keycloak_authentication_flow.browser", "provider_id", "basic-flow"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceAuthenticationFlow_noMatchingAlias(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakAuthenticationFlow_noMatchingAlias(realm),
+				ExpectError: regexp.MustCompile("authentication flow with alias \"does-not-exist\" not found"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakAuthenticationFlow_basic(realm, alias string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "%s"
+}
+
+data "keycloak_authentication_flow" "flow" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "${keycloak_authentication_flow.flow.alias}"
+}
+
+data "keycloak_authentication_flow" "browser" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "browser"
+}
+	`, realm, alias)
+}
+
+func testDataSourceKeycloakAuthenticationFlow_noMatchingAlias(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_authentication_flow" "flow" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "does-not-exist"
+}
+	`, realm)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"sort"
+)
+
+func dataSourceKeycloakClientRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakClientRolesRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"composite": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakClientRolesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	roles, err := keycloakClient.ListRoles(realmId, clientId)
+	if err != nil {
+		return err
+	}
+
+	// keycloak doesn't guarantee an order, so the roles are sorted to keep indexes stable between runs
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+
+	var flattenedRoles []interface{}
+	for _, role := range roles {
+		flattenedRoles = append(flattenedRoles, map[string]interface{}{
+			"id":          role.Id,
+			"name":        role.Name,
+			"description": role.Description,
+			"composite":   role.Composite,
+		})
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, clientId))
+	data.Set("roles", flattenedRoles)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccKeycloakDataSourceClientRoles_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-client-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+	dataSourceName := "data.keycloak_client_roles.roles"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakClientRoles_basic(realmName, clientId, roleName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "roles.0.id", "keycloak_role.role.0", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "roles.0.name", "keycloak_role.role.0", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.0.description", "a client role"),
+				),
+			},
+		},
+	})
+}
+
+// keycloak returns at most 100 roles per page, so this makes sure that every page is fetched
+func TestAccKeycloakDataSourceClientRoles_moreThanOnePage(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-client-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakClientRoles_basic(realmName, clientId, roleName, 120),
+				Check:  resource.TestCheckResourceAttr("data.keycloak_client_roles.roles", "roles.#", "120"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakClientRoles_basic(realmName, clientId, roleName string, count int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = "${keycloak_realm.realm.id}"
+	client_id   = "%s"
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_role" "role" {
+	count = %d
+
+	realm_id    = "${keycloak_realm.realm.id}"
+	client_id   = "${keycloak_openid_client.client.id}"
+	name        = "%s-${format("%%03d", count.index)}"
+	description = "a client role"
+}
+
+data "keycloak_client_roles" "roles" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.client.id}"
+
+	depends_on = ["keycloak_role.role"]
+}
+	`, realmName, clientId, count, roleName)
+}
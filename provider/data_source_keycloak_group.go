@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 )
@@ -15,7 +16,17 @@ func dataSourceKeycloakGroup() *schema.Resource {
 			},
 			"name": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
@@ -26,8 +37,21 @@ func dataSourceKeycloakGroupRead(data *schema.ResourceData, meta interface{}) er
 
 	realmId := data.Get("realm_id").(string)
 	groupName := data.Get("name").(string)
+	groupPath := data.Get("path").(string)
 
-	group, err := keycloakClient.GetGroupByName(realmId, groupName)
+	if (groupName == "") == (groupPath == "") {
+		return fmt.Errorf("exactly one of name or path must be specified")
+	}
+
+	var group *keycloak.Group
+	var err error
+
+	// names are only unique among siblings, so a path is needed to find a specific subgroup
+	if groupPath != "" {
+		group, err = keycloakClient.GetGroupByPath(realmId, groupPath)
+	} else {
+		group, err = keycloakClient.GetGroupByName(realmId, groupName)
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakGroupRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakGroupRolesRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakGroupRolesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	groupId := data.Get("group_id").(string)
+
+	group, err := keycloakClient.GetGroup(realmId, groupId)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return fmt.Errorf("group with id %s does not exist in realm %s", groupId, realmId)
+		}
+
+		return err
+	}
+
+	roles, err := getMapOfRealmAndClientRolesFromGroup(keycloakClient, group)
+	if err != nil {
+		return err
+	}
+
+	data.Set("role_ids", getRoleIdsFromMapOfRealmAndClientRoles(roles))
+	data.SetId(groupRolesId(realmId, groupId))
+
+	return nil
+}
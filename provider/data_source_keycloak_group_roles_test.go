@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccKeycloakDataSourceGroupRoles_basic(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	realmRole := "terraform-role-" + acctest.RandString(10)
+	clientId := "terraform-client-" + acctest.RandString(10)
+	clientRole := "terraform-role-" + acctest.RandString(10)
+	groupName := "terraform-group-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakGroupRoles_basic(realm, realmRole, clientId, clientRole, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_group_roles.group_roles", "id", "data.keycloak_group_roles.group_roles", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_group_roles.group_roles", "role_ids.#", "2"),
+					testAccCheckKeycloakGroupHasRoles("data.keycloak_group_roles.group_roles"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceGroupRoles_groupDoesNotExist(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakGroupRoles_groupDoesNotExist(realm),
+				ExpectError: regexp.MustCompile("group with id .+ does not exist"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakGroupRoles_basic(realm, realmRole, clientId, clientRole, groupName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_role" "client_role" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.client.id}"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_group_roles" "group_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	group_id = "${keycloak_group.group.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role.id}",
+		"${keycloak_role.client_role.id}",
+	]
+}
+
+data "keycloak_group_roles" "group_roles" {
+	realm_id = "${keycloak_group_roles.group_roles.realm_id}"
+	group_id = "${keycloak_group_roles.group_roles.group_id}"
+}
+	`, realm, realmRole, clientId, clientRole, groupName)
+}
+
+func testDataSourceKeycloakGroupRoles_groupDoesNotExist(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_group_roles" "group_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	group_id = "6e6b5bc8-9f36-4bb0-a7d9-5d9b1c7f6a2e"
+}
+	`, realm)
+}
@@ -34,6 +34,29 @@ func TestAccKeycloakDataSourceGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDataSourceGroup_path(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	parentGroup := "terraform-group-" + acctest.RandString(10)
+	childGroup := "terraform-group-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakGroup_path(realm, parentGroup, childGroup),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_group.child", "id", "data.keycloak_group.child", "id"),
+					resource.TestCheckResourceAttrPair("keycloak_group.parent", "id", "data.keycloak_group.child", "parent_id"),
+					resource.TestCheckResourceAttr("data.keycloak_group.child", "name", childGroup),
+					testAccCheckDataKeycloakGroup("data.keycloak_group.child"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataKeycloakGroup(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -77,3 +100,27 @@ data "keycloak_group" "group" {
 }
 	`, realm, group)
 }
+
+func testDataSourceKeycloakGroup_path(realm, parentGroup, childGroup string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "parent" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_group" "child" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	parent_id = "${keycloak_group.parent.id}"
+}
+
+data "keycloak_group" "child" {
+	realm_id = "${keycloak_realm.realm.id}"
+	path     = "${keycloak_group.child.path}"
+}
+	`, realm, parentGroup, childGroup)
+}
@@ -96,6 +96,55 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"authentication_flow_binding_overrides": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"browser_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direct_grant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"pkce_code_challenge_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exclude_session_state_from_auth_response": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"access_token_lifespan": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_session_idle_timeout": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_session_max_lifespan": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backchannel_logout_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backchannel_logout_session_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"extra_config": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
 		},
 	}
 }
@@ -116,5 +165,8 @@ func dataSourceKeycloakOpenidClientRead(data *schema.ResourceData, meta interfac
 		return err
 	}
 
+	// unlike the resource, which only tracks the attributes it manages, every other attribute is exported here
+	data.Set("extra_config", client.Attributes.ExtraConfig)
+
 	return nil
 }
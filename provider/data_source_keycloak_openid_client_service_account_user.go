@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 )
@@ -17,6 +18,10 @@ func dataSourceKeycloakOpenidClientServiceAccountUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"username": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -73,10 +78,27 @@ func dataSourceKeycloakOpenidClientServiceAccountUserRead(data *schema.ResourceD
 
 	user, err := keycloakClient.GetOpenidClientServiceAccountUserId(realmId, clientId)
 	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return fmt.Errorf("client with id %s does not exist in realm %s, or does not have service accounts enabled", clientId, realmId)
+		}
+
 		return err
 	}
 
 	mapFromUserToData(data, user)
 
+	// `mapFromUserToData` sets `federated_identity`, which is named differently here
+	var federatedIdentities []interface{}
+	for _, federatedIdentity := range user.FederatedIdentities {
+		federatedIdentities = append(federatedIdentities, map[string]interface{}{
+			"identity_provider": federatedIdentity.IdentityProvider,
+			"user_id":           federatedIdentity.UserId,
+			"user_name":         federatedIdentity.UserName,
+		})
+	}
+
+	data.Set("user_id", user.Id)
+	data.Set("federated_identities", federatedIdentities)
+
 	return nil
 }
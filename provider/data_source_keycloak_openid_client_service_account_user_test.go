@@ -23,6 +23,7 @@ func TestAccKeycloakDataSourceOpenidClientServiceAccountUser_basic(t *testing.T)
 					resource.TestCheckResourceAttr(dataSourceName, "realm_id", realm),
 					resource.TestMatchResourceAttr(dataSourceName, "client_id", regexp.MustCompile("^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$")),
 					resource.TestCheckResourceAttr(dataSourceName, "username", "service-account-"+clientId),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_id", dataSourceName, "id"),
 				),
 			},
 		},
@@ -65,3 +66,54 @@ data keycloak_openid_client_service_account_user test {
 }
 `, realm, clientId, clientId)
 }
+
+func TestAccKeycloakDataSourceOpenidClientServiceAccountUser_withUserRoles(t *testing.T) {
+	realm := acctest.RandomWithPrefix("tf-acc-test")
+	clientId := acctest.RandomWithPrefix("tf-acc-test")
+	roleName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakOpenidClientServiceAccountUserWithUserRolesConfig(realm, clientId, roleName),
+				Check:  testAccCheckKeycloakUserHasRoles("keycloak_user_roles.service_account_roles"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenidClientServiceAccountUserWithUserRolesConfig(realm, clientId, roleName string) string {
+	return fmt.Sprintf(`
+resource keycloak_realm test {
+  realm = "%s"
+}
+
+resource keycloak_openid_client test {
+  client_id                = "%s"
+  realm_id                 = "${keycloak_realm.test.id}"
+  access_type              = "CONFIDENTIAL"
+  service_accounts_enabled = true
+}
+
+resource keycloak_role test {
+  realm_id = "${keycloak_realm.test.id}"
+  name     = "%s"
+}
+
+data keycloak_openid_client_service_account_user test {
+  client_id = "${keycloak_openid_client.test.id}"
+  realm_id  = "${keycloak_realm.test.id}"
+}
+
+resource keycloak_user_roles service_account_roles {
+  realm_id = "${keycloak_realm.test.id}"
+  user_id  = "${data.keycloak_openid_client_service_account_user.test.user_id}"
+
+  role_ids = [
+    "${keycloak_role.test.id}",
+  ]
+}
+`, realm, clientId, roleName)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 )
@@ -13,6 +14,10 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -56,6 +61,10 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"ssl_required": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 
 			//Smtp server
 
@@ -144,6 +153,14 @@ func dataSourceKeycloakRealm() *schema.Resource {
 
 			// Tokens
 
+			"default_signature_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revoke_refresh_token": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"refresh_token_max_reuse": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -298,6 +315,11 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Description: "Which flow should be used for DockerAuthenticationFlow",
 				Computed:    true,
 			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
 		},
 	}
 }
@@ -314,5 +336,17 @@ func dataSourceKeycloakRealmRead(data *schema.ResourceData, meta interface{}) er
 
 	setRealmData(data, realm)
 
+	data.Set("internal_id", realm.Id)
+	data.Set("default_signature_algorithm", realm.DefaultSignatureAlgorithm)
+
+	// unlike the resource, which only tracks the attributes it manages, the data source exposes all of them
+	attributes := map[string]string{}
+	for key, value := range realm.Attributes {
+		if value != nil {
+			attributes[key] = fmt.Sprintf("%v", value)
+		}
+	}
+	data.Set("attributes", attributes)
+
 	return nil
 }
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"sort"
+)
+
+func dataSourceKeycloakRealmRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakRealmRolesRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"composite": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakRealmRolesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	roles, err := keycloakClient.GetRealmRoles(realmId)
+	if err != nil {
+		return err
+	}
+
+	// keycloak doesn't guarantee an order, so the roles are sorted to keep indexes stable between runs
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+
+	var flattenedRoles []interface{}
+	for _, role := range roles {
+		flattenedRoles = append(flattenedRoles, map[string]interface{}{
+			"id":          role.Id,
+			"name":        role.Name,
+			"description": role.Description,
+			"composite":   role.Composite,
+		})
+	}
+
+	data.SetId(realmId)
+	data.Set("roles", flattenedRoles)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"strconv"
+	"testing"
+)
+
+func TestAccKeycloakDataSourceRealmRoles_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+	dataSourceName := "data.keycloak_realm_roles.roles"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakRealmRoles_basic(realmName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", realmName),
+					testAccCheckDataSourceKeycloakRealmRolesContains(dataSourceName, "keycloak_role.role"),
+				),
+			},
+		},
+	})
+}
+
+// checks that the role created by `roleResourceName` is one of the roles returned by the data source
+func testAccCheckDataSourceKeycloakRealmRolesContains(dataSourceName, roleResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", dataSourceName)
+		}
+
+		role, ok := s.RootModule().Resources[roleResourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", roleResourceName)
+		}
+
+		count, err := strconv.Atoi(ds.Primary.Attributes["roles.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			prefix := fmt.Sprintf("roles.%d.", i)
+
+			if ds.Primary.Attributes[prefix+"id"] != role.Primary.ID {
+				continue
+			}
+
+			if name := ds.Primary.Attributes[prefix+"name"]; name != role.Primary.Attributes["name"] {
+				return fmt.Errorf("expected role %s to be named %s, got %s", role.Primary.ID, role.Primary.Attributes["name"], name)
+			}
+
+			if description := ds.Primary.Attributes[prefix+"description"]; description != role.Primary.Attributes["description"] {
+				return fmt.Errorf("expected role %s to have description %s, got %s", role.Primary.ID, role.Primary.Attributes["description"], description)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("expected %s to contain role %s", dataSourceName, role.Primary.ID)
+	}
+}
+
+func testDataSourceKeycloakRealmRoles_basic(realmName, roleName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	realm_id    = "${keycloak_realm.realm.id}"
+	name        = "%s"
+	description = "a realm role"
+}
+
+data "keycloak_realm_roles" "roles" {
+	realm_id = "${keycloak_role.role.realm_id}"
+}
+	`, realmName, roleName)
+}
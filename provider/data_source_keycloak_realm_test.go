@@ -25,6 +25,10 @@ func TestAccKeycloakDataSourceRealm_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "realm", resourceName, "realm"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "enabled", resourceName, "enabled"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "display_name", resourceName, "display_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "login_theme", resourceName, "login_theme"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ssl_required", resourceName, "ssl_required"),
+					resource.TestCheckResourceAttr(dataSourceName, "internal_id", realm),
+					resource.TestCheckResourceAttr(dataSourceName, "attributes.foo", "bar"),
 				),
 			},
 		},
@@ -36,6 +40,11 @@ func testDataSourceKeycloakRealm_basic(realm string) string {
 resource "keycloak_realm" "realm" {
 	realm        = "%s"
 	display_name = "foo"
+	login_theme  = "keycloak"
+
+	attributes = {
+		foo = "bar"
+	}
 }
 
 data "keycloak_realm" "realm" {
@@ -25,6 +25,12 @@ func dataSourceKeycloakRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"composite_roles": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -43,5 +49,20 @@ func dataSourceKeycloakRoleRead(data *schema.ResourceData, meta interface{}) err
 
 	mapFromRoleToData(data, role)
 
+	var compositeRoleIds []string
+
+	if role.Composite {
+		composites, err := keycloakClient.GetRoleComposites(role)
+		if err != nil {
+			return err
+		}
+
+		for _, composite := range composites {
+			compositeRoleIds = append(compositeRoleIds, composite.Id)
+		}
+	}
+
+	data.Set("composite_roles", compositeRoleIds)
+
 	return nil
 }
@@ -48,6 +48,30 @@ func TestAccKeycloakDataSourceRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDataSourceRole_compositeRoles(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	client := "terraform-client-" + acctest.RandString(10)
+	realmRole := "terraform-role-" + acctest.RandString(10)
+	clientRole := "terraform-role-" + acctest.RandString(10)
+	compositeRole := "terraform-role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakRole_compositeRoles(realm, client, realmRole, clientRole, compositeRole),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_role.composite_role", "id", "data.keycloak_role.composite_role", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_role.composite_role", "composite_roles.#", "2"),
+					resource.TestCheckResourceAttr("data.keycloak_role.realm_role", "composite_roles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataKeycloakRole(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -114,3 +138,48 @@ data "keycloak_role" "realm_offline_access" {
 }
 	`, realm, client, realmRole, clientRole)
 }
+
+func testDataSourceKeycloakRole_compositeRoles(realm, client, realmRole, clientRole, compositeRole string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "CONFIDENTIAL"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "client_role" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.client.id}"
+}
+
+resource "keycloak_role" "composite_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+
+	composite_roles = [
+		"${keycloak_role.realm_role.id}",
+		"${keycloak_role.client_role.id}",
+	]
+}
+
+data "keycloak_role" "realm_role" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "${keycloak_role.realm_role.name}"
+}
+
+data "keycloak_role" "composite_role" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "${keycloak_role.composite_role.name}"
+}
+	`, realm, client, realmRole, clientRole, compositeRole)
+}
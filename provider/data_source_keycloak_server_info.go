@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakServerInfo() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakServerInfoRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"major_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"enabled_features": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"disabled_features": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakServerInfoRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	serverInfo, err := keycloakClient.GetServerInfo()
+	if err != nil {
+		return err
+	}
+
+	majorVersion, err := serverInfo.MajorVersion()
+	if err != nil {
+		return err
+	}
+
+	var enabledFeatures, disabledFeatures []string
+	for _, feature := range serverInfo.Features {
+		if feature.Enabled {
+			enabledFeatures = append(enabledFeatures, feature.Name)
+		} else {
+			disabledFeatures = append(disabledFeatures, feature.Name)
+		}
+	}
+
+	data.SetId(serverInfo.SystemInfo.ServerVersion)
+
+	data.Set("version", serverInfo.SystemInfo.ServerVersion)
+	data.Set("major_version", majorVersion)
+	data.Set("enabled_features", enabledFeatures)
+	data.Set("disabled_features", disabledFeatures)
+
+	return nil
+}
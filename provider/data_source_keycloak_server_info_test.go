@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakDataSourceServerInfo_basic(t *testing.T) {
+	dataSourceName := "data.keycloak_server_info.server_info"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakServerInfo_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "major_version"),
+				),
+			},
+		},
+	})
+}
+
+func newServerInfoTestHandler(t *testing.T, serverInfoRequests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/auth/admin/serverinfo":
+			*serverInfoRequests++
+			w.Write([]byte(`{
+				"systemInfo": {"version": "26.0.5"},
+				"features": [
+					{"name": "ORGANIZATION", "enabled": true},
+					{"name": "ADMIN_FINE_GRAINED_AUTHZ", "enabled": false},
+					{"name": "TOKEN_EXCHANGE", "enabled": false}
+				]
+			}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
+
+func TestKeycloakDataSourceServerInfo_read(t *testing.T) {
+	serverInfoRequests := 0
+
+	keycloakClient, server := newTestKeycloakClient(t, newServerInfoTestHandler(t, &serverInfoRequests))
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, dataSourceKeycloakServerInfo().Schema, map[string]interface{}{})
+
+	err := dataSourceKeycloakServerInfoRead(data, keycloakClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if version := data.Get("version").(string); version != "26.0.5" {
+		t.Errorf("expected version 26.0.5, got %s", version)
+	}
+
+	if majorVersion := data.Get("major_version").(int); majorVersion != 26 {
+		t.Errorf("expected major version 26, got %d", majorVersion)
+	}
+
+	enabledFeatures := interfaceSliceToStringSlice(data.Get("enabled_features").(*schema.Set).List())
+	if !reflect.DeepEqual(enabledFeatures, []string{"ORGANIZATION"}) {
+		t.Errorf("expected ORGANIZATION to be the only enabled feature, got %v", enabledFeatures)
+	}
+
+	disabledFeatures := interfaceSliceToStringSlice(data.Get("disabled_features").(*schema.Set).List())
+	sort.Strings(disabledFeatures)
+	if !reflect.DeepEqual(disabledFeatures, []string{"ADMIN_FINE_GRAINED_AUTHZ", "TOKEN_EXCHANGE"}) {
+		t.Errorf("expected ADMIN_FINE_GRAINED_AUTHZ and TOKEN_EXCHANGE to be disabled, got %v", disabledFeatures)
+	}
+}
+
+// the server's version is fetched once when the provider is configured, and then reused by everything that needs it
+func TestKeycloakProvider_serverVersionIsFetchedOnce(t *testing.T) {
+	serverInfoRequests := 0
+
+	server := newTestKeycloakServer(newServerInfoTestHandler(t, &serverInfoRequests))
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, KeycloakProvider().Schema, map[string]interface{}{
+		"url":           server.URL,
+		"client_id":     "terraform",
+		"client_secret": "secret",
+		"realm":         "master",
+		"base_path":     "/auth",
+	})
+
+	meta, err := configureKeycloakProvider(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if serverInfoRequests != 0 {
+		t.Errorf("expected the server info not to be fetched while configuring the provider, got %d requests", serverInfoRequests)
+	}
+
+	for i := 0; i < 2; i++ {
+		majorVersion, err := keycloakClient.ServerMajorVersion()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if majorVersion != 26 {
+			t.Errorf("expected major version 26, got %d", majorVersion)
+		}
+	}
+
+	if serverInfoRequests != 1 {
+		t.Errorf("expected the server info to be fetched once, got %d requests", serverInfoRequests)
+	}
+}
+
+func testDataSourceKeycloakServerInfo_basic() string {
+	return `
+data "keycloak_server_info" "server_info" {
+}`
+}
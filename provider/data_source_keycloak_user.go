@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakUserRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"federated_identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUserRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	username := data.Get("username").(string)
+	email := data.Get("email").(string)
+
+	if (username == "") == (email == "") {
+		return fmt.Errorf("exactly one of username or email must be specified")
+	}
+
+	var user *keycloak.User
+
+	if username != "" {
+		var err error
+
+		user, err = keycloakClient.GetUserByUsername(realmId, username)
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return fmt.Errorf("user with username %s does not exist in realm %s", username, realmId)
+		}
+	} else {
+		users, err := keycloakClient.GetUsersByEmail(realmId, email)
+		if err != nil {
+			return err
+		}
+
+		if len(users) == 0 {
+			return fmt.Errorf("user with email %s does not exist in realm %s", email, realmId)
+		}
+
+		if len(users) > 1 {
+			return fmt.Errorf("found %d users with email %s in realm %s, expected exactly one", len(users), email, realmId)
+		}
+
+		user = users[0]
+	}
+
+	mapFromUserToData(data, user)
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserEffectiveRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakUserEffectiveRolesRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// every client in the realm needs its own request, so limiting the clients can save a lot of requests
+			"clients": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "The clientIds of the clients to look up client roles for. When omitted, every client in the realm is checked.",
+			},
+			"realm_roles": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"client_roles": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"names": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getUserEffectiveRolesClients(keycloakClient *keycloak.KeycloakClient, realmId string, clientIds []string) ([]*keycloak.GenericClient, error) {
+	if len(clientIds) == 0 {
+		return keycloakClient.ListGenericClients(realmId)
+	}
+
+	var clients []*keycloak.GenericClient
+	for _, clientId := range clientIds {
+		client, err := keycloakClient.GetGenericClientByClientId(realmId, clientId)
+		if err != nil {
+			return nil, err
+		}
+
+		clients = append(clients, client)
+	}
+
+	return clients, nil
+}
+
+func dataSourceKeycloakUserEffectiveRolesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// check for the user first, so a missing user gets a clearer error than a 404 from the role mapping endpoints
+	_, err := keycloakClient.GetUser(realmId, userId)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return fmt.Errorf("user with id %s does not exist in realm %s", userId, realmId)
+		}
+
+		return err
+	}
+
+	realmRoles, err := keycloakClient.GetUserEffectiveRealmRoles(realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	clients, err := getUserEffectiveRolesClients(keycloakClient, realmId, interfaceSliceToStringSlice(data.Get("clients").(*schema.Set).List()))
+	if err != nil {
+		return err
+	}
+
+	// each goroutine only writes to its own index, so no lock is needed
+	clientRoles := make([][]*keycloak.Role, len(clients))
+	err = parallelForEach(keycloakClient.MaxConcurrency(), len(clients), func(i int) error {
+		roles, err := keycloakClient.GetUserEffectiveClientRoles(realmId, userId, clients[i].Id)
+		if err != nil {
+			return err
+		}
+
+		clientRoles[i] = roles
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var realmRoleNames []string
+	var roleIds []string
+
+	for _, role := range realmRoles {
+		realmRoleNames = append(realmRoleNames, role.Name)
+		roleIds = append(roleIds, role.Id)
+	}
+
+	var clientRolesData []interface{}
+	for i, client := range clients {
+		if len(clientRoles[i]) == 0 {
+			continue
+		}
+
+		var names []interface{}
+		for _, role := range clientRoles[i] {
+			names = append(names, role.Name)
+			roleIds = append(roleIds, role.Id)
+		}
+
+		clientRolesData = append(clientRolesData, map[string]interface{}{
+			"client": client.ClientId,
+			"names":  schema.NewSet(schema.HashString, names),
+		})
+	}
+
+	data.Set("realm_roles", realmRoleNames)
+	data.Set("client_roles", clientRolesData)
+	data.Set("role_ids", roleIds)
+	data.SetId(userRolesId(realmId, userId))
+
+	return nil
+}
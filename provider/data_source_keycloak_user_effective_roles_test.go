@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccKeycloakDataSourceUserEffectiveRoles_basic(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	compositeRole := "terraform-role-" + acctest.RandString(10)
+	childRole := "terraform-role-" + acctest.RandString(10)
+	clientId := "terraform-client-" + acctest.RandString(10)
+	clientRole := "terraform-role-" + acctest.RandString(10)
+	group := "terraform-group-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	dataSourceName := "data.keycloak_user_effective_roles.effective_roles"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUserEffectiveRoles_basic(realm, compositeRole, childRole, clientId, clientRole, group, username),
+				Check: resource.ComposeTestCheckFunc(
+					// the composite role is assigned directly, and the child role is granted through it
+					resource.TestCheckResourceAttr("data.keycloak_user_roles.user_roles", "role_ids.#", "1"),
+					testAccCheckResourceAttrSetContains(dataSourceName, "realm_roles", compositeRole),
+					testAccCheckResourceAttrSetContains(dataSourceName, "realm_roles", childRole),
+					// the client role is only granted through the group
+					resource.TestCheckResourceAttr(dataSourceName, "client_roles.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUserEffectiveRoles_userDoesNotExist(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakUserEffectiveRoles_userDoesNotExist(realm),
+				ExpectError: regexp.MustCompile("user with id .+ does not exist"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUserEffectiveRoles_basic(realm, compositeRole, childRole, clientId, clientRole, group, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "child_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "composite_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+
+	composite_roles = [
+		"${keycloak_role.child_role.id}",
+	]
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_role" "client_role" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.client.id}"
+}
+
+resource "keycloak_group" "group" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_group_roles" "group_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	group_id = "${keycloak_group.group.id}"
+
+	role_ids = [
+		"${keycloak_role.client_role.id}",
+	]
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_group_memberships" "memberships" {
+	realm_id = "${keycloak_realm.realm.id}"
+	group_id = "${keycloak_group.group.id}"
+
+	members = [
+		"${keycloak_user.user.username}",
+	]
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	user_id   = "${keycloak_user.user.id}"
+	exclusive = false
+
+	role_ids = [
+		"${keycloak_role.composite_role.id}",
+	]
+}
+
+data "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_user_roles.user_roles.realm_id}"
+	user_id  = "${keycloak_user_roles.user_roles.user_id}"
+}
+
+data "keycloak_user_effective_roles" "effective_roles" {
+	realm_id = "${keycloak_user_roles.user_roles.realm_id}"
+	user_id  = "${keycloak_user_roles.user_roles.user_id}"
+
+	clients = [
+		"${keycloak_openid_client.client.client_id}",
+	]
+
+	depends_on = [
+		"keycloak_group_roles.group_roles",
+		"keycloak_group_memberships.memberships",
+	]
+}
+	`, realm, childRole, compositeRole, clientId, clientRole, group, username)
+}
+
+func testDataSourceKeycloakUserEffectiveRoles_userDoesNotExist(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_user_effective_roles" "effective_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "does-not-exist"
+}
+	`, realm)
+}
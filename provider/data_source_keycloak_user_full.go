@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+// returns everything that's attached to a user in one place, which is mostly useful when migrating existing users to
+// terraform. the role fields use the same format as `keycloak_user_roles_by_name`, and the group IDs can be used with
+// `keycloak_user_group_memberships`
+func dataSourceKeycloakUserFull() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakUserFullRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"realm_roles": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"client_roles": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"names": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"group_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"group_paths": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+			"federated_identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUserFullRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	user, err := keycloakClient.GetUser(realmId, userId)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return fmt.Errorf("user with id %s does not exist in realm %s", userId, realmId)
+		}
+
+		return err
+	}
+
+	roles, err := getRolesByNameFromUser(keycloakClient, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	groups, err := keycloakClient.GetUserGroups(realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	federatedIdentities, err := keycloakClient.GetFederatedIdentities(realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	attributes := make(map[string]string)
+	for k, v := range user.Attributes {
+		attributes[k] = strings.Join(v, "")
+	}
+
+	var realmRoles []string
+	var roleIds []string
+	namesByClient := make(map[string][]interface{})
+
+	for key, role := range roles {
+		roleIds = append(roleIds, role.Id)
+
+		if key.client == "" {
+			realmRoles = append(realmRoles, key.name)
+		} else {
+			namesByClient[key.client] = append(namesByClient[key.client], key.name)
+		}
+	}
+
+	var clientRoles []interface{}
+	for client, names := range namesByClient {
+		clientRoles = append(clientRoles, map[string]interface{}{
+			"client": client,
+			"names":  schema.NewSet(schema.HashString, names),
+		})
+	}
+
+	var groupIds []string
+	var groupPaths []string
+	for _, group := range groups {
+		groupIds = append(groupIds, group.Id)
+		groupPaths = append(groupPaths, group.Path)
+	}
+
+	var identities []interface{}
+	for _, federatedIdentity := range federatedIdentities {
+		identities = append(identities, map[string]interface{}{
+			"identity_provider": federatedIdentity.IdentityProvider,
+			"user_id":           federatedIdentity.UserId,
+			"user_name":         federatedIdentity.UserName,
+		})
+	}
+
+	data.SetId(user.Id)
+	data.Set("username", user.Username)
+	data.Set("email", user.Email)
+	data.Set("first_name", user.FirstName)
+	data.Set("last_name", user.LastName)
+	data.Set("enabled", user.Enabled)
+	data.Set("attributes", attributes)
+	data.Set("realm_roles", realmRoles)
+	data.Set("client_roles", clientRoles)
+	data.Set("role_ids", roleIds)
+	data.Set("group_ids", groupIds)
+	data.Set("group_paths", groupPaths)
+	data.Set("federated_identity", identities)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccKeycloakDataSourceUserFull_basic(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	realmRole := "terraform-role-" + acctest.RandString(10)
+	group := "terraform-group-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+	dataSourceName := "data.keycloak_user_full.user"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUserFull_basic(realm, realmRole, group, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_user.user", "id", dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "username", username),
+					resource.TestCheckResourceAttr(dataSourceName, "attributes.foo", "bar"),
+					resource.TestCheckResourceAttr(dataSourceName, "realm_roles.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "group_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "group_paths.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "federated_identity.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUserFull_userDoesNotExist(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakUserFull_userDoesNotExist(realm),
+				ExpectError: regexp.MustCompile("user with id .+ does not exist"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUserFull_basic(realm, realmRole, group, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_group" "group" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+
+	attributes = {
+		foo = "bar"
+	}
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role.id}",
+	]
+}
+
+resource "keycloak_user_group_memberships" "user_groups" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	group_ids = [
+		"${keycloak_group.group.id}",
+	]
+}
+
+data "keycloak_user_full" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	depends_on = [
+		"keycloak_user_roles.user_roles",
+		"keycloak_user_group_memberships.user_groups",
+	]
+}
+	`, realm, realmRole, group, username)
+}
+
+func testDataSourceKeycloakUserFull_userDoesNotExist(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_user_full" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "6e6b5bc8-9f36-4bb0-a7d9-5d9b1c7f6a2e"
+}
+	`, realm)
+}
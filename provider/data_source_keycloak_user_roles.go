@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakUserRolesRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUserRolesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	user, err := keycloakClient.GetUser(realmId, userId)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return fmt.Errorf("user with id %s does not exist in realm %s", userId, realmId)
+		}
+
+		return err
+	}
+
+	roles, err := getMapOfRealmAndClientRolesFromUser(keycloakClient, user)
+	if err != nil {
+		return err
+	}
+
+	data.Set("role_ids", getRoleIdsFromMapOfRealmAndClientRoles(roles))
+	data.SetId(userRolesId(realmId, userId))
+
+	return nil
+}
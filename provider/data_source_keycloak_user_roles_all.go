@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserRolesAll() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakUserRolesAllRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_ids": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUserRolesAllRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	users, err := keycloakClient.GetUsers(realmId)
+	if err != nil {
+		return err
+	}
+
+	// every user needs its own request, and each goroutine only writes to its own index, so no lock is needed
+	userRoleIds := make([][]string, len(users))
+	err = parallelForEach(keycloakClient.MaxConcurrency(), len(users), func(i int) error {
+		roles, err := getMapOfRealmAndClientRolesFromUser(keycloakClient, users[i])
+		if err != nil {
+			return err
+		}
+
+		userRoleIds[i] = getRoleIdsFromMapOfRealmAndClientRoles(roles)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var usersData []interface{}
+	for i, user := range users {
+		usersData = append(usersData, map[string]interface{}{
+			"user_id":  user.Id,
+			"username": user.Username,
+			"role_ids": userRoleIds[i],
+		})
+	}
+
+	data.Set("users", usersData)
+	data.SetId(realmId)
+
+	return nil
+}
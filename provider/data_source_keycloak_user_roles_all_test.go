@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAccKeycloakDataSourceUserRolesAll_basic(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	realmRole := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUserRolesAll_basic(realm, realmRole, username),
+				Check:  testAccCheckDataSourceKeycloakUserRolesAllHasUserRoles("data.keycloak_user_roles_all.all", "keycloak_user_roles.user_roles"),
+			},
+		},
+	})
+}
+
+func TestKeycloakDataSourceUserRolesAll_limitsConcurrency(t *testing.T) {
+	var mutex sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	server := newTestKeycloakServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/auth/admin/realms/test/users":
+			w.Write([]byte(`[{"id": "alice-id", "username": "alice"}, {"id": "bob-id", "username": "bob"}, {"id": "carol-id", "username": "carol"}, {"id": "dave-id", "username": "dave"}]`))
+		case strings.HasSuffix(r.URL.Path, "/role-mappings"):
+			mutex.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mutex.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			mutex.Lock()
+			inFlight--
+			mutex.Unlock()
+
+			userId := strings.Split(r.URL.Path, "/")[6]
+			w.Write([]byte(fmt.Sprintf(`{"realmMappings": [{"id": "%s-role", "name": "role"}]}`, userId)))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	keycloakClient := newTestKeycloakClientForServer(t, server, 2)
+
+	data := schema.TestResourceDataRaw(t, dataSourceKeycloakUserRolesAll().Schema, map[string]interface{}{
+		"realm_id": "test",
+	})
+
+	err := dataSourceKeycloakUserRolesAllRead(data, keycloakClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 role mapping requests at the same time, got %d", maxInFlight)
+	}
+
+	users := data.Get("users").([]interface{})
+	if len(users) != 4 {
+		t.Fatalf("expected 4 users, got %d", len(users))
+	}
+
+	for _, u := range users {
+		user := u.(map[string]interface{})
+		roleIds := user["role_ids"].(*schema.Set)
+
+		if roleIds.Len() != 1 || !roleIds.Contains(user["user_id"].(string)+"-role") {
+			t.Errorf("expected user %s to have only their own role, got %v", user["username"], roleIds.List())
+		}
+	}
+}
+
+func testAccCheckDataSourceKeycloakUserRolesAllHasUserRoles(dataSourceName, userRolesResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[userRolesResourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", userRolesResourceName)
+		}
+
+		userId := rs.Primary.Attributes["user_id"]
+
+		for i := 0; i < len(ds.Primary.Attributes); i++ {
+			prefix := fmt.Sprintf("users.%d.", i)
+			if _, ok := ds.Primary.Attributes[prefix+"user_id"]; !ok {
+				break
+			}
+
+			if ds.Primary.Attributes[prefix+"user_id"] != userId {
+				continue
+			}
+
+			if ds.Primary.Attributes[prefix+"role_ids.#"] != rs.Primary.Attributes["role_ids.#"] {
+				return fmt.Errorf("expected user %s to have %s roles, got %s", userId, rs.Primary.Attributes["role_ids.#"], ds.Primary.Attributes[prefix+"role_ids.#"])
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("expected %s to contain user %s", dataSourceName, userId)
+	}
+}
+
+func testDataSourceKeycloakUserRolesAll_basic(realm, realmRole, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role.id}",
+	]
+}
+
+data "keycloak_user_roles_all" "all" {
+	realm_id = "${keycloak_user_roles.user_roles.realm_id}"
+}
+	`, realm, realmRole, username)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccKeycloakDataSourceUserRoles_basic(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	realmRole := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUserRoles_basic(realm, realmRole, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_user_roles.user_roles", "id", "data.keycloak_user_roles.user_roles", "id"),
+					resource.TestCheckResourceAttrPair("keycloak_user_roles.user_roles", "role_ids.#", "data.keycloak_user_roles.user_roles", "role_ids.#"),
+					testAccCheckKeycloakUserHasRoles("data.keycloak_user_roles.user_roles"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUserRoles_userDoesNotExist(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakUserRoles_userDoesNotExist(realm),
+				ExpectError: regexp.MustCompile("user with id .+ does not exist"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUserRoles_basic(realm, realmRole, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role.id}",
+	]
+}
+
+data "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_user_roles.user_roles.realm_id}"
+	user_id  = "${keycloak_user_roles.user_roles.user_id}"
+}
+	`, realm, realmRole, username)
+}
+
+func testDataSourceKeycloakUserRoles_userDoesNotExist(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "6e6b5bc8-9f36-4bb0-a7d9-5d9b1c7f6a2e"
+}
+	`, realm)
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccKeycloakDataSourceUser_byUsername(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+	email := username + "@fakedomain.com"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUser_byUsername(realm, username, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_user.user", "id", "data.keycloak_user.user", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_user.user", "email", email),
+					resource.TestCheckResourceAttr("data.keycloak_user.user", "first_name", "Bob"),
+					resource.TestCheckResourceAttr("data.keycloak_user.user", "last_name", "Bobson"),
+					resource.TestCheckResourceAttr("data.keycloak_user.user", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.keycloak_user.user", "attributes.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUser_byEmail(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+	email := username + "@fakedomain.com"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUser_byEmail(realm, username, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_user.user", "id", "data.keycloak_user.user", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_user.user", "username", username),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUser_userDoesNotExist(t *testing.T) {
+	realm := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakUser_userDoesNotExist(realm),
+				ExpectError: regexp.MustCompile("user with username .+ does not exist"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUser_user(realm, username, email string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id   = "${keycloak_realm.realm.id}"
+	username   = "%s"
+	email      = "%s"
+	first_name = "Bob"
+	last_name  = "Bobson"
+
+	attributes = {
+		foo = "bar"
+	}
+}
+	`, realm, username, email)
+}
+
+func testDataSourceKeycloakUser_byUsername(realm, username, email string) string {
+	return testDataSourceKeycloakUser_user(realm, username, email) + `
+data "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "${keycloak_user.user.username}"
+}
+	`
+}
+
+func testDataSourceKeycloakUser_byEmail(realm, username, email string) string {
+	return testDataSourceKeycloakUser_user(realm, username, email) + `
+data "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	email    = "${keycloak_user.user.email}"
+}
+	`
+}
+
+func testDataSourceKeycloakUser_userDoesNotExist(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "this-user-does-not-exist"
+}
+	`, realm)
+}
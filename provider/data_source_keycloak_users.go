@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"sort"
+)
+
+func dataSourceKeycloakUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeycloakUsersRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"search": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUsersRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	search := data.Get("search").(string)
+
+	attributes := make(map[string]string)
+	for key, value := range data.Get("attributes").(map[string]interface{}) {
+		attributes[key] = value.(string)
+	}
+
+	users, err := keycloakClient.SearchUsers(realmId, search, attributes)
+	if err != nil {
+		return err
+	}
+
+	// keycloak doesn't guarantee an order, so the users are sorted to keep indexes stable between runs
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Username < users[j].Username
+	})
+
+	var flattenedUsers []interface{}
+	for _, user := range users {
+		flattenedUsers = append(flattenedUsers, map[string]interface{}{
+			"id":       user.Id,
+			"username": user.Username,
+			"email":    user.Email,
+		})
+	}
+
+	data.SetId(realmId)
+	data.Set("users", flattenedUsers)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccKeycloakDataSourceUsers_attributes(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+	otherUsername := "terraform-user-" + acctest.RandString(10)
+	dataSourceName := "data.keycloak_users.users"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUsers_attributes(realmName, username, otherUsername),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.id", "keycloak_user.user", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.username", username),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUsers_attributes(realmName, username, otherUsername string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+	attributes = {
+		"department" = "engineering"
+	}
+}
+
+resource "keycloak_user" "other_user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+	attributes = {
+		"department" = "sales"
+	}
+}
+
+data "keycloak_users" "users" {
+	realm_id = "${keycloak_realm.realm.id}"
+	attributes = {
+		"department" = "engineering"
+	}
+
+	depends_on = [
+		"keycloak_user.user",
+		"keycloak_user.other_user",
+	]
+}
+	`, realmName, username, otherUsername)
+}
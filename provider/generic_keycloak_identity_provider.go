@@ -155,6 +155,12 @@ func resourceKeycloakIdentityProviderCreate(getIdentityProviderFromData identity
 	return func(data *schema.ResourceData, meta interface{}) error {
 		keycloakClient := meta.(*keycloak.KeycloakClient)
 		identityProvider, err := getIdentityProviderFromData(data)
+		if err != nil {
+			return err
+		}
+		if err = keycloakClient.ValidateIdentityProvider(identityProvider); err != nil {
+			return err
+		}
 		if err = keycloakClient.NewIdentityProvider(identityProvider); err != nil {
 			return err
 		}
@@ -185,6 +191,12 @@ func resourceKeycloakIdentityProviderUpdate(getIdentityProviderFromData identity
 	return func(data *schema.ResourceData, meta interface{}) error {
 		keycloakClient := meta.(*keycloak.KeycloakClient)
 		identityProvider, err := getIdentityProviderFromData(data)
+		if err != nil {
+			return err
+		}
+		if err = keycloakClient.ValidateIdentityProvider(identityProvider); err != nil {
+			return err
+		}
 		if err = keycloakClient.UpdateIdentityProvider(identityProvider); err != nil {
 			return err
 		}
@@ -8,57 +8,103 @@ import (
 func KeycloakProvider() *schema.Provider {
 	return &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
+			"keycloak_authentication_flow":                dataSourceKeycloakAuthenticationFlow(),
 			"keycloak_group":                              dataSourceKeycloakGroup(),
+			"keycloak_group_roles":                        dataSourceKeycloakGroupRoles(),
 			"keycloak_openid_client":                      dataSourceKeycloakOpenidClient(),
 			"keycloak_openid_client_authorization_policy": dataSourceKeycloakOpenidClientAuthorizationPolicy(),
 			"keycloak_openid_client_service_account_user": dataSourceKeycloakOpenidClientServiceAccountUser(),
 			"keycloak_realm":                              dataSourceKeycloakRealm(),
 			"keycloak_realm_keys":                         dataSourceKeycloakRealmKeys(),
+			"keycloak_realm_roles":                        dataSourceKeycloakRealmRoles(),
+			"keycloak_server_info":                        dataSourceKeycloakServerInfo(),
+			"keycloak_client_roles":                       dataSourceKeycloakClientRoles(),
 			"keycloak_role":                               dataSourceKeycloakRole(),
+			"keycloak_user":                               dataSourceKeycloakUser(),
+			"keycloak_user_roles":                         dataSourceKeycloakUserRoles(),
+			"keycloak_user_roles_all":                     dataSourceKeycloakUserRolesAll(),
+			"keycloak_user_effective_roles":               dataSourceKeycloakUserEffectiveRoles(),
+			"keycloak_user_full":                          dataSourceKeycloakUserFull(),
+			"keycloak_users":                              dataSourceKeycloakUsers(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"keycloak_realm":                                           resourceKeycloakRealm(),
-			"keycloak_required_action":                                 resourceKeycloakRequiredAction(),
-			"keycloak_group":                                           resourceKeycloakGroup(),
-			"keycloak_group_memberships":                               resourceKeycloakGroupMemberships(),
-			"keycloak_default_groups":                                  resourceKeycloakDefaultGroups(),
-			"keycloak_group_roles":                                     resourceKeycloakGroupRoles(),
-			"keycloak_user":                                            resourceKeycloakUser(),
-			"keycloak_openid_client":                                   resourceKeycloakOpenidClient(),
-			"keycloak_openid_client_scope":                             resourceKeycloakOpenidClientScope(),
-			"keycloak_ldap_user_federation":                            resourceKeycloakLdapUserFederation(),
-			"keycloak_ldap_user_attribute_mapper":                      resourceKeycloakLdapUserAttributeMapper(),
-			"keycloak_ldap_group_mapper":                               resourceKeycloakLdapGroupMapper(),
-			"keycloak_ldap_hardcoded_role_mapper":                      resourceKeycloakLdapHardcodedRoleMapper(),
-			"keycloak_ldap_msad_user_account_control_mapper":           resourceKeycloakLdapMsadUserAccountControlMapper(),
-			"keycloak_ldap_full_name_mapper":                           resourceKeycloakLdapFullNameMapper(),
-			"keycloak_custom_user_federation":                          resourceKeycloakCustomUserFederation(),
-			"keycloak_openid_user_attribute_protocol_mapper":           resourceKeycloakOpenIdUserAttributeProtocolMapper(),
-			"keycloak_openid_user_property_protocol_mapper":            resourceKeycloakOpenIdUserPropertyProtocolMapper(),
-			"keycloak_openid_group_membership_protocol_mapper":         resourceKeycloakOpenIdGroupMembershipProtocolMapper(),
-			"keycloak_openid_full_name_protocol_mapper":                resourceKeycloakOpenIdFullNameProtocolMapper(),
-			"keycloak_openid_hardcoded_claim_protocol_mapper":          resourceKeycloakOpenIdHardcodedClaimProtocolMapper(),
-			"keycloak_openid_audience_protocol_mapper":                 resourceKeycloakOpenIdAudienceProtocolMapper(),
-			"keycloak_openid_hardcoded_role_protocol_mapper":           resourceKeycloakOpenIdHardcodedRoleProtocolMapper(),
-			"keycloak_openid_user_realm_role_protocol_mapper":          resourceKeycloakOpenIdUserRealmRoleProtocolMapper(),
-			"keycloak_openid_client_default_scopes":                    resourceKeycloakOpenidClientDefaultScopes(),
-			"keycloak_openid_client_optional_scopes":                   resourceKeycloakOpenidClientOptionalScopes(),
-			"keycloak_saml_client":                                     resourceKeycloakSamlClient(),
-			"keycloak_generic_client_protocol_mapper":                  resourceKeycloakGenericClientProtocolMapper(),
-			"keycloak_saml_user_attribute_protocol_mapper":             resourceKeycloakSamlUserAttributeProtocolMapper(),
-			"keycloak_saml_user_property_protocol_mapper":              resourceKeycloakSamlUserPropertyProtocolMapper(),
-			"keycloak_hardcoded_attribute_identity_provider_mapper":    resourceKeycloakHardcodedAttributeIdentityProviderMapper(),
-			"keycloak_hardcoded_role_identity_provider_mapper":         resourceKeycloakHardcodedRoleIdentityProviderMapper(),
-			"keycloak_attribute_importer_identity_provider_mapper":     resourceKeycloakAttributeImporterIdentityProviderMapper(),
-			"keycloak_attribute_to_role_identity_provider_mapper":      resourceKeycloakAttributeToRoleIdentityProviderMapper(),
-			"keycloak_user_template_importer_identity_provider_mapper": resourceKeycloakUserTemplateImporterIdentityProviderMapper(),
-			"keycloak_saml_identity_provider":                          resourceKeycloakSamlIdentityProvider(),
-			"keycloak_oidc_identity_provider":                          resourceKeycloakOidcIdentityProvider(),
-			"keycloak_openid_client_authorization_resource":            resourceKeycloakOpenidClientAuthorizationResource(),
-			"keycloak_openid_client_authorization_scope":               resourceKeycloakOpenidClientAuthorizationScope(),
-			"keycloak_openid_client_authorization_permission":          resourceKeycloakOpenidClientAuthorizationPermission(),
-			"keycloak_openid_client_service_account_role":              resourceKeycloakOpenidClientServiceAccountRole(),
-			"keycloak_role":                                            resourceKeycloakRole(),
+			"keycloak_realm":                                               resourceKeycloakRealm(),
+			"keycloak_realm_user_profile":                                  resourceKeycloakRealmUserProfile(),
+			"keycloak_realm_events":                                        resourceKeycloakRealmEvents(),
+			"keycloak_realm_smtp":                                          resourceKeycloakRealmSmtp(),
+			"keycloak_realm_client_profiles":                               resourceKeycloakRealmClientProfiles(),
+			"keycloak_realm_client_policies":                               resourceKeycloakRealmClientPolicies(),
+			"keycloak_realm_keystore_rsa":                                  resourceKeycloakRealmKeystoreRsa(),
+			"keycloak_realm_keystore_rsa_generated":                        resourceKeycloakRealmKeystoreRsaGenerated(),
+			"keycloak_realm_keystore_hmac_generated":                       resourceKeycloakRealmKeystoreHmacGenerated(),
+			"keycloak_realm_keystore_aes_generated":                        resourceKeycloakRealmKeystoreAesGenerated(),
+			"keycloak_realm_localization":                                  resourceKeycloakRealmLocalization(),
+			"keycloak_client_registration_policy_trusted_hosts":            resourceKeycloakClientRegistrationPolicyTrustedHosts(),
+			"keycloak_client_registration_policy_allowed_protocol_mappers": resourceKeycloakClientRegistrationPolicyAllowedProtocolMappers(),
+			"keycloak_realm_partial_import":                                resourceKeycloakRealmPartialImport(),
+			"keycloak_required_action":                                     resourceKeycloakRequiredAction(),
+			"keycloak_authentication_flow":                                 resourceKeycloakAuthenticationFlow(),
+			"keycloak_authentication_subflow":                              resourceKeycloakAuthenticationSubFlow(),
+			"keycloak_authentication_execution":                            resourceKeycloakAuthenticationExecution(),
+			"keycloak_authentication_execution_config":                     resourceKeycloakAuthenticationExecutionConfig(),
+			"keycloak_authentication_bindings":                             resourceKeycloakAuthenticationBindings(),
+			"keycloak_group":                                               resourceKeycloakGroup(),
+			"keycloak_group_memberships":                                   resourceKeycloakGroupMemberships(),
+			"keycloak_default_groups":                                      resourceKeycloakDefaultGroups(),
+			"keycloak_organization":                                        resourceKeycloakOrganization(),
+			"keycloak_organization_members":                                resourceKeycloakOrganizationMembers(),
+			"keycloak_default_roles":                                       resourceKeycloakDefaultRoles(),
+			"keycloak_group_roles":                                         resourceKeycloakGroupRoles(),
+			"keycloak_user":                                                resourceKeycloakUser(),
+			"keycloak_user_credential":                                     resourceKeycloakUserCredential(),
+			"keycloak_user_roles":                                          resourceKeycloakUserRoles(),
+			"keycloak_user_roles_by_name":                                  resourceKeycloakUserRolesByName(),
+			"keycloak_user_federated_identity":                             resourceKeycloakUserFederatedIdentity(),
+			"keycloak_user_group_memberships":                              resourceKeycloakUserGroupMemberships(),
+			"keycloak_openid_client":                                       resourceKeycloakOpenidClient(),
+			"keycloak_openid_client_scope":                                 resourceKeycloakOpenidClientScope(),
+			"keycloak_ldap_user_federation":                                resourceKeycloakLdapUserFederation(),
+			"keycloak_ldap_user_attribute_mapper":                          resourceKeycloakLdapUserAttributeMapper(),
+			"keycloak_ldap_group_mapper":                                   resourceKeycloakLdapGroupMapper(),
+			"keycloak_ldap_role_mapper":                                    resourceKeycloakLdapRoleMapper(),
+			"keycloak_ldap_hardcoded_role_mapper":                          resourceKeycloakLdapHardcodedRoleMapper(),
+			"keycloak_ldap_msad_user_account_control_mapper":               resourceKeycloakLdapMsadUserAccountControlMapper(),
+			"keycloak_ldap_full_name_mapper":                               resourceKeycloakLdapFullNameMapper(),
+			"keycloak_custom_user_federation":                              resourceKeycloakCustomUserFederation(),
+			"keycloak_component":                                           resourceKeycloakComponent(),
+			"keycloak_openid_user_attribute_protocol_mapper":               resourceKeycloakOpenIdUserAttributeProtocolMapper(),
+			"keycloak_openid_user_property_protocol_mapper":                resourceKeycloakOpenIdUserPropertyProtocolMapper(),
+			"keycloak_openid_group_membership_protocol_mapper":             resourceKeycloakOpenIdGroupMembershipProtocolMapper(),
+			"keycloak_openid_full_name_protocol_mapper":                    resourceKeycloakOpenIdFullNameProtocolMapper(),
+			"keycloak_openid_hardcoded_claim_protocol_mapper":              resourceKeycloakOpenIdHardcodedClaimProtocolMapper(),
+			"keycloak_openid_audience_protocol_mapper":                     resourceKeycloakOpenIdAudienceProtocolMapper(),
+			"keycloak_openid_hardcoded_role_protocol_mapper":               resourceKeycloakOpenIdHardcodedRoleProtocolMapper(),
+			"keycloak_openid_user_realm_role_protocol_mapper":              resourceKeycloakOpenIdUserRealmRoleProtocolMapper(),
+			"keycloak_openid_client_default_scopes":                        resourceKeycloakOpenidClientDefaultScopes(),
+			"keycloak_openid_client_optional_scopes":                       resourceKeycloakOpenidClientOptionalScopes(),
+			"keycloak_saml_client":                                         resourceKeycloakSamlClient(),
+			"keycloak_generic_client_protocol_mapper":                      resourceKeycloakGenericClientProtocolMapper(),
+			"keycloak_saml_user_attribute_protocol_mapper":                 resourceKeycloakSamlUserAttributeProtocolMapper(),
+			"keycloak_saml_user_property_protocol_mapper":                  resourceKeycloakSamlUserPropertyProtocolMapper(),
+			"keycloak_hardcoded_attribute_identity_provider_mapper":        resourceKeycloakHardcodedAttributeIdentityProviderMapper(),
+			"keycloak_hardcoded_role_identity_provider_mapper":             resourceKeycloakHardcodedRoleIdentityProviderMapper(),
+			"keycloak_attribute_importer_identity_provider_mapper":         resourceKeycloakAttributeImporterIdentityProviderMapper(),
+			"keycloak_attribute_to_role_identity_provider_mapper":          resourceKeycloakAttributeToRoleIdentityProviderMapper(),
+			"keycloak_advanced_claim_to_role_identity_provider_mapper":     resourceKeycloakAdvancedClaimToRoleIdentityProviderMapper(),
+			"keycloak_user_template_importer_identity_provider_mapper":     resourceKeycloakUserTemplateImporterIdentityProviderMapper(),
+			"keycloak_saml_identity_provider":                              resourceKeycloakSamlIdentityProvider(),
+			"keycloak_oidc_identity_provider":                              resourceKeycloakOidcIdentityProvider(),
+			"keycloak_identity_provider_token_exchange_scope_permission":   resourceKeycloakIdentityProviderTokenExchangeScopePermission(),
+			"keycloak_openid_client_authorization_resource":                resourceKeycloakOpenidClientAuthorizationResource(),
+			"keycloak_openid_client_authorization_scope":                   resourceKeycloakOpenidClientAuthorizationScope(),
+			"keycloak_openid_client_authorization_permission":              resourceKeycloakOpenidClientAuthorizationPermission(),
+			"keycloak_openid_client_authorization_scope_permission":        resourceKeycloakOpenidClientAuthorizationScopePermission(),
+			"keycloak_openid_client_role_policy":                           resourceKeycloakOpenidClientRolePolicy(),
+			"keycloak_users_permissions":                                   resourceKeycloakUsersPermissions(),
+			"keycloak_openid_client_permissions":                           resourceKeycloakOpenidClientPermissions(),
+			"keycloak_openid_client_service_account_role":                  resourceKeycloakOpenidClientServiceAccountRole(),
+			"keycloak_openid_client_service_account_realm_roles":           resourceKeycloakOpenidClientServiceAccountRealmRoles(),
+			"keycloak_role":                                                resourceKeycloakRole(),
 		},
 		Schema: map[string]*schema.Schema{
 			"client_id": {
@@ -92,6 +138,12 @@ func KeycloakProvider() *schema.Provider {
 				Description: "The base URL of the Keycloak instance, before `/auth`",
 				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_URL", nil),
 			},
+			"base_path": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "The path Keycloak is served under, such as `/auth`. Set this to an empty string for Keycloak servers that are served from the root of `url`",
+				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_BASE_PATH", "/auth"),
+			},
 			"initial_login": {
 				Optional:    true,
 				Type:        schema.TypeBool,
@@ -104,6 +156,36 @@ func KeycloakProvider() *schema.Provider {
 				Description: "Timeout (in seconds) of the Keycloak client",
 				Default:     5,
 			},
+			"request_timeout": {
+				Optional:    true,
+				Type:        schema.TypeInt,
+				Description: "Time (in seconds) a request to Keycloak may take, including any retries, before it is aborted",
+				Default:     30,
+			},
+			"retry_count": {
+				Optional:    true,
+				Type:        schema.TypeInt,
+				Description: "Number of times to retry a request that fails with a 429, 502, 503, or 504 response",
+				Default:     3,
+			},
+			"retry_wait": {
+				Optional:    true,
+				Type:        schema.TypeInt,
+				Description: "Time (in seconds) to wait before the first retry. This doubles with each subsequent retry",
+				Default:     1,
+			},
+			"max_concurrency": {
+				Optional:    true,
+				Type:        schema.TypeInt,
+				Description: "Maximum number of requests to send in parallel when a single resource needs to look up many clients or roles",
+				Default:     4,
+			},
+			"enable_read_cache": {
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Description: "Cache role and client lookups in memory for a short time, to avoid fetching the same data for every resource",
+				Default:     false,
+			},
 		},
 		ConfigureFunc: configureKeycloakProvider,
 	}
@@ -118,6 +200,12 @@ func configureKeycloakProvider(data *schema.ResourceData) (interface{}, error) {
 	realm := data.Get("realm").(string)
 	initialLogin := data.Get("initial_login").(bool)
 	clientTimeout := data.Get("client_timeout").(int)
+	retryCount := data.Get("retry_count").(int)
+	retryWait := data.Get("retry_wait").(int)
+	maxConcurrency := data.Get("max_concurrency").(int)
+	enableReadCache := data.Get("enable_read_cache").(bool)
+	requestTimeout := data.Get("request_timeout").(int)
+	basePath := data.Get("base_path").(string)
 
-	return keycloak.NewKeycloakClient(url, clientId, clientSecret, realm, username, password, initialLogin, clientTimeout)
+	return keycloak.NewKeycloakClient(url, clientId, clientSecret, realm, username, password, initialLogin, clientTimeout, retryCount, retryWait, maxConcurrency, enableReadCache, requestTimeout, basePath)
 }
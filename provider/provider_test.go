@@ -3,6 +3,7 @@ package provider
 import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 	"os"
 	"testing"
 )
@@ -37,3 +38,32 @@ func testAccPreCheck(t *testing.T) {
 		}
 	}
 }
+
+// skips the test when the keycloak server used for acceptance tests is older than `majorVersion`. this can't use
+// `testAccProvider`, since the provider isn't configured until the test starts running
+func testAccPreCheckKeycloakVersion(t *testing.T, majorVersion int) {
+	// newer versions of keycloak are served without the /auth prefix, which is only set for the provider through the environment
+	basePath, ok := os.LookupEnv("KEYCLOAK_BASE_PATH")
+	if !ok {
+		basePath = "/auth"
+	}
+
+	keycloakClient, err := keycloak.NewKeycloakClient(os.Getenv("KEYCLOAK_URL"), os.Getenv("KEYCLOAK_CLIENT_ID"), os.Getenv("KEYCLOAK_CLIENT_SECRET"), os.Getenv("KEYCLOAK_REALM"), "", "", true, 5, 3, 1, 1, false, 30, basePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	version, err := serverInfo.MajorVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if version < majorVersion {
+		t.Skipf("keycloak %d or later is required, but the server is running %s", majorVersion, serverInfo.SystemInfo.ServerVersion)
+	}
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// the password policies that have an argument of their own in `password_policy_settings`. any other policy is kept in
+// `extra`
+var realmPasswordPolicies = []struct {
+	key       string
+	policyId  string
+	valueType schema.ValueType
+}{
+	{"length", "length", schema.TypeInt},
+	{"max_length", "maxLength", schema.TypeInt},
+	{"digits", "digits", schema.TypeInt},
+	{"lower_case", "lowerCase", schema.TypeInt},
+	{"upper_case", "upperCase", schema.TypeInt},
+	{"special_chars", "specialChars", schema.TypeInt},
+	{"not_username", "notUsername", schema.TypeBool},
+	{"not_email", "notEmail", schema.TypeBool},
+	{"password_history", "passwordHistory", schema.TypeInt},
+	{"force_expired_password_change", "forceExpiredPasswordChange", schema.TypeInt},
+	{"hash_algorithm", "hashAlgorithm", schema.TypeString},
+	{"hash_iterations", "hashIterations", schema.TypeInt},
+	{"regex_pattern", "regexPattern", schema.TypeString},
+}
+
+func realmPasswordPolicySettingsSchema() map[string]*schema.Schema {
+	settingsSchema := map[string]*schema.Schema{
+		"extra": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Policies that don't have an argument of their own, keyed by their provider ID. Policies that don't take a value can be set to an empty string.",
+		},
+	}
+
+	for _, policy := range realmPasswordPolicies {
+		settingsSchema[policy.key] = &schema.Schema{
+			Type:     policy.valueType,
+			Optional: true,
+		}
+	}
+
+	return settingsSchema
+}
+
+// parses a password policy string such as "length(8) and notUsername(undefined)" into a map of policy IDs to values.
+// policies without a value are mapped to an empty string
+func parsePasswordPolicy(passwordPolicy string) map[string]string {
+	policies := make(map[string]string)
+
+	if passwordPolicy == "" {
+		return policies
+	}
+
+	for _, policy := range strings.Split(passwordPolicy, " and ") {
+		policy = strings.TrimSpace(policy)
+
+		if i := strings.Index(policy, "("); i != -1 && strings.HasSuffix(policy, ")") {
+			policies[policy[:i]] = policy[i+1 : len(policy)-1]
+		} else {
+			policies[policy] = ""
+		}
+	}
+
+	return policies
+}
+
+// the inverse of `parsePasswordPolicy`. policies are sorted by ID so the same policies always produce the same string
+func formatPasswordPolicy(policies map[string]string) string {
+	var policyIds []string
+	for policyId := range policies {
+		policyIds = append(policyIds, policyId)
+	}
+	sort.Strings(policyIds)
+
+	var formatted []string
+	for _, policyId := range policyIds {
+		if value := policies[policyId]; value != "" {
+			formatted = append(formatted, fmt.Sprintf("%s(%s)", policyId, value))
+		} else {
+			formatted = append(formatted, policyId)
+		}
+	}
+
+	return strings.Join(formatted, " and ")
+}
+
+func getRealmPasswordPolicyFromSettings(settings map[string]interface{}) string {
+	policies := make(map[string]string)
+
+	for policyId, value := range settings["extra"].(map[string]interface{}) {
+		policies[policyId] = value.(string)
+	}
+
+	for _, policy := range realmPasswordPolicies {
+		switch value := settings[policy.key].(type) {
+		case int:
+			if value > 0 {
+				policies[policy.policyId] = strconv.Itoa(value)
+			}
+		case bool:
+			// the admin console sends "undefined" for policies that don't take a value, so the same is done here
+			if value {
+				policies[policy.policyId] = "undefined"
+			}
+		case string:
+			if value != "" {
+				policies[policy.policyId] = value
+			}
+		}
+	}
+
+	return formatPasswordPolicy(policies)
+}
+
+// the opposite of `getRealmPasswordPolicyFromSettings`. policies whose value doesn't fit their argument are kept in
+// `extra`, so nothing that's set on the realm is lost
+func getRealmPasswordPolicySettings(passwordPolicy string) map[string]interface{} {
+	policies := parsePasswordPolicy(passwordPolicy)
+	settings := make(map[string]interface{})
+
+	for _, policy := range realmPasswordPolicies {
+		value, ok := policies[policy.policyId]
+		if !ok {
+			continue
+		}
+
+		switch policy.valueType {
+		case schema.TypeInt:
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				continue
+			}
+			settings[policy.key] = i
+		case schema.TypeBool:
+			settings[policy.key] = true
+		case schema.TypeString:
+			settings[policy.key] = value
+		}
+
+		delete(policies, policy.policyId)
+	}
+
+	extra := make(map[string]interface{})
+	for policyId, value := range policies {
+		extra[policyId] = value
+	}
+	settings["extra"] = extra
+
+	return settings
+}
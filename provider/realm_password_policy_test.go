@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRealmPasswordPolicySettings_roundTrip(t *testing.T) {
+	settings := map[string]interface{}{
+		"length":         8,
+		"digits":         1,
+		"not_username":   true,
+		"not_email":      false,
+		"hash_algorithm": "pbkdf2-sha256",
+		"extra": map[string]interface{}{
+			"passwordBlacklist": "blacklist.txt",
+			"someFlag":          "",
+		},
+	}
+
+	passwordPolicy := getRealmPasswordPolicyFromSettings(settings)
+
+	expected := "digits(1) and hashAlgorithm(pbkdf2-sha256) and length(8) and notUsername(undefined) and passwordBlacklist(blacklist.txt) and someFlag"
+	if passwordPolicy != expected {
+		t.Fatalf("expected password policy %q, got %q", expected, passwordPolicy)
+	}
+
+	parsed := getRealmPasswordPolicySettings(passwordPolicy)
+
+	expectedSettings := map[string]interface{}{
+		"length":         8,
+		"digits":         1,
+		"not_username":   true,
+		"hash_algorithm": "pbkdf2-sha256",
+		"extra": map[string]interface{}{
+			"passwordBlacklist": "blacklist.txt",
+			"someFlag":          "",
+		},
+	}
+	if !reflect.DeepEqual(parsed, expectedSettings) {
+		t.Errorf("expected parsed settings %v, got %v", expectedSettings, parsed)
+	}
+}
+
+func TestRealmPasswordPolicySettings_orderAndUndefinedDoNotMatter(t *testing.T) {
+	a := getRealmPasswordPolicySettings("notUsername(undefined) and length(8) and digits(1)")
+	b := getRealmPasswordPolicySettings("digits(1) and length(8) and notUsername")
+
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("expected the same policies to produce the same settings, got %v and %v", a, b)
+	}
+}
+
+func TestRealmPasswordPolicySettings_invalidValuesAreKept(t *testing.T) {
+	settings := getRealmPasswordPolicySettings("length(eight)")
+
+	if _, ok := settings["length"]; ok {
+		t.Errorf("expected an invalid length to not be set, got %v", settings["length"])
+	}
+
+	if extra := settings["extra"].(map[string]interface{}); extra["length"] != "eight" {
+		t.Errorf("expected an invalid length to be kept in extra, got %v", extra)
+	}
+}
+
+func TestRealmPasswordPolicySettings_empty(t *testing.T) {
+	if passwordPolicy := getRealmPasswordPolicyFromSettings(map[string]interface{}{"extra": map[string]interface{}{}}); passwordPolicy != "" {
+		t.Errorf("expected no policies to produce an empty password policy, got %q", passwordPolicy)
+	}
+
+	settings := getRealmPasswordPolicySettings("")
+	if len(settings) != 1 || len(settings["extra"].(map[string]interface{})) != 0 {
+		t.Errorf("expected an empty password policy to produce no settings, got %v", settings)
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakAdvancedClaimToRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"claims": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "Claims that must all be present with the given values for the role to be granted",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "OIDC Claim Name",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "OIDC Claim Value",
+					},
+				},
+			},
+		},
+		"claim_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Treat claim values as regular expressions",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.Create = resourceKeycloakIdentityProviderMapperCreate(getAdvancedClaimToRoleIdentityProviderMapperFromData, setAdvancedClaimToRoleIdentityProviderMapperData)
+	genericMapperResource.Read = resourceKeycloakIdentityProviderMapperRead(setAdvancedClaimToRoleIdentityProviderMapperData)
+	genericMapperResource.Update = resourceKeycloakIdentityProviderMapperUpdate(getAdvancedClaimToRoleIdentityProviderMapperFromData, setAdvancedClaimToRoleIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getAdvancedClaimToRoleIdentityProviderMapperFromData(data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+	rec, _ := getIdentityProviderMapperFromData(data)
+	identityProvider, err := keycloakClient.GetIdentityProvider(rec.Realm, rec.IdentityProviderAlias)
+	if err != nil {
+		return nil, handleNotFoundError(err, data)
+	}
+	if identityProvider.ProviderId != "oidc" && identityProvider.ProviderId != "keycloak-oidc" {
+		return nil, fmt.Errorf(`provider.keycloak: keycloak_advanced_claim_to_role_identity_provider_mapper: %s: "%s" identity provider is not supported, only OIDC identity providers can be used`, data.Get("name").(string), identityProvider.ProviderId)
+	}
+
+	var claims []keycloak.IdentityProviderMapperClaim
+	for _, c := range data.Get("claims").([]interface{}) {
+		claim := c.(map[string]interface{})
+		claims = append(claims, keycloak.IdentityProviderMapperClaim{
+			Key:   claim["key"].(string),
+			Value: claim["value"].(string),
+		})
+	}
+
+	claimsJson, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = keycloak.OidcAdvancedRoleIdentityProviderMapperType
+	rec.Config = &keycloak.IdentityProviderMapperConfig{
+		Role:                data.Get("role").(string),
+		Claims:              string(claimsJson),
+		AreClaimValuesRegex: keycloak.KeycloakBoolQuoted(data.Get("claim_values_regex").(bool)),
+	}
+	return rec, nil
+}
+
+func setAdvancedClaimToRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+
+	var claims []keycloak.IdentityProviderMapperClaim
+	if identityProviderMapper.Config.Claims != "" {
+		err := json.Unmarshal([]byte(identityProviderMapper.Config.Claims), &claims)
+		if err != nil {
+			return fmt.Errorf("unable to parse claims for identity provider mapper %s: %s", identityProviderMapper.Id, err)
+		}
+	}
+
+	var flattenedClaims []interface{}
+	for _, claim := range claims {
+		flattenedClaims = append(flattenedClaims, map[string]interface{}{
+			"key":   claim.Key,
+			"value": claim.Value,
+		})
+	}
+
+	data.Set("claims", flattenedClaims)
+	data.Set("claim_values_regex", bool(identityProviderMapper.Config.AreClaimValuesRegex))
+	data.Set("role", identityProviderMapper.Config.Role)
+	return nil
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	mapperName := "terraform-" + acctest.RandString(10)
+	alias := "terraform-" + acctest.RandString(10)
+	role := "terraform-" + acctest.RandString(10)
+	resourceName := "keycloak_advanced_claim_to_role_identity_provider_mapper.oidc"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(realmName, alias, mapperName, role, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperHasClaims(resourceName, `[{"key":"department","value":"engineering"},{"key":"team","value":"platform"}]`),
+					resource.TestCheckResourceAttr(resourceName, "claims.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "claims.0.key", "department"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccKeycloakIdentityProviderMapperImportId(resourceName),
+			},
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(realmName, alias, mapperName, role, true),
+				Check:  resource.TestCheckResourceAttr(resourceName, "claim_values_regex", "true"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakIdentityProviderMapperImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm"], rs.Primary.Attributes["identity_provider_alias"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperHasClaims(resourceName, claims string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		mapper, err := getKeycloakAttributeToRoleIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if mapper.IdentityProviderMapper != keycloak.OidcAdvancedRoleIdentityProviderMapperType {
+			return fmt.Errorf("expected mapper %s to have type %s, but got %s", mapper.Name, keycloak.OidcAdvancedRoleIdentityProviderMapperType, mapper.IdentityProviderMapper)
+		}
+
+		if mapper.Config.Claims != claims {
+			return fmt.Errorf("expected mapper %s to have claims %s, but got %s", mapper.Name, claims, mapper.Config.Claims)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_advanced_claim_to_role_identity_provider_mapper" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm"]
+			alias := rs.Primary.Attributes["identity_provider_alias"]
+			id := rs.Primary.ID
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(realm, alias, id)
+			if mapper != nil {
+				return fmt.Errorf("identity provider mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(realm, alias, name, role string, claimValuesRegex bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = "${keycloak_realm.realm.id}"
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource keycloak_advanced_claim_to_role_identity_provider_mapper oidc {
+	realm                   = "${keycloak_realm.realm.id}"
+	name                    = "%s"
+	identity_provider_alias = "${keycloak_oidc_identity_provider.oidc.alias}"
+	role                    = "%s"
+	claim_values_regex      = %t
+
+	claims {
+		key   = "department"
+		value = "engineering"
+	}
+
+	claims {
+		key   = "team"
+		value = "platform"
+	}
+}
+	`, realm, alias, name, role, claimValuesRegex)
+}
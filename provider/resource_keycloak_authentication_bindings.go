@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakAuthenticationBindings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakAuthenticationBindingsCreate,
+		Read:   resourceKeycloakAuthenticationBindingsRead,
+		Update: resourceKeycloakAuthenticationBindingsUpdate,
+		Delete: resourceKeycloakAuthenticationBindingsDelete,
+		// This resource can be imported using {{realm}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakAuthenticationBindingsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"browser_flow": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The alias of the flow used for browser authentication.",
+			},
+			"registration_flow": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The alias of the flow used for user registration.",
+			},
+			"direct_grant_flow": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The alias of the flow used for direct access grants.",
+			},
+			"reset_credentials_flow": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The alias of the flow used when a user has forgotten their credentials.",
+			},
+			"client_authentication_flow": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The alias of the flow used for client authentication.",
+			},
+			"docker_authentication_flow": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The alias of the flow used for docker authentication.",
+			},
+		},
+	}
+}
+
+// only the bindings that are set are changed, the others keep the flow that's already bound
+func setRealmAuthenticationBindingsFromData(data *schema.ResourceData, realm *keycloak.Realm) {
+	if flow, ok := data.GetOk("browser_flow"); ok {
+		realm.BrowserFlow = flow.(string)
+	}
+
+	if flow, ok := data.GetOk("registration_flow"); ok {
+		realm.RegistrationFlow = flow.(string)
+	}
+
+	if flow, ok := data.GetOk("direct_grant_flow"); ok {
+		realm.DirectGrantFlow = flow.(string)
+	}
+
+	if flow, ok := data.GetOk("reset_credentials_flow"); ok {
+		realm.ResetCredentialsFlow = flow.(string)
+	}
+
+	if flow, ok := data.GetOk("client_authentication_flow"); ok {
+		realm.ClientAuthenticationFlow = flow.(string)
+	}
+
+	if flow, ok := data.GetOk("docker_authentication_flow"); ok {
+		realm.DockerAuthenticationFlow = flow.(string)
+	}
+}
+
+func setAuthenticationBindingsData(data *schema.ResourceData, realm *keycloak.Realm) {
+	data.Set("browser_flow", realm.BrowserFlow)
+	data.Set("registration_flow", realm.RegistrationFlow)
+	data.Set("direct_grant_flow", realm.DirectGrantFlow)
+	data.Set("reset_credentials_flow", realm.ResetCredentialsFlow)
+	data.Set("client_authentication_flow", realm.ClientAuthenticationFlow)
+	data.Set("docker_authentication_flow", realm.DockerAuthenticationFlow)
+}
+
+func resourceKeycloakAuthenticationBindingsCreate(data *schema.ResourceData, meta interface{}) error {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakAuthenticationBindingsUpdate(data, meta)
+}
+
+func resourceKeycloakAuthenticationBindingsRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setAuthenticationBindingsData(data, realm)
+
+	return nil
+}
+
+func resourceKeycloakAuthenticationBindingsUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// the bindings are part of the realm, so the realm is read first to avoid changing any of its other settings
+	realm, err := keycloakClient.GetRealm(data.Get("realm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	setRealmAuthenticationBindingsFromData(data, realm)
+
+	err = keycloakClient.UpdateRealm(realm)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakAuthenticationBindingsRead(data, meta)
+}
+
+func resourceKeycloakAuthenticationBindingsDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(data.Get("realm_id").(string))
+	if err != nil {
+		// the bindings were deleted along with the realm, so there's nothing left to do
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	// the bindings are reset to the flows keycloak binds a new realm to
+	realm.BrowserFlow = "browser"
+	realm.RegistrationFlow = "registration"
+	realm.DirectGrantFlow = "direct grant"
+	realm.ResetCredentialsFlow = "reset credentials"
+	realm.ClientAuthenticationFlow = "clients"
+	realm.DockerAuthenticationFlow = "docker auth"
+
+	return keycloakClient.UpdateRealm(realm)
+}
+
+func resourceKeycloakAuthenticationBindingsImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
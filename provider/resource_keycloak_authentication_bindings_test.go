@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakAuthenticationBindings_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	flowAlias := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationBindingsDestroy(realmName),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationBindings_basic(realmName, flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationBindingsBrowserFlow(realmName, flowAlias),
+					resource.TestCheckResourceAttr("keycloak_authentication_bindings.bindings", "direct_grant_flow", "direct grant"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_authentication_bindings.bindings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakAuthenticationBindings_realmAndFlow(realmName, flowAlias),
+				Check:  testAccCheckKeycloakAuthenticationBindingsBrowserFlow(realmName, "browser"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAuthenticationBindingsBrowserFlow(realmName, flowAlias string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		realm, err := keycloakClient.GetRealm(realmName)
+		if err != nil {
+			return err
+		}
+
+		if realm.BrowserFlow != flowAlias {
+			return fmt.Errorf("expected realm %s to have browser flow %s, but got %s", realmName, flowAlias, realm.BrowserFlow)
+		}
+
+		return nil
+	}
+}
+
+// the realm is destroyed along with the bindings, so this only checks that the realm is gone
+func testAccCheckKeycloakAuthenticationBindingsDestroy(realmName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		realm, _ := keycloakClient.GetRealm(realmName)
+		if realm != nil {
+			return fmt.Errorf("realm %s still exists", realmName)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAuthenticationBindings_realmAndFlow(realmName, flowAlias string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "cookie" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	parent_flow_alias = "${keycloak_authentication_flow.flow.alias}"
+	authenticator     = "auth-cookie"
+	requirement       = "ALTERNATIVE"
+}
+	`, realmName, flowAlias)
+}
+
+func testKeycloakAuthenticationBindings_basic(realmName, flowAlias string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_authentication_bindings" "bindings" {
+	realm_id     = "${keycloak_realm.realm.id}"
+	browser_flow = "${keycloak_authentication_flow.flow.alias}"
+
+	depends_on = ["keycloak_authentication_execution.cookie"]
+}
+	`, testKeycloakAuthenticationBindings_realmAndFlow(realmName, flowAlias))
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+var keycloakAuthenticationExecutionRequirements = []string{"REQUIRED", "ALTERNATIVE", "OPTIONAL", "CONDITIONAL", "DISABLED"}
+
+func resourceKeycloakAuthenticationExecution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakAuthenticationExecutionCreate,
+		Read:   resourceKeycloakAuthenticationExecutionRead,
+		Update: resourceKeycloakAuthenticationExecutionUpdate,
+		Delete: resourceKeycloakAuthenticationExecutionDelete,
+		// This resource can be imported using {{realm}}/{{parentFlowAlias}}/{{executionId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakAuthenticationExecutionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent_flow_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"authenticator": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"requirement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "DISABLED",
+				ValidateFunc: validation.StringInSlice(keycloakAuthenticationExecutionRequirements, false),
+			},
+			"index": authenticationExecutionIndexSchema(),
+		},
+	}
+}
+
+// the position of an execution or subflow within its parent flow, starting at 0. when this isn't set, new executions
+// are added to the end of the flow and are left wherever they are moved to afterwards
+func authenticationExecutionIndexSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+}
+
+// moves the execution to the configured `index`, if there is one
+func setAuthenticationExecutionIndex(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, executionId string) error {
+	// GetOk would ignore an index of 0, which moves the execution to the start of the flow
+	index, ok := data.GetOkExists("index")
+	if !ok {
+		return nil
+	}
+
+	realmId := data.Get("realm_id").(string)
+	parentFlowAlias := data.Get("parent_flow_alias").(string)
+
+	defer keycloakClient.LockAuthenticationFlow(realmId, parentFlowAlias)()
+
+	return keycloakClient.MoveAuthenticationExecution(realmId, parentFlowAlias, executionId, index.(int))
+}
+
+// the index is only tracked when it's managed, otherwise adding an execution would cause a diff for every execution after it
+func readAuthenticationExecutionIndex(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, executionId string) error {
+	if _, ok := data.GetOkExists("index"); !ok {
+		return nil
+	}
+
+	index, err := keycloakClient.GetAuthenticationExecutionIndex(data.Get("realm_id").(string), data.Get("parent_flow_alias").(string), executionId)
+	if err != nil {
+		return err
+	}
+
+	data.Set("index", index)
+
+	return nil
+}
+
+func getAuthenticationExecutionFromData(data *schema.ResourceData) *keycloak.AuthenticationExecution {
+	return &keycloak.AuthenticationExecution{
+		Id:              data.Id(),
+		RealmId:         data.Get("realm_id").(string),
+		ParentFlowAlias: data.Get("parent_flow_alias").(string),
+		Authenticator:   data.Get("authenticator").(string),
+		Requirement:     data.Get("requirement").(string),
+	}
+}
+
+func setAuthenticationExecutionData(data *schema.ResourceData, execution *keycloak.AuthenticationExecution) {
+	data.SetId(execution.Id)
+	data.Set("realm_id", execution.RealmId)
+	data.Set("parent_flow_alias", execution.ParentFlowAlias)
+	data.Set("authenticator", execution.Authenticator)
+	data.Set("requirement", execution.Requirement)
+}
+
+func resourceKeycloakAuthenticationExecutionCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	execution := getAuthenticationExecutionFromData(data)
+
+	err := keycloakClient.NewAuthenticationExecution(execution)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(execution.Id)
+
+	err = setAuthenticationExecutionIndex(keycloakClient, data, execution.Id)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakAuthenticationExecutionRead(data, meta)
+}
+
+func resourceKeycloakAuthenticationExecutionRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parentFlowAlias := data.Get("parent_flow_alias").(string)
+
+	execution, err := keycloakClient.GetAuthenticationExecution(realmId, parentFlowAlias, data.Id())
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setAuthenticationExecutionData(data, execution)
+
+	return readAuthenticationExecutionIndex(keycloakClient, data, execution.Id)
+}
+
+func resourceKeycloakAuthenticationExecutionUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	execution := getAuthenticationExecutionFromData(data)
+
+	err := keycloakClient.UpdateAuthenticationExecutionRequirement(execution.RealmId, execution.ParentFlowAlias, execution.Id, execution.Requirement)
+	if err != nil {
+		return err
+	}
+
+	err = setAuthenticationExecutionIndex(keycloakClient, data, execution.Id)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakAuthenticationExecutionRead(data, meta)
+}
+
+func resourceKeycloakAuthenticationExecutionDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return keycloakClient.DeleteAuthenticationExecution(data.Get("realm_id").(string), data.Id())
+}
+
+func resourceKeycloakAuthenticationExecutionImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{parentFlowAlias}}/{{authenticationExecutionId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("parent_flow_alias", parts[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
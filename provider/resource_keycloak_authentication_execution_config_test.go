@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakAuthenticationExecutionConfig_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	flowAlias := "terraform-flow-" + acctest.RandString(10)
+	configAlias := "terraform-config-" + acctest.RandString(10)
+
+	resourceName := "keycloak_authentication_execution_config.config"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationExecutionConfigDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationExecutionConfig_basic(realmName, flowAlias, configAlias, "my-provider"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationExecutionConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias", configAlias),
+					resource.TestCheckResourceAttr(resourceName, "config.defaultProvider", "my-provider"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["execution_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				Config: testKeycloakAuthenticationExecutionConfig_basic(realmName, flowAlias, configAlias, "my-other-provider"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "config.defaultProvider", "my-other-provider"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationExecutionConfig_createAfterManualDestroy(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	flowAlias := "terraform-flow-" + acctest.RandString(10)
+	configAlias := "terraform-config-" + acctest.RandString(10)
+
+	resourceName := "keycloak_authentication_execution_config.config"
+
+	var configId string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationExecutionConfigDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationExecutionConfig_basic(realmName, flowAlias, configAlias, "my-provider"),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return fmt.Errorf("resource not found: %s", resourceName)
+					}
+
+					configId = rs.Primary.ID
+
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					err := keycloakClient.DeleteAuthenticationExecutionConfig(realmName, configId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakAuthenticationExecutionConfig_basic(realmName, flowAlias, configAlias, "my-provider"),
+				Check:  testAccCheckKeycloakAuthenticationExecutionConfigExists(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAuthenticationExecutionConfigExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		_, err := keycloakClient.GetAuthenticationExecutionConfig(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["execution_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting authentication execution config with id %s: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAuthenticationExecutionConfigDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_authentication_execution_config" {
+				continue
+			}
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			config, _ := keycloakClient.GetAuthenticationExecutionConfig(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["execution_id"], rs.Primary.ID)
+			if config != nil {
+				return fmt.Errorf("authentication execution config %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAuthenticationExecutionConfig_basic(realm, flowAlias, configAlias, defaultProvider string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "redirector" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	parent_flow_alias = "${keycloak_authentication_flow.flow.alias}"
+	authenticator     = "identity-provider-redirector"
+	requirement       = "ALTERNATIVE"
+}
+
+resource "keycloak_authentication_execution_config" "config" {
+	realm_id     = "${keycloak_realm.realm.id}"
+	execution_id = "${keycloak_authentication_execution.redirector.id}"
+	alias        = "%s"
+
+	config = {
+		defaultProvider = "%s"
+	}
+}
+	`, realm, flowAlias, configAlias, defaultProvider)
+}
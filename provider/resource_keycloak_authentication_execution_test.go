@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakAuthenticationExecution_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	flowAlias := "terraform-flow-" + acctest.RandString(10)
+
+	resourceName := "keycloak_authentication_execution.cookie"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationExecutionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationExecution_ordered(realmName, flowAlias, 0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationExecutionsOrdered(realmName, flowAlias, []string{"auth-cookie", "identity-provider-redirector"}),
+					resource.TestCheckResourceAttr(resourceName, "requirement", "ALTERNATIVE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"index"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["parent_flow_alias"], rs.Primary.ID), nil
+				},
+			},
+			{
+				Config: testKeycloakAuthenticationExecution_ordered(realmName, flowAlias, 1, 0),
+				Check:  testAccCheckKeycloakAuthenticationExecutionsOrdered(realmName, flowAlias, []string{"identity-provider-redirector", "auth-cookie"}),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAuthenticationExecutionDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_authentication_execution" {
+				continue
+			}
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			execution, _ := keycloakClient.GetAuthenticationExecution(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["parent_flow_alias"], rs.Primary.ID)
+			if execution != nil {
+				return fmt.Errorf("authentication execution %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAuthenticationExecution_ordered(realm, flowAlias string, cookieIndex, redirectorIndex int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "cookie" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	parent_flow_alias = "${keycloak_authentication_flow.flow.alias}"
+	authenticator     = "auth-cookie"
+	requirement       = "ALTERNATIVE"
+	index             = %d
+}
+
+resource "keycloak_authentication_execution" "redirector" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	parent_flow_alias = "${keycloak_authentication_flow.flow.alias}"
+	authenticator     = "identity-provider-redirector"
+	requirement       = "ALTERNATIVE"
+	index             = %d
+
+	depends_on = ["keycloak_authentication_execution.cookie"]
+}
+	`, realm, flowAlias, cookieIndex, redirectorIndex)
+}
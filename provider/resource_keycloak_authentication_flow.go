@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+var keycloakAuthenticationFlowProviderIds = []string{"basic-flow", "client-flow"}
+
+func resourceKeycloakAuthenticationFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakAuthenticationFlowCreate,
+		Read:   resourceKeycloakAuthenticationFlowRead,
+		Update: resourceKeycloakAuthenticationFlowUpdate,
+		Delete: resourceKeycloakAuthenticationFlowDelete,
+		// This resource can be imported using {{realm}}/{{flowId}}. The flow ID can be found with GET realms/{{realm}}/authentication/flows
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakAuthenticationFlowImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "basic-flow",
+				ValidateFunc: validation.StringInSlice(keycloakAuthenticationFlowProviderIds, false),
+				// a copied flow has the provider of the flow it was copied from
+				DiffSuppressFunc: func(_, _, _ string, data *schema.ResourceData) bool {
+					return data.Get("copy_from").(string) != ""
+				},
+			},
+			// the flow is only copied when this resource is created, keycloak doesn't keep track of where a flow was copied
+			// from so this isn't read back either
+			"copy_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The alias of an existing flow, such as the built-in browser flow, to copy the executions of this flow from.",
+			},
+		},
+	}
+}
+
+func getAuthenticationFlowFromData(data *schema.ResourceData) *keycloak.AuthenticationFlow {
+	return &keycloak.AuthenticationFlow{
+		Id:          data.Id(),
+		RealmId:     data.Get("realm_id").(string),
+		Alias:       data.Get("alias").(string),
+		Description: data.Get("description").(string),
+		ProviderId:  data.Get("provider_id").(string),
+	}
+}
+
+func setAuthenticationFlowData(data *schema.ResourceData, authenticationFlow *keycloak.AuthenticationFlow) {
+	data.SetId(authenticationFlow.Id)
+	data.Set("realm_id", authenticationFlow.RealmId)
+	data.Set("alias", authenticationFlow.Alias)
+	data.Set("description", authenticationFlow.Description)
+	data.Set("provider_id", authenticationFlow.ProviderId)
+}
+
+func resourceKeycloakAuthenticationFlowCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	authenticationFlow := getAuthenticationFlowFromData(data)
+
+	if copyFrom := data.Get("copy_from").(string); copyFrom != "" {
+		description := authenticationFlow.Description
+
+		err := keycloakClient.CopyAuthenticationFlow(authenticationFlow, copyFrom)
+		if err != nil {
+			return err
+		}
+
+		// older versions of keycloak copy the description of the original flow instead of using the given one
+		if authenticationFlow.Description != description {
+			authenticationFlow.Description = description
+
+			err = keycloakClient.UpdateAuthenticationFlow(authenticationFlow)
+			if err != nil {
+				return err
+			}
+		}
+
+		setAuthenticationFlowData(data, authenticationFlow)
+
+		return resourceKeycloakAuthenticationFlowRead(data, meta)
+	}
+
+	err := keycloakClient.NewAuthenticationFlow(authenticationFlow)
+	if err != nil {
+		return err
+	}
+
+	setAuthenticationFlowData(data, authenticationFlow)
+
+	return resourceKeycloakAuthenticationFlowRead(data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	authenticationFlow, err := keycloakClient.GetAuthenticationFlow(realmId, id)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setAuthenticationFlowData(data, authenticationFlow)
+
+	return nil
+}
+
+func resourceKeycloakAuthenticationFlowUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	authenticationFlow := getAuthenticationFlowFromData(data)
+
+	err := keycloakClient.UpdateAuthenticationFlow(authenticationFlow)
+	if err != nil {
+		return err
+	}
+
+	setAuthenticationFlowData(data, authenticationFlow)
+
+	return nil
+}
+
+func resourceKeycloakAuthenticationFlowDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return keycloakClient.DeleteAuthenticationFlow(realmId, id)
+}
+
+func resourceKeycloakAuthenticationFlowImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{authenticationFlowId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
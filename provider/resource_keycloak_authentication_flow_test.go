@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"net/http"
+	"testing"
+)
+
+func TestAccKeycloakAuthenticationFlow_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	alias := "terraform-flow-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlow_basic(realmName, alias, "a flow"),
+				Check:  testAccCheckKeycloakAuthenticationFlowHasDescription("keycloak_authentication_flow.flow", "a flow"),
+			},
+			{
+				ResourceName:        "keycloak_authentication_flow.flow",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+			{
+				Config: testKeycloakAuthenticationFlow_basic(realmName, alias, "an updated flow"),
+				Check:  testAccCheckKeycloakAuthenticationFlowHasDescription("keycloak_authentication_flow.flow", "an updated flow"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlow_copyFrom(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	alias := "terraform-flow-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlow_copyFrom(realmName, alias, "clients", "a copied flow"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowHasDescription("keycloak_authentication_flow.flow", "a copied flow"),
+					testAccCheckKeycloakAuthenticationFlowHasExecutions("keycloak_authentication_flow.flow"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow.flow", "provider_id", "client-flow"),
+				),
+			},
+			{
+				ResourceName:            "keycloak_authentication_flow.flow",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     realmName + "/",
+				ImportStateVerifyIgnore: []string{"copy_from"},
+			},
+			// updating the copied flow doesn't copy it again
+			{
+				Config: testKeycloakAuthenticationFlow_copyFrom(realmName, alias, "clients", "an updated flow"),
+				Check:  testAccCheckKeycloakAuthenticationFlowHasDescription("keycloak_authentication_flow.flow", "an updated flow"),
+			},
+		},
+	})
+}
+
+func TestKeycloakAuthenticationFlow_copyFrom(t *testing.T) {
+	var copyRequests []map[string]string
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /auth/admin/realms/test/authentication/flows/direct grant/copy":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			copyRequests = append(copyRequests, body)
+			w.WriteHeader(http.StatusCreated)
+		case "GET /auth/admin/realms/test/authentication/flows":
+			w.Write([]byte(`[{"id": "direct-grant-id", "alias": "direct grant", "providerId": "basic-flow", "topLevel": true, "builtIn": true}, {"id": "copied-id", "alias": "my direct grant", "description": "my description", "providerId": "basic-flow", "topLevel": true}]`))
+		case "GET /auth/admin/realms/test/authentication/flows/copied-id":
+			w.Write([]byte(`{"id": "copied-id", "alias": "my direct grant", "description": "my description", "providerId": "basic-flow", "topLevel": true}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakAuthenticationFlow().Schema, map[string]interface{}{
+		"realm_id":    "test",
+		"alias":       "my direct grant",
+		"description": "my description",
+		"copy_from":   "direct grant",
+	})
+
+	err := resourceKeycloakAuthenticationFlowCreate(data, keycloakClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(copyRequests) != 1 || copyRequests[0]["newName"] != "my direct grant" {
+		t.Fatalf("expected the flow to be copied once with the new alias, got %v", copyRequests)
+	}
+
+	if data.Id() != "copied-id" {
+		t.Errorf("expected the id of the copied flow, got %s", data.Id())
+	}
+}
+
+func testAccCheckKeycloakAuthenticationFlowHasDescription(resourceName, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		authenticationFlow, err := keycloakClient.GetAuthenticationFlow(rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting authentication flow %s: %s", rs.Primary.ID, err)
+		}
+
+		if authenticationFlow.Description != description {
+			return fmt.Errorf("expected authentication flow %s to have description %q, but got %q", authenticationFlow.Alias, description, authenticationFlow.Description)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAuthenticationFlowHasExecutions(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		executions, err := keycloakClient.ListAuthenticationExecutionInfo(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["alias"])
+		if err != nil {
+			return err
+		}
+
+		if len(executions) == 0 {
+			return fmt.Errorf("expected authentication flow %s to have the executions of the flow it was copied from", rs.Primary.Attributes["alias"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAuthenticationFlowDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_authentication_flow" {
+				continue
+			}
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			authenticationFlow, _ := keycloakClient.GetAuthenticationFlow(rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if authenticationFlow != nil {
+				return fmt.Errorf("authentication flow %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAuthenticationFlow_basic(realm, alias, description string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id    = "${keycloak_realm.realm.id}"
+	alias       = "%s"
+	description = "%s"
+}
+	`, realm, alias, description)
+}
+
+func testKeycloakAuthenticationFlow_copyFrom(realm, alias, copyFrom, description string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id    = "${keycloak_realm.realm.id}"
+	alias       = "%s"
+	copy_from   = "%s"
+	description = "%s"
+}
+	`, realm, alias, copyFrom, description)
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+var keycloakAuthenticationSubFlowProviderIds = []string{"basic-flow", "form-flow"}
+
+func resourceKeycloakAuthenticationSubFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakAuthenticationSubFlowCreate,
+		Read:   resourceKeycloakAuthenticationSubFlowRead,
+		Update: resourceKeycloakAuthenticationSubFlowUpdate,
+		Delete: resourceKeycloakAuthenticationSubFlowDelete,
+		// This resource can be imported using {{realm}}/{{parentFlowAlias}}/{{subFlowId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakAuthenticationSubFlowImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent_flow_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "basic-flow",
+				ValidateFunc: validation.StringInSlice(keycloakAuthenticationSubFlowProviderIds, false),
+			},
+			// only used by form flows, which need the authenticator that renders the form
+			"authenticator": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"requirement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "DISABLED",
+				ValidateFunc: validation.StringInSlice(keycloakAuthenticationExecutionRequirements, false),
+			},
+			"index": authenticationExecutionIndexSchema(),
+		},
+	}
+}
+
+func getAuthenticationSubFlowFromData(data *schema.ResourceData) *keycloak.AuthenticationSubFlow {
+	return &keycloak.AuthenticationSubFlow{
+		Id:              data.Id(),
+		RealmId:         data.Get("realm_id").(string),
+		ParentFlowAlias: data.Get("parent_flow_alias").(string),
+		Alias:           data.Get("alias").(string),
+		Description:     data.Get("description").(string),
+		ProviderId:      data.Get("provider_id").(string),
+		Authenticator:   data.Get("authenticator").(string),
+		Requirement:     data.Get("requirement").(string),
+	}
+}
+
+func setAuthenticationSubFlowData(data *schema.ResourceData, subFlow *keycloak.AuthenticationSubFlow) {
+	data.SetId(subFlow.Id)
+	data.Set("realm_id", subFlow.RealmId)
+	data.Set("parent_flow_alias", subFlow.ParentFlowAlias)
+	data.Set("alias", subFlow.Alias)
+	data.Set("description", subFlow.Description)
+	data.Set("provider_id", subFlow.ProviderId)
+	data.Set("authenticator", subFlow.Authenticator)
+	data.Set("requirement", subFlow.Requirement)
+}
+
+func resourceKeycloakAuthenticationSubFlowCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	subFlow := getAuthenticationSubFlowFromData(data)
+
+	err := keycloakClient.NewAuthenticationSubFlow(subFlow)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(subFlow.Id)
+
+	err = setAuthenticationExecutionIndex(keycloakClient, data, subFlow.ExecutionId)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakAuthenticationSubFlowRead(data, meta)
+}
+
+func resourceKeycloakAuthenticationSubFlowRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parentFlowAlias := data.Get("parent_flow_alias").(string)
+
+	subFlow, err := keycloakClient.GetAuthenticationSubFlow(realmId, parentFlowAlias, data.Id())
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setAuthenticationSubFlowData(data, subFlow)
+
+	return readAuthenticationExecutionIndex(keycloakClient, data, subFlow.ExecutionId)
+}
+
+func resourceKeycloakAuthenticationSubFlowUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parentFlowAlias := data.Get("parent_flow_alias").(string)
+
+	// the execution that runs the subflow isn't tracked in state, so it's looked up again
+	existing, err := keycloakClient.GetAuthenticationSubFlow(realmId, parentFlowAlias, data.Id())
+	if err != nil {
+		return err
+	}
+
+	subFlow := getAuthenticationSubFlowFromData(data)
+	subFlow.ExecutionId = existing.ExecutionId
+
+	err = keycloakClient.UpdateAuthenticationSubFlow(subFlow)
+	if err != nil {
+		return err
+	}
+
+	err = setAuthenticationExecutionIndex(keycloakClient, data, subFlow.ExecutionId)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakAuthenticationSubFlowRead(data, meta)
+}
+
+func resourceKeycloakAuthenticationSubFlowDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parentFlowAlias := data.Get("parent_flow_alias").(string)
+
+	return keycloakClient.DeleteAuthenticationSubFlow(realmId, parentFlowAlias, data.Id())
+}
+
+func resourceKeycloakAuthenticationSubFlowImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{parentFlowAlias}}/{{authenticationSubFlowId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("parent_flow_alias", parts[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
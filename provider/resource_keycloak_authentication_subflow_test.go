@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakAuthenticationSubFlow_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	flowAlias := "terraform-flow-" + acctest.RandString(10)
+	subFlowAlias := "terraform-subflow-" + acctest.RandString(10)
+
+	resourceName := "keycloak_authentication_subflow.subflow"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationSubFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationSubFlow_basic(realmName, flowAlias, subFlowAlias, "ALTERNATIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationSubFlowHasRequirement(resourceName, "ALTERNATIVE"),
+					resource.TestCheckResourceAttr(resourceName, "alias", subFlowAlias),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["parent_flow_alias"], rs.Primary.ID), nil
+				},
+			},
+			{
+				Config: testKeycloakAuthenticationSubFlow_basic(realmName, flowAlias, subFlowAlias, "REQUIRED"),
+				Check:  testAccCheckKeycloakAuthenticationSubFlowHasRequirement(resourceName, "REQUIRED"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationSubFlow_conditionalOtp(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	flowAlias := "terraform-flow-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakAuthenticationSubFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationSubFlow_conditionalOtp(realmName, flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationExecutionsOrdered(realmName, flowAlias+"-otp", []string{"conditional-user-configured", "auth-otp-form"}),
+					resource.TestCheckResourceAttr("keycloak_authentication_execution.otp_form", "index", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAuthenticationSubFlowHasRequirement(resourceName, requirement string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		subFlow, err := keycloakClient.GetAuthenticationSubFlow(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["parent_flow_alias"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting authentication subflow %s: %s", rs.Primary.ID, err)
+		}
+
+		if subFlow.Requirement != requirement {
+			return fmt.Errorf("expected authentication subflow %s to have requirement %s, but got %s", subFlow.Alias, requirement, subFlow.Requirement)
+		}
+
+		return nil
+	}
+}
+
+// checks that the executions directly within the flow use these authenticators, in this order
+func testAccCheckKeycloakAuthenticationExecutionsOrdered(realmId, flowAlias string, authenticators []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		executions, err := keycloakClient.ListAuthenticationExecutionInfo(realmId, flowAlias)
+		if err != nil {
+			return err
+		}
+
+		var actual []string
+		for _, execution := range executions {
+			if execution.Level == 0 {
+				actual = append(actual, execution.ProviderId)
+			}
+		}
+
+		if fmt.Sprint(actual) != fmt.Sprint(authenticators) {
+			return fmt.Errorf("expected executions of flow %s to be %v, got %v", flowAlias, authenticators, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAuthenticationSubFlowDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_authentication_subflow" {
+				continue
+			}
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			subFlow, _ := keycloakClient.GetAuthenticationFlow(rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if subFlow != nil {
+				return fmt.Errorf("authentication subflow %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAuthenticationSubFlow_basic(realm, flowAlias, subFlowAlias, requirement string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_subflow" "subflow" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	parent_flow_alias = "${keycloak_authentication_flow.flow.alias}"
+	alias             = "%s"
+	description       = "a subflow"
+	requirement       = "%s"
+}
+	`, realm, flowAlias, subFlowAlias, requirement)
+}
+
+// the otp form is created before the condition, and then moved after it with `index`
+func testKeycloakAuthenticationSubFlow_conditionalOtp(realm, flowAlias string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "username_password" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	parent_flow_alias = "${keycloak_authentication_flow.flow.alias}"
+	authenticator     = "auth-username-password-form"
+	requirement       = "REQUIRED"
+}
+
+resource "keycloak_authentication_subflow" "otp" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	parent_flow_alias = "${keycloak_authentication_flow.flow.alias}"
+	alias             = "${keycloak_authentication_flow.flow.alias}-otp"
+	requirement       = "CONDITIONAL"
+
+	depends_on = ["keycloak_authentication_execution.username_password"]
+}
+
+resource "keycloak_authentication_execution" "otp_form" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	parent_flow_alias = "${keycloak_authentication_subflow.otp.alias}"
+	authenticator     = "auth-otp-form"
+	requirement       = "REQUIRED"
+	index             = 1
+}
+
+resource "keycloak_authentication_execution" "condition" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	parent_flow_alias = "${keycloak_authentication_subflow.otp.alias}"
+	authenticator     = "conditional-user-configured"
+	requirement       = "REQUIRED"
+	index             = 0
+
+	depends_on = ["keycloak_authentication_execution.otp_form"]
+}
+	`, realm, flowAlias)
+}
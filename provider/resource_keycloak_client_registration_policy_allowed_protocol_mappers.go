@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakClientRegistrationPolicyAllowedProtocolMappers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakClientRegistrationPolicyAllowedProtocolMappersCreate,
+		Read:   resourceKeycloakClientRegistrationPolicyAllowedProtocolMappersRead,
+		Update: resourceKeycloakClientRegistrationPolicyAllowedProtocolMappersUpdate,
+		Delete: resourceKeycloakClientRegistrationPolicyAllowedProtocolMappersDelete,
+		// This resource can be imported using {{realm}}/{{component_id}}. The component ID is displayed in the GUI
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakClientRegistrationPolicyGenericImport,
+		},
+		Schema: mergeSchemas(clientRegistrationPolicySchema(), map[string]*schema.Schema{
+			"allowed_protocol_mapper_types": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "The protocol mapper types, such as oidc-full-name-mapper, that registered clients are allowed to use.",
+			},
+		}),
+	}
+}
+
+func getClientRegistrationPolicyAllowedProtocolMappersFromData(data *schema.ResourceData) *keycloak.ClientRegistrationPolicyAllowedProtocolMappers {
+	return &keycloak.ClientRegistrationPolicyAllowedProtocolMappers{
+		Id:      data.Id(),
+		Name:    data.Get("name").(string),
+		RealmId: data.Get("realm_id").(string),
+		SubType: data.Get("sub_type").(string),
+
+		AllowedProtocolMapperTypes: interfaceSliceToStringSlice(data.Get("allowed_protocol_mapper_types").(*schema.Set).List()),
+	}
+}
+
+func setClientRegistrationPolicyAllowedProtocolMappersData(data *schema.ResourceData, policy *keycloak.ClientRegistrationPolicyAllowedProtocolMappers) {
+	data.SetId(policy.Id)
+
+	data.Set("name", policy.Name)
+	data.Set("realm_id", policy.RealmId)
+	data.Set("sub_type", policy.SubType)
+	data.Set("allowed_protocol_mapper_types", policy.AllowedProtocolMapperTypes)
+}
+
+func resourceKeycloakClientRegistrationPolicyAllowedProtocolMappersCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getClientRegistrationPolicyAllowedProtocolMappersFromData(data)
+
+	err := keycloakClient.NewClientRegistrationPolicyAllowedProtocolMappers(policy)
+	if err != nil {
+		return err
+	}
+
+	setClientRegistrationPolicyAllowedProtocolMappersData(data, policy)
+
+	return resourceKeycloakClientRegistrationPolicyAllowedProtocolMappersRead(data, meta)
+}
+
+func resourceKeycloakClientRegistrationPolicyAllowedProtocolMappersRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	policy, err := keycloakClient.GetClientRegistrationPolicyAllowedProtocolMappers(realmId, id)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setClientRegistrationPolicyAllowedProtocolMappersData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakClientRegistrationPolicyAllowedProtocolMappersUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getClientRegistrationPolicyAllowedProtocolMappersFromData(data)
+
+	err := keycloakClient.UpdateClientRegistrationPolicyAllowedProtocolMappers(policy)
+	if err != nil {
+		return err
+	}
+
+	setClientRegistrationPolicyAllowedProtocolMappersData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakClientRegistrationPolicyAllowedProtocolMappersDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return keycloakClient.DeleteClientRegistrationPolicyAllowedProtocolMappers(realmId, id)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakClientRegistrationPolicyAllowedProtocolMappers_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	policyName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_client_registration_policy_allowed_protocol_mappers.policy"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakClientRegistrationPolicyAllowedProtocolMappersDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationPolicyAllowedProtocolMappers_basic(realmName, policyName, []string{"oidc-full-name-mapper"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyAllowedProtocolMappersExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allowed_protocol_mapper_types.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getClientRegistrationPolicyGenericImportId(resourceName),
+			},
+			{
+				Config: testKeycloakClientRegistrationPolicyAllowedProtocolMappers_basic(realmName, policyName, []string{"oidc-full-name-mapper", "oidc-address-mapper", "saml-role-list-mapper"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyAllowedProtocolMappersExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allowed_protocol_mapper_types.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakClientRegistrationPolicyAllowedProtocolMappersExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		id := rs.Primary.ID
+		realm := rs.Primary.Attributes["realm_id"]
+
+		_, err := keycloakClient.GetClientRegistrationPolicyAllowedProtocolMappers(realm, id)
+		if err != nil {
+			return fmt.Errorf("error getting allowed protocol mappers client registration policy with id %s: %s", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakClientRegistrationPolicyAllowedProtocolMappersDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_client_registration_policy_allowed_protocol_mappers" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			policy, _ := keycloakClient.GetClientRegistrationPolicyAllowedProtocolMappers(realm, id)
+			if policy != nil {
+				return fmt.Errorf("allowed protocol mappers client registration policy with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakClientRegistrationPolicyAllowedProtocolMappers_basic(realm, policyName string, mapperTypes []string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_policy_allowed_protocol_mappers" "policy" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+
+	allowed_protocol_mapper_types = %s
+}
+	`, realm, policyName, arrayOfStringsForTerraformResource(mapperTypes))
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+var keycloakClientRegistrationPolicySubTypes = []string{"anonymous", "authenticated"}
+
+// the arguments shared by every kind of client registration policy
+func clientRegistrationPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Display name of the policy when displayed in the console.",
+		},
+		"realm_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The realm this policy exists in.",
+		},
+		"sub_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "anonymous",
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(keycloakClientRegistrationPolicySubTypes, false),
+			Description:  "Whether the policy applies to anonymous registration requests, or to requests authenticated with a bearer token.",
+		},
+	}
+}
+
+func resourceKeycloakClientRegistrationPolicyTrustedHosts() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakClientRegistrationPolicyTrustedHostsCreate,
+		Read:   resourceKeycloakClientRegistrationPolicyTrustedHostsRead,
+		Update: resourceKeycloakClientRegistrationPolicyTrustedHostsUpdate,
+		Delete: resourceKeycloakClientRegistrationPolicyTrustedHostsDelete,
+		// This resource can be imported using {{realm}}/{{component_id}}. The component ID is displayed in the GUI
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakClientRegistrationPolicyGenericImport,
+		},
+		Schema: mergeSchemas(clientRegistrationPolicySchema(), map[string]*schema.Schema{
+			"trusted_hosts": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Hosts or domains that are trusted to register clients and to be used in client URIs.",
+			},
+			"host_sending_registration_request_must_match": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, registration requests are only accepted from trusted hosts.",
+			},
+			"client_uris_must_match": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, the redirect URIs and other URIs of registered clients must use trusted hosts.",
+			},
+		}),
+	}
+}
+
+func getClientRegistrationPolicyTrustedHostsFromData(data *schema.ResourceData) *keycloak.ClientRegistrationPolicyTrustedHosts {
+	return &keycloak.ClientRegistrationPolicyTrustedHosts{
+		Id:      data.Id(),
+		Name:    data.Get("name").(string),
+		RealmId: data.Get("realm_id").(string),
+		SubType: data.Get("sub_type").(string),
+
+		TrustedHosts:                            interfaceSliceToStringSlice(data.Get("trusted_hosts").(*schema.Set).List()),
+		HostSendingRegistrationRequestMustMatch: data.Get("host_sending_registration_request_must_match").(bool),
+		ClientUrisMustMatch:                     data.Get("client_uris_must_match").(bool),
+	}
+}
+
+func setClientRegistrationPolicyTrustedHostsData(data *schema.ResourceData, policy *keycloak.ClientRegistrationPolicyTrustedHosts) {
+	data.SetId(policy.Id)
+
+	data.Set("name", policy.Name)
+	data.Set("realm_id", policy.RealmId)
+	data.Set("sub_type", policy.SubType)
+	data.Set("trusted_hosts", policy.TrustedHosts)
+	data.Set("host_sending_registration_request_must_match", policy.HostSendingRegistrationRequestMustMatch)
+	data.Set("client_uris_must_match", policy.ClientUrisMustMatch)
+}
+
+func resourceKeycloakClientRegistrationPolicyTrustedHostsCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getClientRegistrationPolicyTrustedHostsFromData(data)
+
+	err := keycloakClient.NewClientRegistrationPolicyTrustedHosts(policy)
+	if err != nil {
+		return err
+	}
+
+	setClientRegistrationPolicyTrustedHostsData(data, policy)
+
+	return resourceKeycloakClientRegistrationPolicyTrustedHostsRead(data, meta)
+}
+
+func resourceKeycloakClientRegistrationPolicyTrustedHostsRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	policy, err := keycloakClient.GetClientRegistrationPolicyTrustedHosts(realmId, id)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setClientRegistrationPolicyTrustedHostsData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakClientRegistrationPolicyTrustedHostsUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getClientRegistrationPolicyTrustedHostsFromData(data)
+
+	err := keycloakClient.UpdateClientRegistrationPolicyTrustedHosts(policy)
+	if err != nil {
+		return err
+	}
+
+	setClientRegistrationPolicyTrustedHostsData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakClientRegistrationPolicyTrustedHostsDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return keycloakClient.DeleteClientRegistrationPolicyTrustedHosts(realmId, id)
+}
+
+func resourceKeycloakClientRegistrationPolicyGenericImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realm}}/{{componentId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
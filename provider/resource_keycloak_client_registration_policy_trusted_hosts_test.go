@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakClientRegistrationPolicyTrustedHosts_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	policyName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_client_registration_policy_trusted_hosts.policy"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakClientRegistrationPolicyTrustedHostsDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationPolicyTrustedHosts_basic(realmName, policyName, "anonymous", []string{"example.com"}, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyTrustedHostsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "trusted_hosts.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getClientRegistrationPolicyGenericImportId(resourceName),
+			},
+			{
+				Config: testKeycloakClientRegistrationPolicyTrustedHosts_basic(realmName, policyName, "anonymous", []string{"example.com", "example.org"}, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyTrustedHostsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "trusted_hosts.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "client_uris_must_match", "false"),
+				),
+			},
+			{
+				Config: testKeycloakClientRegistrationPolicyTrustedHosts_basic(realmName, policyName, "authenticated", []string{"example.com"}, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyTrustedHostsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sub_type", "authenticated"),
+				),
+			},
+		},
+	})
+}
+
+func getClientRegistrationPolicyGenericImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckKeycloakClientRegistrationPolicyTrustedHostsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		id := rs.Primary.ID
+		realm := rs.Primary.Attributes["realm_id"]
+
+		_, err := keycloakClient.GetClientRegistrationPolicyTrustedHosts(realm, id)
+		if err != nil {
+			return fmt.Errorf("error getting trusted hosts client registration policy with id %s: %s", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakClientRegistrationPolicyTrustedHostsDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_client_registration_policy_trusted_hosts" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			policy, _ := keycloakClient.GetClientRegistrationPolicyTrustedHosts(realm, id)
+			if policy != nil {
+				return fmt.Errorf("trusted hosts client registration policy with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakClientRegistrationPolicyTrustedHosts_basic(realm, policyName, subType string, trustedHosts []string, clientUrisMustMatch bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_policy_trusted_hosts" "policy" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+	sub_type = "%s"
+
+	trusted_hosts          = %s
+	client_uris_must_match = %t
+}
+	`, realm, policyName, subType, arrayOfStringsForTerraformResource(trustedHosts), clientUrisMustMatch)
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+func resourceKeycloakComponent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakComponentCreate,
+		Read:   resourceKeycloakComponentRead,
+		Update: resourceKeycloakComponentUpdate,
+		Delete: resourceKeycloakComponentDelete,
+		// This resource can be imported using {{realm}}/{{componentId}}. The Component ID is displayed in the GUI
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakComponentImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the parent of this component. Keycloak uses the realm's internal ID when this is omitted.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the provider factory, such as `rsa-generated` or `ldap`.",
+			},
+			"provider_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The fully qualified name of the SPI, such as `org.keycloak.keys.KeyProvider`.",
+			},
+			// keycloak stores every config value as a list, and single values can contain commas (such as an LDAP DN), so
+			// the values are given as a list instead of being split out of a string
+			"config": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The component's config. Keys added by Keycloak are ignored.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getGenericComponentFromData(data *schema.ResourceData) *keycloak.GenericComponent {
+	config := map[string][]string{}
+	for _, v := range data.Get("config").(*schema.Set).List() {
+		entry := v.(map[string]interface{})
+
+		config[entry["name"].(string)] = interfaceSliceToStringSlice(entry["values"].([]interface{}))
+	}
+
+	return &keycloak.GenericComponent{
+		Id:           data.Id(),
+		Name:         data.Get("name").(string),
+		RealmId:      data.Get("realm_id").(string),
+		ParentId:     data.Get("parent_id").(string),
+		ProviderId:   data.Get("provider_id").(string),
+		ProviderType: data.Get("provider_type").(string),
+
+		Config: config,
+	}
+}
+
+func setGenericComponentData(data *schema.ResourceData, genericComponent *keycloak.GenericComponent) {
+	// keycloak fills in defaults and generated values (keys, secrets) for many providers. only the keys that are
+	// already managed by terraform are kept, unless there are none yet, such as after an import
+	managedKeys := map[string]bool{}
+	for _, v := range data.Get("config").(*schema.Set).List() {
+		managedKeys[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	var config []interface{}
+	for key, values := range genericComponent.Config {
+		if managedKeys[key] || len(managedKeys) == 0 {
+			config = append(config, map[string]interface{}{
+				"name":   key,
+				"values": values,
+			})
+		}
+	}
+
+	data.SetId(genericComponent.Id)
+
+	data.Set("name", genericComponent.Name)
+	data.Set("realm_id", genericComponent.RealmId)
+	data.Set("parent_id", genericComponent.ParentId)
+	data.Set("provider_id", genericComponent.ProviderId)
+	data.Set("provider_type", genericComponent.ProviderType)
+
+	data.Set("config", config)
+}
+
+func resourceKeycloakComponentCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	genericComponent := getGenericComponentFromData(data)
+
+	err := keycloakClient.NewGenericComponent(genericComponent)
+	if err != nil {
+		return err
+	}
+
+	setGenericComponentData(data, genericComponent)
+
+	return resourceKeycloakComponentRead(data, meta)
+}
+
+func resourceKeycloakComponentRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	genericComponent, err := keycloakClient.GetGenericComponent(realmId, id)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setGenericComponentData(data, genericComponent)
+
+	return nil
+}
+
+func resourceKeycloakComponentUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	genericComponent := getGenericComponentFromData(data)
+
+	err := keycloakClient.UpdateGenericComponent(genericComponent)
+	if err != nil {
+		return err
+	}
+
+	setGenericComponentData(data, genericComponent)
+
+	return nil
+}
+
+func resourceKeycloakComponentDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return keycloakClient.DeleteGenericComponent(realmId, id)
+}
+
+func resourceKeycloakComponentImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{componentId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
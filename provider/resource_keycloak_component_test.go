@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakComponent_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	componentName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_component.component"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakComponentDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakComponent_basic(realmName, componentName, 100),
+				Check:  testAccCheckKeycloakComponentExists(resourceName),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     realmName + "/",
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+			{
+				Config: testKeycloakComponent_basic(realmName, componentName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakComponentExists(resourceName),
+					testAccCheckKeycloakComponentHasConfig(resourceName, "priority", []string{"10"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakComponent_createAfterManualDestroy(t *testing.T) {
+	var genericComponent = &keycloak.GenericComponent{}
+
+	realmName := "terraform-" + acctest.RandString(10)
+	componentName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_component.component"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakComponentDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakComponent_basic(realmName, componentName, 100),
+				Check:  testAccCheckKeycloakComponentFetch(resourceName, genericComponent),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					err := keycloakClient.DeleteGenericComponent(genericComponent.RealmId, genericComponent.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakComponent_basic(realmName, componentName, 100),
+				Check:  testAccCheckKeycloakComponentExists(resourceName),
+			},
+		},
+	})
+}
+
+func TestKeycloakComponent_configValuesAreNotSplit(t *testing.T) {
+	data := schema.TestResourceDataRaw(t, resourceKeycloakComponent().Schema, map[string]interface{}{
+		"realm_id":      "test",
+		"name":          "ldap",
+		"provider_id":   "ldap",
+		"provider_type": "org.keycloak.storage.UserStorageProvider",
+		"config": []interface{}{
+			map[string]interface{}{
+				"name":   "bindDn",
+				"values": []interface{}{"cn=admin,dc=example,dc=org"},
+			},
+			map[string]interface{}{
+				"name":   "userObjectClasses",
+				"values": []interface{}{"inetOrgPerson", "organizationalPerson"},
+			},
+		},
+	})
+
+	genericComponent := getGenericComponentFromData(data)
+
+	expected := map[string][]string{
+		"bindDn":            {"cn=admin,dc=example,dc=org"},
+		"userObjectClasses": {"inetOrgPerson", "organizationalPerson"},
+	}
+	if !reflect.DeepEqual(genericComponent.Config, expected) {
+		t.Fatalf("expected config %v, got %v", expected, genericComponent.Config)
+	}
+
+	genericComponent.Id = "f3f3a0b5-0d43-4b31-b7e8-5a6b0c3e1a9d"
+	genericComponent.Config["vendor"] = []string{"other"}
+
+	setGenericComponentData(data, genericComponent)
+
+	roundTripped := getGenericComponentFromData(data)
+	if !reflect.DeepEqual(roundTripped.Config, expected) {
+		t.Fatalf("expected config %v after reading it back, got %v", expected, roundTripped.Config)
+	}
+}
+
+func testAccCheckKeycloakComponentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getGenericComponentFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakComponentHasConfig(resourceName, key string, values []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		genericComponent, err := getGenericComponentFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !reflect.DeepEqual(genericComponent.Config[key], values) {
+			return fmt.Errorf("expected component config %s to be %v, got %v", key, values, genericComponent.Config[key])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakComponentFetch(resourceName string, genericComponent *keycloak.GenericComponent) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedComponent, err := getGenericComponentFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		genericComponent.Id = fetchedComponent.Id
+		genericComponent.RealmId = fetchedComponent.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakComponentDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_component" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			genericComponent, _ := keycloakClient.GetGenericComponent(realm, id)
+			if genericComponent != nil {
+				return fmt.Errorf("component with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getGenericComponentFromState(s *terraform.State, resourceName string) (*keycloak.GenericComponent, error) {
+	keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	genericComponent, err := keycloakClient.GetGenericComponent(realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting component with id %s: %s", id, err)
+	}
+
+	return genericComponent, nil
+}
+
+func testKeycloakComponent_basic(realm, name string, priority int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_component" "component" {
+	name          = "%s"
+	realm_id      = "${keycloak_realm.realm.id}"
+	parent_id     = "${keycloak_realm.realm.id}"
+	provider_id   = "hmac-generated"
+	provider_type = "org.keycloak.keys.KeyProvider"
+
+	config {
+		name   = "priority"
+		values = ["%d"]
+	}
+
+	config {
+		name   = "algorithm"
+		values = ["HS256"]
+	}
+}
+	`, realm, name, priority)
+}
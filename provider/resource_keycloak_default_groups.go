@@ -59,7 +59,7 @@ func resourceKeycloakDefaultGroupsRead(data *schema.ResourceData, meta interface
 
 	groups, err := keycloakClient.GetDefaultGroups(realmId)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, data)
 	}
 
 	var groupIds []string
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakDefaultRoles() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakDefaultRolesCreate,
+		Read:   resourceKeycloakDefaultRolesRead,
+		Update: resourceKeycloakDefaultRolesUpdate,
+		Delete: resourceKeycloakDefaultRolesDelete,
+		// This resource can be imported using {{realm}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakDefaultRolesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default_roles": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func defaultRolesId(realmId string) string {
+	return realmId + "/default-roles"
+}
+
+func resourceKeycloakDefaultRolesCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	defaultRoles := interfaceSliceToStringSlice(data.Get("default_roles").(*schema.Set).List())
+
+	err := keycloakClient.UpdateDefaultRoles(realmId, defaultRoles)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(defaultRolesId(realmId))
+
+	return resourceKeycloakDefaultRolesRead(data, meta)
+}
+
+func resourceKeycloakDefaultRolesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	defaultRoles, err := keycloakClient.GetDefaultRoles(realmId)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	data.SetId(defaultRolesId(realmId))
+	data.Set("default_roles", defaultRoles)
+
+	return nil
+}
+
+func resourceKeycloakDefaultRolesUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	defaultRoles := interfaceSliceToStringSlice(data.Get("default_roles").(*schema.Set).List())
+
+	err := keycloakClient.UpdateDefaultRoles(realmId, defaultRoles)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakDefaultRolesRead(data, meta)
+}
+
+func resourceKeycloakDefaultRolesDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	managedRoles := data.Get("default_roles").(*schema.Set)
+
+	defaultRoles, err := keycloakClient.GetDefaultRoles(realmId)
+	if err != nil {
+		return err
+	}
+
+	// only remove the roles this resource manages, in case any were added since the last refresh
+	var remainingRoles []string
+	for _, defaultRole := range defaultRoles {
+		if !managedRoles.Contains(defaultRole) {
+			remainingRoles = append(remainingRoles, defaultRole)
+		}
+	}
+
+	return keycloakClient.UpdateDefaultRoles(realmId, remainingRoles)
+}
+
+func resourceKeycloakDefaultRolesImport(data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	realmId := data.Id()
+
+	data.Set("realm_id", realmId)
+	data.SetId(defaultRolesId(realmId))
+
+	return []*schema.ResourceData{data}, nil
+}
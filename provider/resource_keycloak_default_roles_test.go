@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakDefaultRoles_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakDefaultRoles_basic(realmName, roleName, []string{"offline_access", "${keycloak_role.role.name}"}),
+				Check:  testAccCheckDefaultRolesAre(realmName, []string{"offline_access", roleName}),
+			},
+			{
+				ResourceName:      "keycloak_default_roles.default_roles",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDefaultRoles_updateInPlace(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakDefaultRoles_basic(realmName, roleName, []string{"offline_access", "uma_authorization"}),
+				Check:  testAccCheckDefaultRolesAre(realmName, []string{"offline_access", "uma_authorization"}),
+			},
+			{
+				Config: testKeycloakDefaultRoles_basic(realmName, roleName, []string{"${keycloak_role.role.name}"}),
+				Check:  testAccCheckDefaultRolesAre(realmName, []string{roleName}),
+			},
+			{
+				// the resource is removed, so the roles it managed should no longer be default roles
+				Config: testKeycloakDefaultRoles_noDefaultRoles(realmName, roleName),
+				Check:  testAccCheckDefaultRolesAre(realmName, nil),
+			},
+		},
+	})
+}
+
+func testAccCheckDefaultRolesAre(realmName string, expectedRoleNames []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		roleNames, err := keycloakClient.GetDefaultRoles(realmName)
+		if err != nil {
+			return err
+		}
+
+		sort.Strings(roleNames)
+		sort.Strings(expectedRoleNames)
+
+		if fmt.Sprint(roleNames) != fmt.Sprint(expectedRoleNames) {
+			return fmt.Errorf("expected default roles for realm %s to be %v, got %v", realmName, expectedRoleNames, roleNames)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakDefaultRoles_basic(realmName, roleName string, defaultRoles []string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_default_roles" "default_roles" {
+	realm_id      = "${keycloak_realm.realm.id}"
+	default_roles = %s
+}
+	`, realmName, roleName, arrayOfStringsForTerraformResource(defaultRoles))
+}
+
+func testKeycloakDefaultRoles_noDefaultRoles(realmName, roleName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+	`, realmName, roleName)
+}
@@ -118,11 +118,12 @@ func resourceKeycloakGenericClientProtocolMapperRead(data *schema.ResourceData,
 }
 
 func resourceKeycloakGenericClientProtocolMapperUpdate(data *schema.ResourceData, meta interface{}) error {
-	log.Printf("[DEBUG] updating\n")
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	resource := getGenericClientProtocolMapperFromData(data)
 
+	log.Printf("[DEBUG] Updating protocol mapper %s in realm %s", resource.Id, resource.RealmId)
+
 	err := keycloakClient.UpdateGenericClientProtocolMapper(resource)
 	if err != nil {
 		return err
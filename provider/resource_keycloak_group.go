@@ -40,6 +40,13 @@ func resourceKeycloakGroup() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"realm_roles": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Names of realm roles granted to members of this group. Roles assigned to the group elsewhere are left alone.",
+			},
 		},
 	}
 }
@@ -90,6 +97,11 @@ func resourceKeycloakGroupCreate(data *schema.ResourceData, meta interface{}) er
 
 	mapFromGroupToData(data, group)
 
+	err = updateGroupRealmRoles(keycloakClient, group, &schema.Set{F: schema.HashString}, data.Get("realm_roles").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
 	return resourceKeycloakGroupRead(data, meta)
 }
 
@@ -106,7 +118,7 @@ func resourceKeycloakGroupRead(data *schema.ResourceData, meta interface{}) erro
 
 	mapFromGroupToData(data, group)
 
-	return nil
+	return setGroupRealmRolesData(keycloakClient, data, group)
 }
 
 func resourceKeycloakGroupUpdate(data *schema.ResourceData, meta interface{}) error {
@@ -121,6 +133,15 @@ func resourceKeycloakGroupUpdate(data *schema.ResourceData, meta interface{}) er
 
 	mapFromGroupToData(data, group)
 
+	if data.HasChange("realm_roles") {
+		oldRealmRoles, newRealmRoles := data.GetChange("realm_roles")
+
+		err = updateGroupRealmRoles(keycloakClient, group, oldRealmRoles.(*schema.Set), newRealmRoles.(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -133,6 +154,72 @@ func resourceKeycloakGroupDelete(data *schema.ResourceData, meta interface{}) er
 	return keycloakClient.DeleteGroup(realmId, id)
 }
 
+// `realm_roles` is additive, so only the roles that were added or removed from it are changed and any other role
+// mappings, such as the ones from a non-exclusive keycloak_group_roles resource, are kept
+func updateGroupRealmRoles(keycloakClient *keycloak.KeycloakClient, group *keycloak.Group, oldRealmRoles, newRealmRoles *schema.Set) error {
+	var rolesToAdd []*keycloak.Role
+	for _, name := range interfaceSliceToStringSlice(newRealmRoles.Difference(oldRealmRoles).List()) {
+		role, err := keycloakClient.GetRoleByName(group.RealmId, "", name)
+		if err != nil {
+			return err
+		}
+
+		rolesToAdd = append(rolesToAdd, role)
+	}
+
+	var rolesToRemove []*keycloak.Role
+	for _, name := range interfaceSliceToStringSlice(oldRealmRoles.Difference(newRealmRoles).List()) {
+		role, err := keycloakClient.GetRoleByName(group.RealmId, "", name)
+		if err != nil {
+			// the role was deleted, which removed it from the group as well
+			if keycloak.ErrorIs404(err) {
+				continue
+			}
+
+			return err
+		}
+
+		rolesToRemove = append(rolesToRemove, role)
+	}
+
+	if len(rolesToRemove) != 0 {
+		err := keycloakClient.RemoveRealmRolesFromGroup(group.RealmId, group.Id, rolesToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(rolesToAdd) != 0 {
+		return keycloakClient.AddRealmRolesToGroup(group.RealmId, group.Id, rolesToAdd)
+	}
+
+	return nil
+}
+
+// only the roles listed in `realm_roles` are tracked, so roles assigned to the group elsewhere don't cause drift
+func setGroupRealmRolesData(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, group *keycloak.Group) error {
+	managed := data.Get("realm_roles").(*schema.Set)
+	if managed.Len() == 0 {
+		return nil
+	}
+
+	roleMappings, err := keycloakClient.GetGroupRoleMappings(group.RealmId, group.Id)
+	if err != nil {
+		return err
+	}
+
+	var realmRoles []string
+	for _, role := range roleMappings.RealmMappings {
+		if managed.Contains(role.Name) {
+			realmRoles = append(realmRoles, role.Name)
+		}
+	}
+
+	data.Set("realm_roles", realmRoles)
+
+	return nil
+}
+
 func resourceKeycloakGroupImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
@@ -30,6 +30,12 @@ func resourceKeycloakGroupMemberships() *schema.Resource {
 				Set:      schema.HashString,
 				Required: true,
 			},
+			// when false, members added to the group outside of terraform, such as by user federation, are left alone
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -72,6 +78,11 @@ func resourceKeycloakGroupMembershipsRead(data *schema.ResourceData, meta interf
 		members = append(members, userInGroup.Username)
 	}
 
+	// when this resource isn't exclusive, only track the members that it manages so other members don't cause drift
+	if !data.Get("exclusive").(bool) {
+		members = filterToManagedIds(members, data.Get("members").(*schema.Set))
+	}
+
 	data.Set("members", members)
 	data.SetId(groupMembershipsId(realmId, groupId))
 
@@ -90,6 +101,23 @@ func resourceKeycloakGroupMembershipsUpdate(data *schema.ResourceData, meta inte
 		return err
 	}
 
+	if !data.Get("exclusive").(bool) {
+		// only add members that were added to `members`, and only remove members that were removed from it
+		oldMembers, newMembers := data.GetChange("members")
+
+		err = keycloakClient.RemoveUsersFromGroup(realmId, groupId, oldMembers.(*schema.Set).Difference(newMembers.(*schema.Set)).List())
+		if err != nil {
+			return err
+		}
+
+		err = keycloakClient.AddUsersToGroup(realmId, groupId, newMembers.(*schema.Set).Difference(oldMembers.(*schema.Set)).List())
+		if err != nil {
+			return err
+		}
+
+		return resourceKeycloakGroupMembershipsRead(data, meta)
+	}
+
 	keycloakMembers, err := keycloakClient.GetGroupMembers(realmId, groupId)
 	if err != nil {
 		return err
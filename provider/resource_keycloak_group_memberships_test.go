@@ -230,6 +230,56 @@ func TestAccKeycloakGroupMemberships_authoritativeRemove(t *testing.T) {
 	})
 }
 
+// if a user is added to a group outside of terraform, a non-exclusive resource should leave them alone
+func TestAccKeycloakGroupMemberships_nonExclusive(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	groupName := "terraform-group-" + acctest.RandString(10)
+
+	allUsersForTest := []string{
+		"terraform-user-" + acctest.RandString(10),
+		"terraform-user-" + acctest.RandString(10),
+		"terraform-user-" + acctest.RandString(10),
+	}
+	userToManuallyAdd := allUsersForTest[0]
+	usersInGroup := allUsersForTest[1:]
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupMemberships_multipleUsersWithExclusive(realmName, groupName, allUsersForTest, usersInGroup, false),
+				Check:  testAccCheckUsersBelongToGroup("keycloak_group_memberships.group_members", usersInGroup),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					groupsWithName, err := keycloakClient.ListGroupsWithName(realmName, groupName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.AddUsersToGroup(realmName, groupsWithName[0].Id, []interface{}{userToManuallyAdd})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testKeycloakGroupMemberships_multipleUsersWithExclusive(realmName, groupName, allUsersForTest, usersInGroup, false),
+				PlanOnly: true,
+			},
+			// removing a member from the config should only remove that member
+			{
+				Config: testKeycloakGroupMemberships_multipleUsersWithExclusive(realmName, groupName, allUsersForTest, usersInGroup[:1], false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersBelongToGroup("keycloak_group.group", []string{userToManuallyAdd, usersInGroup[0]}),
+					testAccCheckUsersDontBelongToGroup("keycloak_group.group", usersInGroup[1:]),
+				),
+			},
+		},
+	})
+}
+
 // this resource doesn't support import because it can be created even if the desired state already exists in keycloak
 func TestAccKeycloakGroupMemberships_noImportNeeded(t *testing.T) {
 	realmName := "terraform-" + acctest.RandString(10)
@@ -488,6 +538,10 @@ resource "keycloak_group_memberships" "group_members" {
 
 // this tf config provides a good way to test users that exist within keycloak but are not necessarily part of a group
 func testKeycloakGroupMemberships_multipleUsers(realm, group string, definedUsers, usersInGroup []string) string {
+	return testKeycloakGroupMemberships_multipleUsersWithExclusive(realm, group, definedUsers, usersInGroup, true)
+}
+
+func testKeycloakGroupMemberships_multipleUsersWithExclusive(realm, group string, definedUsers, usersInGroup []string, exclusive bool) string {
 	var userResources strings.Builder
 	for _, username := range definedUsers {
 		userResources.WriteString(fmt.Sprintf(`
@@ -516,12 +570,13 @@ resource "keycloak_group" "group" {
 %s
 
 resource "keycloak_group_memberships" "group_members" {
-	realm_id = "${keycloak_realm.realm.id}"
-	group_id = "${keycloak_group.group.id}"
+	realm_id  = "${keycloak_realm.realm.id}"
+	group_id  = "${keycloak_group.group.id}"
+	exclusive = %t
 
 	members = %s
 }
-	`, realm, group, userResources.String(), arrayOfStringsForTerraformResource(usersInGroupInterpolated))
+	`, realm, group, userResources.String(), exclusive, arrayOfStringsForTerraformResource(usersInGroupInterpolated))
 }
 
 func testKeycloakGroupMemberships_userDoesNotExist(realm, group, username string) string {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"log"
 	"strings"
 )
 
@@ -34,6 +35,11 @@ func resourceKeycloakGroupRoles() *schema.Resource {
 				Set:      schema.HashString,
 				Required: true,
 			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -42,12 +48,37 @@ func groupRolesId(realmId, groupId string) string {
 	return fmt.Sprintf("%s/%s", realmId, groupId)
 }
 
+// looks up each role by ID, and groups them by "realm" or client ID. this is used when adding roles, so a role that
+// doesn't exist is an error
 func getMapOfRealmAndClientRoles(keycloakClient *keycloak.KeycloakClient, realmId string, roleIds []string) (map[string][]*keycloak.Role, error) {
+	return groupRoleIdsByContainer(keycloakClient, realmId, roleIds, false)
+}
+
+// like `getMapOfRealmAndClientRoles`, but roles that no longer exist are skipped with a warning. this is used when
+// removing roles, since a role that was deleted outside of terraform has already been unassigned, and failing here would
+// make it impossible to remove the reference to it from the configuration
+func getMapOfExistingRealmAndClientRoles(keycloakClient *keycloak.KeycloakClient, realmId string, roleIds []string) (map[string][]*keycloak.Role, error) {
+	return groupRoleIdsByContainer(keycloakClient, realmId, roleIds, true)
+}
+
+func groupRoleIdsByContainer(keycloakClient *keycloak.KeycloakClient, realmId string, roleIds []string, skipMissing bool) (map[string][]*keycloak.Role, error) {
 	roles := make(map[string][]*keycloak.Role)
+	cache := newRoleCache(keycloakClient, realmId)
 
 	for _, roleId := range roleIds {
-		role, err := keycloakClient.GetRole(realmId, roleId)
+		role, err := cache.getRole(roleId)
 		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				if skipMissing {
+					log.Printf("[WARN] Role with id %s no longer exists in realm %s, skipping it. It should be removed from your configuration", roleId, realmId)
+
+					continue
+				}
+
+				// client roles are deleted along with their client, which is the usual reason for this
+				return nil, fmt.Errorf("role with id %s does not exist in realm %s. If it was a client role, its client may have been deleted", roleId, realmId)
+			}
+
 			return nil, err
 		}
 
@@ -61,120 +92,31 @@ func getMapOfRealmAndClientRoles(keycloakClient *keycloak.KeycloakClient, realmI
 	return roles, nil
 }
 
-// given a group and a map of roles we already know about, fetch the roles we don't know about
-// `localRoles` is used as a cache to avoid unnecessary http requests
-func getMapOfRealmAndClientRolesFromGroup(keycloakClient *keycloak.KeycloakClient, group *keycloak.Group, localRoles map[string][]*keycloak.Role) (map[string][]*keycloak.Role, error) {
-	roles := make(map[string][]*keycloak.Role)
-
-	// realm roles
-	if len(group.RealmRoles) != 0 {
-		var realmRoles []*keycloak.Role
-
-		for _, realmRoleName := range group.RealmRoles {
-			found := false
-
-			for _, localRealmRole := range localRoles["realm"] {
-				if localRealmRole.Name == realmRoleName {
-					found = true
-					realmRoles = append(realmRoles, localRealmRole)
-
-					break
-				}
-			}
-
-			if !found {
-				realmRole, err := keycloakClient.GetRoleByName(group.RealmId, "", realmRoleName)
-				if err != nil {
-					return nil, err
-				}
-
-				realmRoles = append(realmRoles, realmRole)
-			}
-		}
-
-		roles["realm"] = realmRoles
-	}
-
-	// client roles
-	if len(group.ClientRoles) != 0 {
-		for clientName, clientRoleNames := range group.ClientRoles {
-			client, err := keycloakClient.GetGenericClientByClientId(group.RealmId, clientName)
-			if err != nil {
-				return nil, err
-			}
-
-			var clientRoles []*keycloak.Role
-			for _, clientRoleName := range clientRoleNames {
-				found := false
-
-				for _, localClientRole := range localRoles[client.Id] {
-					if localClientRole.Name == clientRoleName {
-						found = true
-						clientRoles = append(clientRoles, localClientRole)
-
-						break
-					}
-				}
-
-				if !found {
-					clientRole, err := keycloakClient.GetRoleByName(group.RealmId, client.Id, clientRoleName)
-					if err != nil {
-						return nil, err
-					}
-
-					clientRoles = append(clientRoles, clientRole)
-				}
-			}
-
-			roles[client.Id] = clientRoles
-		}
+// fetch all of the realm and client roles that are directly assigned to a group
+// this is shared by the `keycloak_group_roles` resource and data source so they resolve roles the same way
+func getMapOfRealmAndClientRolesFromGroup(keycloakClient *keycloak.KeycloakClient, group *keycloak.Group) (map[string][]*keycloak.Role, error) {
+	roleMappings, err := keycloakClient.GetGroupRoleMappings(group.RealmId, group.Id)
+	if err != nil {
+		return nil, err
 	}
 
-	return roles, nil
+	return getMapOfRealmAndClientRolesFromRoleMapping(roleMappings), nil
 }
 
 func addRolesToGroup(keycloakClient *keycloak.KeycloakClient, rolesToAdd map[string][]*keycloak.Role, group *keycloak.Group) error {
-	if realmRoles, ok := rolesToAdd["realm"]; ok && len(realmRoles) != 0 {
-		err := keycloakClient.AddRealmRolesToGroup(group.RealmId, group.Id, realmRoles)
-		if err != nil {
-			return err
-		}
-	}
-
-	for k, roles := range rolesToAdd {
-		if k == "realm" {
-			continue
-		}
-
-		err := keycloakClient.AddClientRolesToGroup(group.RealmId, group.Id, k, roles)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return applyRoleMappings(keycloakClient, rolesToAdd, func(roles []*keycloak.Role) error {
+		return keycloakClient.AddRealmRolesToGroup(group.RealmId, group.Id, roles)
+	}, func(clientId string, roles []*keycloak.Role) error {
+		return keycloakClient.AddClientRolesToGroup(group.RealmId, group.Id, clientId, roles)
+	})
 }
 
 func removeRolesFromGroup(keycloakClient *keycloak.KeycloakClient, rolesToRemove map[string][]*keycloak.Role, group *keycloak.Group) error {
-	if realmRoles, ok := rolesToRemove["realm"]; ok && len(realmRoles) != 0 {
-		err := keycloakClient.RemoveRealmRolesFromGroup(group.RealmId, group.Id, realmRoles)
-		if err != nil {
-			return err
-		}
-	}
-
-	for k, roles := range rolesToRemove {
-		if k == "realm" {
-			continue
-		}
-
-		err := keycloakClient.RemoveClientRolesFromGroup(group.RealmId, group.Id, k, roles)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return applyRoleMappings(keycloakClient, rolesToRemove, func(roles []*keycloak.Role) error {
+		return keycloakClient.RemoveRealmRolesFromGroup(group.RealmId, group.Id, roles)
+	}, func(clientId string, roles []*keycloak.Role) error {
+		return keycloakClient.RemoveClientRolesFromGroup(group.RealmId, group.Id, clientId, roles)
+	})
 }
 
 func resourceKeycloakGroupRolesCreate(data *schema.ResourceData, meta interface{}) error {
@@ -212,37 +154,23 @@ func resourceKeycloakGroupRolesRead(data *schema.ResourceData, meta interface{})
 
 	group, err := keycloakClient.GetGroup(realmId, groupId)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, data)
 	}
 
-	var roleIds []string
-
-	if len(group.RealmRoles) != 0 {
-		for _, realmRole := range group.RealmRoles {
-			role, err := keycloakClient.GetRoleByName(realmId, "", realmRole)
-			if err != nil {
-				return err
-			}
-
-			roleIds = append(roleIds, role.Id)
-		}
+	roles, err := getMapOfRealmAndClientRolesFromGroup(keycloakClient, group)
+	if err != nil {
+		return err
 	}
 
-	if len(group.ClientRoles) != 0 {
-		for clientName, clientRoles := range group.ClientRoles {
-			client, err := keycloakClient.GetGenericClientByClientId(realmId, clientName)
-			if err != nil {
-				return err
-			}
-
-			for _, clientRole := range clientRoles {
-				role, err := keycloakClient.GetRoleByName(realmId, client.Id, clientRole)
-				if err != nil {
-					return err
-				}
+	var roleIds []string
 
-				roleIds = append(roleIds, role.Id)
-			}
+	// when this resource isn't exclusive, only track the roles that it manages so roles assigned elsewhere don't cause drift
+	if !data.Get("exclusive").(bool) {
+		roleIds = filterToManagedIds(getRoleIdsFromMapOfRealmAndClientRoles(roles), data.Get("role_ids").(*schema.Set))
+	} else {
+		roleIds, err = getRoleIdsNotImpliedByManagedComposites(keycloakClient, roles, data.Get("role_ids").(*schema.Set))
+		if err != nil {
+			return err
 		}
 	}
 
@@ -263,6 +191,33 @@ func resourceKeycloakGroupRolesUpdate(data *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if !data.Get("exclusive").(bool) {
+		// only add roles that were added to `role_ids`, and only remove roles that were removed from it
+		oldRoleIds, newRoleIds := data.GetChange("role_ids")
+
+		rolesToAdd, err := getMapOfRealmAndClientRoles(keycloakClient, realmId, interfaceSliceToStringSlice(newRoleIds.(*schema.Set).Difference(oldRoleIds.(*schema.Set)).List()))
+		if err != nil {
+			return err
+		}
+
+		rolesToRemove, err := getMapOfExistingRealmAndClientRoles(keycloakClient, realmId, interfaceSliceToStringSlice(oldRoleIds.(*schema.Set).Difference(newRoleIds.(*schema.Set)).List()))
+		if err != nil {
+			return err
+		}
+
+		err = addRolesToGroup(keycloakClient, rolesToAdd, group)
+		if err != nil {
+			return err
+		}
+
+		err = removeRolesFromGroup(keycloakClient, rolesToRemove, group)
+		if err != nil {
+			return err
+		}
+
+		return resourceKeycloakGroupRolesRead(data, meta)
+	}
+
 	roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
 
 	tfRoles, err := getMapOfRealmAndClientRoles(keycloakClient, realmId, roleIds)
@@ -270,13 +225,22 @@ func resourceKeycloakGroupRolesUpdate(data *schema.ResourceData, meta interface{
 		return err
 	}
 
-	remoteRoles, err := getMapOfRealmAndClientRolesFromGroup(keycloakClient, group, tfRoles)
+	tfComposites := filterRoles(tfRoles, func(role *keycloak.Role) bool {
+		return role.Composite
+	})
+
+	remoteRoles, err := getMapOfRealmAndClientRolesFromGroup(keycloakClient, group)
 	if err != nil {
 		return err
 	}
 
 	removeDuplicateRoles(&tfRoles, &remoteRoles)
 
+	err = removeRolesImpliedByComposites(keycloakClient, remoteRoles, tfComposites)
+	if err != nil {
+		return err
+	}
+
 	// `tfRoles` contains all roles that need to be added
 	// `remoteRoles` contains all roles that need to be removed
 
@@ -290,7 +254,7 @@ func resourceKeycloakGroupRolesUpdate(data *schema.ResourceData, meta interface{
 		return err
 	}
 
-	return nil
+	return resourceKeycloakGroupRolesRead(data, meta)
 }
 
 func resourceKeycloakGroupRolesDelete(data *schema.ResourceData, meta interface{}) error {
@@ -300,9 +264,13 @@ func resourceKeycloakGroupRolesDelete(data *schema.ResourceData, meta interface{
 	groupId := data.Get("group_id").(string)
 
 	group, err := keycloakClient.GetGroup(realmId, groupId)
+	if err != nil {
+		return err
+	}
 
+	// only the roles in `role_ids` are removed, so roles assigned elsewhere are kept when this resource isn't exclusive
 	roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
-	rolesToRemove, err := getMapOfRealmAndClientRoles(keycloakClient, realmId, roleIds)
+	rolesToRemove, err := getMapOfExistingRealmAndClientRoles(keycloakClient, realmId, roleIds)
 	if err != nil {
 		return err
 	}
@@ -324,6 +292,7 @@ func resourceKeycloakGroupRolesImport(d *schema.ResourceData, _ interface{}) ([]
 
 	d.Set("realm_id", parts[0])
 	d.Set("group_id", parts[1])
+	d.Set("exclusive", true)
 
 	d.SetId(groupRolesId(parts[0], parts[1]))
 
@@ -354,3 +323,99 @@ func removeDuplicateRoles(one, two *map[string][]*keycloak.Role) {
 		}
 	}
 }
+
+// returns the IDs of every role that is granted through one of the composite roles in `roles`, following composites of
+// composites. a direct mapping for one of these roles doesn't grant anything that the composite doesn't already
+func getRoleIdsImpliedByComposites(keycloakClient *keycloak.KeycloakClient, roles []*keycloak.Role) (map[string]bool, error) {
+	implied := make(map[string]bool)
+
+	var queue []*keycloak.Role
+	for _, role := range roles {
+		if role.Composite {
+			queue = append(queue, role)
+		}
+	}
+
+	for len(queue) != 0 {
+		role := queue[0]
+		queue = queue[1:]
+
+		composites, err := keycloakClient.GetRoleComposites(role)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, composite := range composites {
+			if implied[composite.Id] {
+				continue
+			}
+
+			implied[composite.Id] = true
+
+			if composite.Composite {
+				queue = append(queue, composite)
+			}
+		}
+	}
+
+	return implied, nil
+}
+
+// removes the roles in `roles` that are granted through `composites`. this is used to keep roles that aren't in the
+// configuration of an exclusive resource, but are granted through a composite that is, so they don't churn
+func removeRolesImpliedByComposites(keycloakClient *keycloak.KeycloakClient, roles map[string][]*keycloak.Role, composites []*keycloak.Role) error {
+	implied, err := getRoleIdsImpliedByComposites(keycloakClient, composites)
+	if err != nil {
+		return err
+	}
+
+	for container, rolesForContainer := range roles {
+		var kept []*keycloak.Role
+		for _, role := range rolesForContainer {
+			if !implied[role.Id] {
+				kept = append(kept, role)
+			}
+		}
+
+		roles[container] = kept
+	}
+
+	return nil
+}
+
+// returns the IDs of the roles in `roles`, leaving out the roles that aren't managed by this resource but are granted
+// through a managed composite. `removeRolesImpliedByComposites` keeps those roles assigned, so they aren't drift
+func getRoleIdsNotImpliedByManagedComposites(keycloakClient *keycloak.KeycloakClient, roles map[string][]*keycloak.Role, managed *schema.Set) ([]string, error) {
+	managedComposites := filterRoles(roles, func(role *keycloak.Role) bool {
+		return role.Composite && managed.Contains(role.Id)
+	})
+
+	implied, err := getRoleIdsImpliedByComposites(keycloakClient, managedComposites)
+	if err != nil {
+		return nil, err
+	}
+
+	var roleIds []string
+	for _, role := range filterRoles(roles, func(role *keycloak.Role) bool {
+		return managed.Contains(role.Id) || !implied[role.Id]
+	}) {
+		roleIds = append(roleIds, role.Id)
+	}
+
+	return roleIds, nil
+}
+
+// flattens a map of roles grouped by "realm" or client ID, keeping only the roles `filter` returns true for
+func filterRoles(roles map[string][]*keycloak.Role, filter func(*keycloak.Role) bool) []*keycloak.Role {
+	var filtered []*keycloak.Role
+
+	for _, rolesForContainer := range roles {
+		for _, role := range rolesForContainer {
+			if filter(role) {
+				filtered = append(filtered, role)
+			}
+		}
+	}
+
+	return filtered
+}
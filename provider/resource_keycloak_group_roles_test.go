@@ -136,6 +136,34 @@ func TestAccKeycloakGroupRoles_update(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakGroupRoles_nonExclusive(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleOneName := "terraform-role-" + acctest.RandString(10)
+	realmRoleTwoName := "terraform-role-" + acctest.RandString(10)
+	groupName := "terraform-group-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupRoles_nonExclusive(realmName, realmRoleOneName, realmRoleTwoName, groupName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_group_roles.group_roles_one", "role_ids.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_group_roles.group_roles_two", "role_ids.#", "1"),
+					testAccCheckKeycloakGroupHasRolesIncluding("keycloak_group_roles.group_roles_one"),
+					testAccCheckKeycloakGroupHasRolesIncluding("keycloak_group_roles.group_roles_two"),
+				),
+			},
+			// destroying one of the resources should only remove the role that it manages
+			{
+				Config: testKeycloakGroupRoles_nonExclusive(realmName, realmRoleOneName, realmRoleTwoName, groupName, false),
+				Check:  testAccCheckKeycloakGroupHasRoles("keycloak_group_roles.group_roles_one"),
+			},
+		},
+	})
+}
+
 func flattenGroupRoles(keycloakClient *keycloak.KeycloakClient, group *keycloak.Group) ([]string, error) {
 	var roles []string
 
@@ -223,6 +251,62 @@ func testAccCheckKeycloakGroupHasRoles(resourceName string) resource.TestCheckFu
 	}
 }
 
+func testAccCheckKeycloakGroupHasRolesIncluding(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+		groupId := rs.Primary.Attributes["group_id"]
+
+		group, err := keycloakClient.GetGroup(realm, groupId)
+		if err != nil {
+			return err
+		}
+
+		groupRoles, err := flattenGroupRoles(keycloakClient, group)
+		if err != nil {
+			return err
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if match, _ := regexp.MatchString("role_ids\\.[^#]+", k); !match {
+				continue
+			}
+
+			role, err := keycloakClient.GetRole(realm, v)
+			if err != nil {
+				return err
+			}
+
+			var expectedRoleString string
+			if role.ClientRole {
+				expectedRoleString = fmt.Sprintf("%s/%s", role.ClientId, role.Name)
+			} else {
+				expectedRoleString = role.Name
+			}
+
+			found := false
+			for _, groupRole := range groupRoles {
+				if groupRole == expectedRoleString {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("expected to find role %s assigned to group %s", expectedRoleString, group.Name)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakGroupHasNoRoles(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
@@ -423,3 +507,51 @@ resource "keycloak_group_roles" "group_roles" {
 }
 	`, realmName, openIdClientName, samlClientName, realmRoleOneName, realmRoleTwoName, openIdRoleOneName, openIdRoleTwoName, samlRoleOneName, samlRoleTwoName, groupName, tfRoleIds)
 }
+
+func testKeycloakGroupRoles_nonExclusive(realmName, realmRoleOneName, realmRoleTwoName, groupName string, withSecondResource bool) string {
+	groupRolesTwo := ""
+	if withSecondResource {
+		groupRolesTwo = `
+resource "keycloak_group_roles" "group_roles_two" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	group_id  = "${keycloak_group.group.id}"
+	exclusive = false
+
+	role_ids = [
+		"${keycloak_role.realm_role_two.id}",
+	]
+}`
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role_one" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "realm_role_two" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_group_roles" "group_roles_one" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	group_id  = "${keycloak_group.group.id}"
+	exclusive = false
+
+	role_ids = [
+		"${keycloak_role.realm_role_one.id}",
+	]
+}
+%s
+	`, realmName, realmRoleOneName, realmRoleTwoName, groupName, groupRolesTwo)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -209,6 +211,44 @@ func TestAccKeycloakGroup_unsetOptionalAttributes(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakGroup_realmRoles(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	groupName := "terraform-group-" + acctest.RandString(10)
+	roleOneName := "terraform-role-" + acctest.RandString(10)
+	roleTwoName := "terraform-role-" + acctest.RandString(10)
+	roleThreeName := "terraform-role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroup_realmRoles(realmName, groupName, roleOneName, roleTwoName, roleThreeName, []string{"realm_role_one"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_group.group", "realm_roles.#", "1"),
+					testAccCheckKeycloakGroupHasRealmRoles("keycloak_group.group", roleOneName, roleThreeName),
+				),
+			},
+			// roles assigned by the keycloak_group_roles resource are kept
+			{
+				Config: testKeycloakGroup_realmRoles(realmName, groupName, roleOneName, roleTwoName, roleThreeName, []string{"realm_role_two"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_group.group", "realm_roles.#", "1"),
+					testAccCheckKeycloakGroupHasRealmRoles("keycloak_group.group", roleTwoName, roleThreeName),
+				),
+			},
+			{
+				Config: testKeycloakGroup_realmRoles(realmName, groupName, roleOneName, roleTwoName, roleThreeName, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_group.group", "realm_roles.#", "0"),
+					testAccCheckKeycloakGroupHasRealmRoles("keycloak_group.group", roleThreeName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakGroupExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getGroupFromState(s, resourceName)
@@ -220,6 +260,36 @@ func testAccCheckKeycloakGroupExists(resourceName string) resource.TestCheckFunc
 	}
 }
 
+func testAccCheckKeycloakGroupHasRealmRoles(resourceName string, roleNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		group, err := getGroupFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		roleMappings, err := keycloakClient.GetGroupRoleMappings(group.RealmId, group.Id)
+		if err != nil {
+			return err
+		}
+
+		var groupRoleNames []string
+		for _, role := range roleMappings.RealmMappings {
+			groupRoleNames = append(groupRoleNames, role.Name)
+		}
+
+		sort.Strings(groupRoleNames)
+		sort.Strings(roleNames)
+
+		if !reflect.DeepEqual(groupRoleNames, roleNames) {
+			return fmt.Errorf("expected group to have realm roles %v, got %v", roleNames, groupRoleNames)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakGroupFetch(resourceName string, group *keycloak.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		fetchedGroup, err := getGroupFromState(s, resourceName)
@@ -377,3 +447,47 @@ resource "keycloak_group" "group" {
 }
 	`, group.RealmId, group.Name)
 }
+
+func testKeycloakGroup_realmRoles(realm, group, roleOneName, roleTwoName, roleThreeName string, realmRoles []string) string {
+	var realmRoleNames []string
+	for _, realmRole := range realmRoles {
+		realmRoleNames = append(realmRoleNames, fmt.Sprintf("${keycloak_role.%s.name}", realmRole))
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role_one" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "realm_role_two" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "realm_role_three" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_group" "group" {
+	name        = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	realm_roles = %s
+}
+
+resource "keycloak_group_roles" "group_roles" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	group_id  = "${keycloak_group.group.id}"
+	exclusive = false
+
+	role_ids = [
+		"${keycloak_role.realm_role_three.id}",
+	]
+}
+	`, realm, roleOneName, roleTwoName, roleThreeName, group, arrayOfStringsForTerraformResource(realmRoleNames))
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"log"
+	"strings"
+)
+
+const identityProviderTokenExchangeScope = "token-exchange"
+
+func resourceKeycloakIdentityProviderTokenExchangeScopePermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakIdentityProviderTokenExchangeScopePermissionCreate,
+		Read:   resourceKeycloakIdentityProviderTokenExchangeScopePermissionRead,
+		Update: resourceKeycloakIdentityProviderTokenExchangeScopePermissionUpdate,
+		Delete: resourceKeycloakIdentityProviderTokenExchangeScopePermissionDelete,
+		// This resource can be imported using {{realm}}/{{providerAlias}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakIdentityProviderTokenExchangeScopePermissionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policies": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"decision_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+				Default:      "UNANIMOUS",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"authorization_resource_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_token_exchange_scope_permission_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func identityProviderPermissionsId(realmId, providerAlias string) string {
+	return fmt.Sprintf("%s/%s", realmId, providerAlias)
+}
+
+// looks up the scope permission that keycloak created for the identity provider's `token-exchange` scope
+func getIdentityProviderTokenExchangeScopePermission(keycloakClient *keycloak.KeycloakClient, realmId, resourceServerId string, identityProviderPermissions *keycloak.IdentityProviderPermissions) (*keycloak.OpenidClientAuthorizationPermission, error) {
+	permissionId, ok := identityProviderPermissions.ScopePermissions[identityProviderTokenExchangeScope]
+	if !ok {
+		return nil, fmt.Errorf("keycloak did not return a permission for the %s scope", identityProviderTokenExchangeScope)
+	}
+
+	return keycloakClient.GetOpenidClientAuthorizationScopePermission(realmId, resourceServerId, permissionId)
+}
+
+func resourceKeycloakIdentityProviderTokenExchangeScopePermissionCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	providerAlias := data.Get("provider_alias").(string)
+
+	err := keycloakClient.EnableIdentityProviderPermissions(realmId, providerAlias)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(identityProviderPermissionsId(realmId, providerAlias))
+
+	return resourceKeycloakIdentityProviderTokenExchangeScopePermissionUpdate(data, meta)
+}
+
+func resourceKeycloakIdentityProviderTokenExchangeScopePermissionRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	providerAlias := data.Get("provider_alias").(string)
+
+	identityProviderPermissions, err := keycloakClient.GetIdentityProviderPermissions(realmId, providerAlias)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	// permissions that were disabled outside of terraform no longer have a scope permission, so they need to be
+	// enabled again
+	if !identityProviderPermissions.Enabled {
+		log.Printf("[WARN] Removing resource with id %s from state as identity provider permissions are no longer enabled", data.Id())
+		data.SetId("")
+
+		return nil
+	}
+
+	realmManagementClient, err := keycloakClient.GetRealmManagementClient(realmId)
+	if err != nil {
+		return err
+	}
+
+	permission, err := getIdentityProviderTokenExchangeScopePermission(keycloakClient, realmId, realmManagementClient.Id, identityProviderPermissions)
+	if err != nil {
+		return err
+	}
+
+	data.Set("policies", permission.Policies)
+	data.Set("description", permission.Description)
+	data.Set("decision_strategy", permission.DecisionStrategy)
+	data.Set("enabled", identityProviderPermissions.Enabled)
+	data.Set("authorization_resource_server_id", realmManagementClient.Id)
+	data.Set("authorization_token_exchange_scope_permission_id", permission.Id)
+
+	return nil
+}
+
+func resourceKeycloakIdentityProviderTokenExchangeScopePermissionUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	providerAlias := data.Get("provider_alias").(string)
+
+	identityProviderPermissions, err := keycloakClient.GetIdentityProviderPermissions(realmId, providerAlias)
+	if err != nil {
+		return err
+	}
+
+	realmManagementClient, err := keycloakClient.GetRealmManagementClient(realmId)
+	if err != nil {
+		return err
+	}
+
+	permission, err := getIdentityProviderTokenExchangeScopePermission(keycloakClient, realmId, realmManagementClient.Id, identityProviderPermissions)
+	if err != nil {
+		return err
+	}
+
+	permission.Policies = interfaceSliceToStringSlice(data.Get("policies").(*schema.Set).List())
+	permission.Description = data.Get("description").(string)
+	permission.DecisionStrategy = data.Get("decision_strategy").(string)
+
+	err = keycloakClient.UpdateOpenidClientAuthorizationScopePermission(permission)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakIdentityProviderTokenExchangeScopePermissionRead(data, meta)
+}
+
+func resourceKeycloakIdentityProviderTokenExchangeScopePermissionDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.DisableIdentityProviderPermissions(data.Get("realm_id").(string), data.Get("provider_alias").(string))
+	if err != nil {
+		// the permissions were deleted along with the identity provider, so there's nothing left to do
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func resourceKeycloakIdentityProviderTokenExchangeScopePermissionImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{providerAlias}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("provider_alias", parts[1])
+	d.SetId(identityProviderPermissionsId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakIdentityProviderTokenExchangeScopePermission_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	alias := "terraform-idp-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+	policyName := "terraform-policy-" + acctest.RandString(10)
+
+	resourceName := "keycloak_identity_provider_token_exchange_scope_permission.permission"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakIdentityProviderPermissionsDisabled(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakIdentityProviderTokenExchangeScopePermission_basic(realmName, alias, roleName, policyName, "UNANIMOUS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorization_resource_server_id", "data.keycloak_openid_client.realm_management", "id"),
+					testAccCheckKeycloakIdentityProviderTokenExchangeScopePermissionHasPolicies(resourceName, 1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakIdentityProviderTokenExchangeScopePermission_basic(realmName, alias, roleName, policyName, "AFFIRMATIVE"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "decision_strategy", "AFFIRMATIVE"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakIdentityProviderTokenExchangeScopePermissionHasPolicies(resourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		realmId := rs.Primary.Attributes["realm_id"]
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationScopePermission(realmId, rs.Primary.Attributes["authorization_resource_server_id"], rs.Primary.Attributes["authorization_token_exchange_scope_permission_id"])
+		if err != nil {
+			return err
+		}
+
+		if len(permission.Policies) != count {
+			return fmt.Errorf("expected token-exchange permission to have %d policies, got %d", count, len(permission.Policies))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakIdentityProviderPermissionsDisabled() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_identity_provider_token_exchange_scope_permission" {
+				continue
+			}
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			identityProviderPermissions, _ := keycloakClient.GetIdentityProviderPermissions(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["provider_alias"])
+			if identityProviderPermissions != nil && identityProviderPermissions.Enabled {
+				return fmt.Errorf("permissions are still enabled for identity provider %s", rs.Primary.Attributes["provider_alias"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakIdentityProviderTokenExchangeScopePermission_basic(realmName, alias, roleName, policyName, decisionStrategy string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = "${keycloak_realm.realm.id}"
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+data "keycloak_openid_client" "realm_management" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "realm-management"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_openid_client_role_policy" "policy" {
+	realm_id           = "${keycloak_realm.realm.id}"
+	resource_server_id = "${data.keycloak_openid_client.realm_management.id}"
+	name               = "%s"
+
+	role {
+		id = "${keycloak_role.role.id}"
+	}
+}
+
+resource "keycloak_identity_provider_token_exchange_scope_permission" "permission" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	provider_alias    = "${keycloak_oidc_identity_provider.oidc.alias}"
+	policies          = ["${keycloak_openid_client_role_policy.policy.id}"]
+	description       = "exchange external tokens from this identity provider"
+	decision_strategy = "%s"
+}
+	`, realmName, alias, roleName, policyName, decisionStrategy)
+}
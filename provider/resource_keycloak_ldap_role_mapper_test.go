@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"regexp"
+	"testing"
+)
+
+func TestAccKeycloakLdapRoleMapper_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleMapperName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakLdapRoleMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapRoleMapper_basic(realmName, roleMapperName, "READ_ONLY"),
+				Check:  testAccCheckKeycloakLdapRoleMapperExists("keycloak_ldap_role_mapper.role_mapper"),
+			},
+			{
+				ResourceName:      "keycloak_ldap_role_mapper.role_mapper",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getLdapGenericMapperImportId("keycloak_ldap_role_mapper.role_mapper"),
+			},
+			{
+				Config: testKeycloakLdapRoleMapper_basic(realmName, roleMapperName, "IMPORT"),
+				Check:  resource.TestCheckResourceAttr("keycloak_ldap_role_mapper.role_mapper", "mode", "IMPORT"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakLdapRoleMapper_clientRoleMode(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleMapperName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakLdapRoleMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapRoleMapper_clientRoleMode(realmName, roleMapperName, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakLdapRoleMapperUsesClient("keycloak_ldap_role_mapper.role_mapper", clientId),
+					resource.TestCheckResourceAttr("keycloak_ldap_role_mapper.role_mapper", "use_realm_roles_mapping", "false"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_ldap_role_mapper.role_mapper",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getLdapGenericMapperImportId("keycloak_ldap_role_mapper.role_mapper"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakLdapRoleMapper_clientIdValidation(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleMapperName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakLdapRoleMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakLdapRoleMapper_basicWithAttrValidation(realmName, roleMapperName, "use_realm_roles_mapping", "false"),
+				ExpectError: regexp.MustCompile("validation error: client_id is required when realm roles mapping is disabled"),
+			},
+			{
+				Config:      testKeycloakLdapRoleMapper_basicWithAttrValidation(realmName, roleMapperName, "client_id", "account"),
+				ExpectError: regexp.MustCompile("validation error: client_id can only be set when realm roles mapping is disabled"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakLdapRoleMapper_userRolesRetrieveStrategyValidation(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleMapperName := "terraform-" + acctest.RandString(10)
+	userRolesRetrieveStrategy := randomStringInSlice(keycloakLdapRoleMapperUserRolesRetrieveStrategies)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakLdapRoleMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakLdapRoleMapper_basicWithAttrValidation(realmName, roleMapperName, "user_roles_retrieve_strategy", acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("expected user_roles_retrieve_strategy to be one of .+ got .+"),
+			},
+			{
+				Config: testKeycloakLdapRoleMapper_basicWithAttrValidation(realmName, roleMapperName, "user_roles_retrieve_strategy", userRolesRetrieveStrategy),
+				Check:  testAccCheckKeycloakLdapRoleMapperExists("keycloak_ldap_role_mapper.role_mapper"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakLdapRoleMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getLdapRoleMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakLdapRoleMapperUsesClient(resourceName, clientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ldapRoleMapper, err := getLdapRoleMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if ldapRoleMapper.UseRealmRolesMapping {
+			return fmt.Errorf("expected ldap role mapper to map client roles, but it maps realm roles")
+		}
+
+		if ldapRoleMapper.ClientId != clientId {
+			return fmt.Errorf("expected ldap role mapper to map roles of client %s, but got %s", clientId, ldapRoleMapper.ClientId)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakLdapRoleMapperDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_ldap_role_mapper" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			ldapRoleMapper, _ := keycloakClient.GetLdapRoleMapper(realm, id)
+			if ldapRoleMapper != nil {
+				return fmt.Errorf("ldap role mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getLdapRoleMapperFromState(s *terraform.State, resourceName string) (*keycloak.LdapRoleMapper, error) {
+	keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	ldapRoleMapper, err := keycloakClient.GetLdapRoleMapper(realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ldap role mapper with id %s: %s", id, err)
+	}
+
+	return ldapRoleMapper, nil
+}
+
+func testKeycloakLdapRoleMapper_ldapUserFederation(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name                    = "openldap"
+	realm_id                = "${keycloak_realm.realm.id}"
+
+	enabled                 = true
+
+	username_ldap_attribute = "cn"
+	rdn_ldap_attribute      = "cn"
+	uuid_ldap_attribute     = "entryDN"
+	user_object_classes     = [
+		"simpleSecurityObject",
+		"organizationalRole"
+	]
+	connection_url          = "ldap://openldap"
+	users_dn                = "dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "admin"
+}
+	`, realm)
+}
+
+func testKeycloakLdapRoleMapper_basic(realm, roleMapperName, mode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_ldap_role_mapper" "role_mapper" {
+	name                           = "%s"
+	realm_id                       = "${keycloak_realm.realm.id}"
+	ldap_user_federation_id        = "${keycloak_ldap_user_federation.openldap.id}"
+
+	ldap_roles_dn                  = "dc=example,dc=org"
+	role_name_ldap_attribute       = "cn"
+	role_object_classes            = [
+		"groupOfNames"
+	]
+	membership_attribute_type      = "DN"
+	membership_ldap_attribute      = "member"
+	membership_user_ldap_attribute = "cn"
+	memberof_ldap_attribute        = "memberOf"
+	mode                           = "%s"
+}
+	`, testKeycloakLdapRoleMapper_ldapUserFederation(realm), roleMapperName, mode)
+}
+
+func testKeycloakLdapRoleMapper_basicWithAttrValidation(realm, roleMapperName, attr, val string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_ldap_role_mapper" "role_mapper" {
+	name                           = "%s"
+	realm_id                       = "${keycloak_realm.realm.id}"
+	ldap_user_federation_id        = "${keycloak_ldap_user_federation.openldap.id}"
+
+	ldap_roles_dn                  = "dc=example,dc=org"
+	role_name_ldap_attribute       = "cn"
+	role_object_classes            = [
+		"groupOfNames"
+	]
+	membership_ldap_attribute      = "member"
+	membership_user_ldap_attribute = "cn"
+
+	%s                             = "%s"
+}
+	`, testKeycloakLdapRoleMapper_ldapUserFederation(realm), roleMapperName, attr, val)
+}
+
+func testKeycloakLdapRoleMapper_clientRoleMode(realm, roleMapperName, clientId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = "${keycloak_realm.realm.id}"
+	client_id   = "%s"
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_ldap_role_mapper" "role_mapper" {
+	name                           = "%s"
+	realm_id                       = "${keycloak_realm.realm.id}"
+	ldap_user_federation_id        = "${keycloak_ldap_user_federation.openldap.id}"
+
+	ldap_roles_dn                  = "dc=example,dc=org"
+	role_name_ldap_attribute       = "cn"
+	role_object_classes            = [
+		"groupOfNames"
+	]
+	membership_ldap_attribute      = "member"
+	membership_user_ldap_attribute = "cn"
+	user_roles_retrieve_strategy   = "GET_ROLES_FROM_USER_MEMBEROF_ATTRIBUTE"
+
+	use_realm_roles_mapping        = false
+	client_id                      = "${keycloak_openid_client.client.client_id}"
+}
+	`, testKeycloakLdapRoleMapper_ldapUserFederation(realm), clientId, roleMapperName)
+}
@@ -195,6 +195,44 @@ func resourceKeycloakLdapUserFederation() *schema.Resource {
 				Default:      "DEFAULT",
 				ValidateFunc: validation.StringInSlice(keycloakUserFederationCachePolicies, false),
 			},
+
+			"kerberos": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Settings for authenticating users with Kerberos/SPNEGO.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_kerberos_authentication": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "When true, users can authenticate with SPNEGO.",
+						},
+						"kerberos_realm": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the Kerberos realm.",
+						},
+						"server_principal": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The Kerberos principal of the HTTP service, such as HTTP/host.example.org@EXAMPLE.ORG.",
+						},
+						"key_tab": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The path to the Kerberos keytab file containing the server principal's credentials.",
+						},
+						"use_kerberos_for_password_authentication": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "When true, passwords are checked with the Kerberos server instead of the LDAP server.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -219,7 +257,7 @@ func getLdapUserFederationFromData(data *schema.ResourceData) *keycloak.LdapUser
 		userObjectClasses = append(userObjectClasses, userObjectClass.(string))
 	}
 
-	return &keycloak.LdapUserFederation{
+	ldap := &keycloak.LdapUserFederation{
 		Id:      data.Id(),
 		Name:    data.Get("name").(string),
 		RealmId: data.Get("realm_id").(string),
@@ -255,6 +293,18 @@ func getLdapUserFederationFromData(data *schema.ResourceData) *keycloak.LdapUser
 
 		CachePolicy: data.Get("cache_policy").(string),
 	}
+
+	if kerberosConfig := data.Get("kerberos").([]interface{}); len(kerberosConfig) == 1 {
+		kerberos := kerberosConfig[0].(map[string]interface{})
+
+		ldap.AllowKerberosAuthentication = kerberos["allow_kerberos_authentication"].(bool)
+		ldap.KerberosRealm = kerberos["kerberos_realm"].(string)
+		ldap.ServerPrincipal = kerberos["server_principal"].(string)
+		ldap.KeyTab = kerberos["key_tab"].(string)
+		ldap.UseKerberosForPasswordAuthentication = kerberos["use_kerberos_for_password_authentication"].(bool)
+	}
+
+	return ldap
 }
 
 func setLdapUserFederationData(data *schema.ResourceData, ldap *keycloak.LdapUserFederation) {
@@ -293,6 +343,20 @@ func setLdapUserFederationData(data *schema.ResourceData, ldap *keycloak.LdapUse
 	data.Set("changed_sync_period", ldap.ChangedSyncPeriod)
 
 	data.Set("cache_policy", ldap.CachePolicy)
+
+	if ldap.AllowKerberosAuthentication || ldap.KerberosRealm != "" || ldap.ServerPrincipal != "" || ldap.KeyTab != "" {
+		data.Set("kerberos", []interface{}{
+			map[string]interface{}{
+				"allow_kerberos_authentication": ldap.AllowKerberosAuthentication,
+				"kerberos_realm":                ldap.KerberosRealm,
+				"server_principal":              ldap.ServerPrincipal,
+				"key_tab":                       ldap.KeyTab,
+				"use_kerberos_for_password_authentication": ldap.UseKerberosForPasswordAuthentication,
+			},
+		})
+	} else {
+		data.Set("kerberos", nil)
+	}
 }
 
 func resourceKeycloakLdapUserFederationCreate(data *schema.ResourceData, meta interface{}) error {
@@ -411,6 +411,66 @@ func TestAccKeycloakLdapUserFederation_bindCredential(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakLdapUserFederation_kerberos(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	ldapName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakLdapUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapUserFederation_basic(realmName, ldapName),
+				Check:  testAccCheckKeycloakLdapUserFederationKerberosEnabled("keycloak_ldap_user_federation.openldap", false),
+			},
+			{
+				Config: testKeycloakLdapUserFederation_kerberos(realmName, ldapName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakLdapUserFederationKerberosEnabled("keycloak_ldap_user_federation.openldap", true),
+					resource.TestCheckResourceAttr("keycloak_ldap_user_federation.openldap", "kerberos.0.kerberos_realm", "EXAMPLE.ORG"),
+					resource.TestCheckResourceAttr("keycloak_ldap_user_federation.openldap", "kerberos.0.use_kerberos_for_password_authentication", "true"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_ldap_user_federation.openldap",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getLdapUserFederationImportId("keycloak_ldap_user_federation.openldap", "admin"),
+			},
+			{
+				Config: testKeycloakLdapUserFederation_kerberos(realmName, ldapName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakLdapUserFederationKerberosEnabled("keycloak_ldap_user_federation.openldap", false),
+					resource.TestCheckResourceAttr("keycloak_ldap_user_federation.openldap", "kerberos.0.allow_kerberos_authentication", "false"),
+				),
+			},
+			{
+				Config: testKeycloakLdapUserFederation_basic(realmName, ldapName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakLdapUserFederationKerberosEnabled("keycloak_ldap_user_federation.openldap", false),
+					resource.TestCheckResourceAttr("keycloak_ldap_user_federation.openldap", "kerberos.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakLdapUserFederationKerberosEnabled(resourceName string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ldap, err := getLdapUserFederationFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if ldap.AllowKerberosAuthentication != enabled {
+			return fmt.Errorf("expected ldap user federation %s to have kerberos authentication set to %t, but was %t", ldap.Name, enabled, ldap.AllowKerberosAuthentication)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakLdapUserFederationExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getLdapUserFederationFromState(s, resourceName)
@@ -518,6 +578,41 @@ resource "keycloak_ldap_user_federation" "openldap" {
 	`, realm, ldap)
 }
 
+func testKeycloakLdapUserFederation_kerberos(realm, ldap string, allowKerberosAuthentication bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name                    = "%s"
+	realm_id                = "${keycloak_realm.realm.id}"
+
+	enabled                 = true
+
+	username_ldap_attribute = "cn"
+	rdn_ldap_attribute      = "cn"
+	uuid_ldap_attribute     = "entryDN"
+	user_object_classes     = [
+		"simpleSecurityObject",
+		"organizationalRole"
+	]
+	connection_url          = "ldap://openldap"
+	users_dn                = "dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "admin"
+
+	kerberos {
+		allow_kerberos_authentication            = %t
+		kerberos_realm                           = "EXAMPLE.ORG"
+		server_principal                         = "HTTP/keycloak.example.org@EXAMPLE.ORG"
+		key_tab                                  = "/etc/keycloak.keytab"
+		use_kerberos_for_password_authentication = true
+	}
+}
+	`, realm, ldap, allowKerberosAuthentication)
+}
+
 func testKeycloakLdapUserFederation_basicFromInterface(ldap *keycloak.LdapUserFederation) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"regexp"
 	"testing"
 )
 
@@ -47,6 +48,42 @@ func TestAccKeycloakOidcIdentityProvider_custom(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOidcIdentityProvider_brokerLoginFlows(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	oidcName := "terraform-" + acctest.RandString(10)
+	firstBrokerLoginFlowAlias := "terraform-flow-" + acctest.RandString(10)
+	postBrokerLoginFlowAlias := "terraform-flow-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcIdentityProvider_brokerLoginFlows(realmName, oidcName, firstBrokerLoginFlowAlias, postBrokerLoginFlowAlias),
+				Check:  testAccCheckKeycloakOidcIdentityProviderHasBrokerLoginFlows("keycloak_oidc_identity_provider.oidc", firstBrokerLoginFlowAlias, postBrokerLoginFlowAlias),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcIdentityProvider_brokerLoginFlowDoesNotExist(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	oidcName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOidcIdentityProvider_brokerLoginFlowDoesNotExist(realmName, oidcName),
+				ExpectError: regexp.MustCompile("authentication flow with alias does-not-exist does not exist"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOidcIdentityProvider_createAfterManualDestroy(t *testing.T) {
 	var oidc = &keycloak.IdentityProvider{}
 
@@ -191,6 +228,25 @@ func testAccCheckKeycloakOidcIdentityProviderHasCustomConfigValue(resourceName,
 	}
 }
 
+func testAccCheckKeycloakOidcIdentityProviderHasBrokerLoginFlows(resourceName, firstBrokerLoginFlowAlias, postBrokerLoginFlowAlias string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedOidc, err := getKeycloakOidcIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if fetchedOidc.FirstBrokerLoginFlowAlias != firstBrokerLoginFlowAlias {
+			return fmt.Errorf("expected oidc provider to have first broker login flow %s, but was %s", firstBrokerLoginFlowAlias, fetchedOidc.FirstBrokerLoginFlowAlias)
+		}
+
+		if fetchedOidc.PostBrokerLoginFlowAlias != postBrokerLoginFlowAlias {
+			return fmt.Errorf("expected oidc provider to have post broker login flow %s, but was %s", postBrokerLoginFlowAlias, fetchedOidc.PostBrokerLoginFlowAlias)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOidcIdentityProviderDestroy() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -287,3 +343,50 @@ resource "keycloak_oidc_identity_provider" "oidc" {
 }
 	`, oidc.Realm, oidc.Alias, oidc.Enabled, oidc.Config.AuthorizationUrl, oidc.Config.TokenUrl, oidc.Config.ClientId, oidc.Config.ClientSecret)
 }
+
+func testKeycloakOidcIdentityProvider_brokerLoginFlows(realm, oidc, firstBrokerLoginFlowAlias, postBrokerLoginFlowAlias string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "first_broker_login" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_flow" "post_broker_login" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm                         = "${keycloak_realm.realm.id}"
+	alias                         = "%s"
+	authorization_url             = "https://example.com/auth"
+	token_url                     = "https://example.com/token"
+	client_id                     = "example_id"
+	client_secret                 = "example_token"
+	first_broker_login_flow_alias = "${keycloak_authentication_flow.first_broker_login.alias}"
+	post_broker_login_flow_alias  = "${keycloak_authentication_flow.post_broker_login.alias}"
+}
+	`, realm, firstBrokerLoginFlowAlias, postBrokerLoginFlowAlias, oidc)
+}
+
+func testKeycloakOidcIdentityProvider_brokerLoginFlowDoesNotExist(realm, oidc string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm                         = "${keycloak_realm.realm.id}"
+	alias                         = "%s"
+	authorization_url             = "https://example.com/auth"
+	token_url                     = "https://example.com/token"
+	client_id                     = "example_id"
+	client_secret                 = "example_token"
+	first_broker_login_flow_alias = "does-not-exist"
+}
+	`, realm, oidc)
+}
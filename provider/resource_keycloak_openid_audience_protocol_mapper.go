@@ -68,6 +68,12 @@ func resourceKeycloakOpenIdAudienceProtocolMapper() *schema.Resource {
 				Default:     true,
 				Description: "Indicates if this claim should be added to the access token.",
 			},
+			"add_to_introspection_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if this claim should be added to the token introspection response.",
+			},
 		},
 	}
 }
@@ -80,8 +86,9 @@ func mapFromDataToOpenIdAudienceProtocolMapper(data *schema.ResourceData) *keycl
 		ClientId:      data.Get("client_id").(string),
 		ClientScopeId: data.Get("client_scope_id").(string),
 
-		AddToIdToken:     data.Get("add_to_id_token").(bool),
-		AddToAccessToken: data.Get("add_to_access_token").(bool),
+		AddToIdToken:            data.Get("add_to_id_token").(bool),
+		AddToAccessToken:        data.Get("add_to_access_token").(bool),
+		AddToIntrospectionToken: data.Get("add_to_introspection_token").(bool),
 
 		IncludedClientAudience: data.Get("included_client_audience").(string),
 		IncludedCustomAudience: data.Get("included_custom_audience").(string),
@@ -107,6 +114,7 @@ func mapFromOpenIdAudienceMapperToData(mapper *keycloak.OpenIdAudienceProtocolMa
 
 	data.Set("add_to_id_token", mapper.AddToIdToken)
 	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_introspection_token", mapper.AddToIntrospectionToken)
 }
 
 func resourceKeycloakOpenIdAudienceProtocolMapperCreate(data *schema.ResourceData, meta interface{}) error {
@@ -51,6 +51,43 @@ func TestAccKeycloakOpenIdAudienceProtocolMapper_basicClientScope(t *testing.T)
 	})
 }
 
+func TestAccKeycloakOpenIdAudienceProtocolMapper_tokenClaims(t *testing.T) {
+	realmName := "terraform-realm-" + acctest.RandString(10)
+	clientScopeId := "terraform-client-scope-" + acctest.RandString(10)
+	mapperName := "terraform-openid-connect-audience-mapper-" + acctest.RandString(5)
+
+	resourceName := "keycloak_openid_audience_protocol_mapper.audience_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKeycloakOpenIdAudienceProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAudienceProtocolMapper_tokenClaims(realmName, clientScopeId, mapperName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAudienceProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", "false"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_introspection_token", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(resourceName),
+			},
+			{
+				Config: testKeycloakOpenIdAudienceProtocolMapper_tokenClaims(realmName, clientScopeId, mapperName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", "true"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_introspection_token", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenIdAudienceProtocolMapper_import(t *testing.T) {
 	realmName := "terraform-realm-" + acctest.RandString(10)
 	clientId := "terraform-openid-client-" + acctest.RandString(10)
@@ -382,6 +419,30 @@ resource "keycloak_openid_audience_protocol_mapper" "audience_mapper_client_scop
 }`, realmName, clientScopeId, mapperName)
 }
 
+func testKeycloakOpenIdAudienceProtocolMapper_tokenClaims(realmName, clientScopeId, mapperName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_openid_audience_protocol_mapper" "audience_mapper_client_scope" {
+	name                       = "%s"
+	realm_id                   = "${keycloak_realm.realm.id}"
+	client_scope_id            = "${keycloak_openid_client_scope.client_scope.id}"
+
+	included_custom_audience   = "foo"
+
+	add_to_id_token            = %[4]t
+	add_to_access_token        = true
+	add_to_introspection_token = %[4]t
+}`, realmName, clientScopeId, mapperName, enabled)
+}
+
 func testKeycloakOpenIdAudienceProtocolMapper_import(realmName, clientId, clientScopeId, mapperName string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strconv"
 	"strings"
 )
 
@@ -101,6 +102,59 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"access_token_lifespan": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				Description:      "How long access tokens issued for this client are valid, such as 5m. The realm's setting is used when this is empty.",
+			},
+			"client_session_idle_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				Description:      "How long a client session can be idle before it expires. The realm's setting is used when this is empty.",
+			},
+			"client_session_max_lifespan": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				Description:      "The maximum time before a client session expires. The realm's setting is used when this is empty.",
+			},
+			"backchannel_logout_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL Keycloak sends a logout token to when a user logs out.",
+			},
+			"backchannel_logout_session_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, the logout token sent to backchannel_logout_url includes the session ID.",
+			},
+			"consent_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, users have to consent to the client's access before they are logged in to it.",
+			},
+			"display_on_consent_screen": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the client is listed on the consent screen along with its client scopes.",
+			},
+			"consent_screen_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The text shown for this client on the consent screen when display_on_consent_screen is true.",
+			},
+			// an escape hatch for attributes that don't have an argument of their own. only the attributes listed here are
+			// tracked, so attributes keycloak sets by default don't cause drift
+			"extra_config": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
 			"service_account_user_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -109,6 +163,10 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"authorization_services_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"authorization": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -138,6 +196,23 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"authentication_flow_binding_overrides": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"browser_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"direct_grant_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -171,14 +246,37 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 		DirectAccessGrantsEnabled: data.Get("direct_access_grants_enabled").(bool),
 		ServiceAccountsEnabled:    data.Get("service_accounts_enabled").(bool),
 		FullScopeAllowed:          data.Get("full_scope_allowed").(bool),
+		ConsentRequired:           data.Get("consent_required").(bool),
 		Attributes: keycloak.OpenidClientAttributes{
 			PkceCodeChallengeMethod:             data.Get("pkce_code_challenge_method").(string),
 			ExcludeSessionStateFromAuthResponse: keycloak.KeycloakBoolQuoted(data.Get("exclude_session_state_from_auth_response").(bool)),
+			BackchannelLogoutUrl:                data.Get("backchannel_logout_url").(string),
+			BackchannelLogoutSessionRequired:    keycloak.KeycloakBoolQuoted(data.Get("backchannel_logout_session_required").(bool)),
+			DisplayOnConsentScreen:              keycloak.KeycloakBoolQuoted(data.Get("display_on_consent_screen").(bool)),
+			ConsentScreenText:                   data.Get("consent_screen_text").(string),
+			ExtraConfig:                         getOpenidClientExtraConfigFromData(data),
 		},
 		ValidRedirectUris: validRedirectUris,
 		WebOrigins:        webOrigins,
 	}
 
+	var err error
+
+	openidClient.Attributes.AccessTokenLifespan, err = getOpenidClientDurationAttributeFromData(data, "access_token_lifespan")
+	if err != nil {
+		return nil, err
+	}
+
+	openidClient.Attributes.ClientSessionIdleTimeout, err = getOpenidClientDurationAttributeFromData(data, "client_session_idle_timeout")
+	if err != nil {
+		return nil, err
+	}
+
+	openidClient.Attributes.ClientSessionMaxLifespan, err = getOpenidClientDurationAttributeFromData(data, "client_session_max_lifespan")
+	if err != nil {
+		return nil, err
+	}
+
 	if !openidClient.ImplicitFlowEnabled && !openidClient.StandardFlowEnabled {
 		if _, ok := data.GetOk("valid_redirect_uris"); ok {
 			return nil, errors.New("valid_redirect_uris cannot be set when standard or implicit flow is not enabled")
@@ -210,9 +308,59 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 	} else {
 		openidClient.AuthorizationServicesEnabled = false
 	}
+
+	// when this block is removed, both overrides are sent as empty strings so the client reverts to the realm's flows
+	if v, ok := data.GetOk("authentication_flow_binding_overrides"); ok {
+		authenticationFlowBindingOverrides := v.(*schema.Set).List()[0].(map[string]interface{})
+		openidClient.AuthenticationFlowBindingOverrides = keycloak.OpenidAuthenticationFlowBindingOverrides{
+			BrowserId:     authenticationFlowBindingOverrides["browser_id"].(string),
+			DirectGrantId: authenticationFlowBindingOverrides["direct_grant_id"].(string),
+		}
+	}
+
 	return openidClient, nil
 }
 
+// client attributes store durations as a number of seconds, and an empty string means the realm's setting is used
+func getOpenidClientDurationAttributeFromData(data *schema.ResourceData, key string) (string, error) {
+	duration := data.Get(key).(string)
+	if duration == "" {
+		return "", nil
+	}
+
+	seconds, err := getSecondsFromDurationString(duration)
+	if err != nil {
+		return "", fmt.Errorf("%s must be a duration such as 5m or 1h: %s", key, err)
+	}
+
+	return strconv.Itoa(seconds), nil
+}
+
+func getOpenidClientDurationAttribute(seconds string) string {
+	s, err := strconv.Atoi(seconds)
+	if err != nil {
+		return seconds
+	}
+
+	return getDurationStringFromSeconds(s)
+}
+
+// attributes that were removed from `extra_config` are sent as empty strings, since keycloak keeps attributes that are
+// left out of an update
+func getOpenidClientExtraConfigFromData(data *schema.ResourceData) map[string]interface{} {
+	extraConfig := make(map[string]interface{})
+
+	oldExtraConfig, newExtraConfig := data.GetChange("extra_config")
+	for key := range oldExtraConfig.(map[string]interface{}) {
+		extraConfig[key] = ""
+	}
+	for key, value := range newExtraConfig.(map[string]interface{}) {
+		extraConfig[key] = value
+	}
+
+	return extraConfig
+}
+
 func setOpenidClientData(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
 	var serviceAccountUserId string
 	if client.ServiceAccountsEnabled {
@@ -237,9 +385,43 @@ func setOpenidClientData(keycloakClient *keycloak.KeycloakClient, data *schema.R
 	data.Set("web_origins", client.WebOrigins)
 	data.Set("authorization_services_enabled", client.AuthorizationServicesEnabled)
 	data.Set("full_scope_allowed", client.FullScopeAllowed)
+	data.Set("pkce_code_challenge_method", client.Attributes.PkceCodeChallengeMethod)
+	data.Set("exclude_session_state_from_auth_response", client.Attributes.ExcludeSessionStateFromAuthResponse)
+	data.Set("access_token_lifespan", getOpenidClientDurationAttribute(client.Attributes.AccessTokenLifespan))
+	data.Set("client_session_idle_timeout", getOpenidClientDurationAttribute(client.Attributes.ClientSessionIdleTimeout))
+	data.Set("client_session_max_lifespan", getOpenidClientDurationAttribute(client.Attributes.ClientSessionMaxLifespan))
+	data.Set("backchannel_logout_url", client.Attributes.BackchannelLogoutUrl)
+	data.Set("backchannel_logout_session_required", client.Attributes.BackchannelLogoutSessionRequired)
+	data.Set("consent_required", client.ConsentRequired)
+	data.Set("display_on_consent_screen", client.Attributes.DisplayOnConsentScreen)
+	data.Set("consent_screen_text", client.Attributes.ConsentScreenText)
+
+	// only the attributes that are managed by `extra_config` are tracked
+	extraConfig := make(map[string]interface{})
+	for key := range data.Get("extra_config").(map[string]interface{}) {
+		if value, ok := client.Attributes.ExtraConfig[key]; ok && value != "" {
+			extraConfig[key] = value
+		}
+	}
+	data.Set("extra_config", extraConfig)
 
+	// the resource server shares its ID with the client, and only exists while authorization services are enabled
 	if client.AuthorizationServicesEnabled {
 		data.Set("resource_server_id", client.Id)
+	} else {
+		data.Set("resource_server_id", "")
+		data.Set("authorization", nil)
+	}
+
+	if overrides := client.AuthenticationFlowBindingOverrides; overrides.BrowserId != "" || overrides.DirectGrantId != "" {
+		data.Set("authentication_flow_binding_overrides", []interface{}{
+			map[string]interface{}{
+				"browser_id":      overrides.BrowserId,
+				"direct_grant_id": overrides.DirectGrantId,
+			},
+		})
+	} else {
+		data.Set("authentication_flow_binding_overrides", nil)
 	}
 
 	if client.ServiceAccountsEnabled {
@@ -170,7 +170,7 @@ func resourceKeycloakOpenidClientAuthorizationPermissionImport(d *schema.Resourc
 	}
 	d.Set("realm_id", parts[0])
 	d.Set("resource_server_id", parts[1])
-	d.SetId(parts[3])
+	d.SetId(parts[2])
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+func resourceKeycloakOpenidClientAuthorizationScopePermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakOpenidClientAuthorizationScopePermissionCreate,
+		Read:   resourceKeycloakOpenidClientAuthorizationScopePermissionRead,
+		Delete: resourceKeycloakOpenidClientAuthorizationScopePermissionDelete,
+		Update: resourceKeycloakOpenidClientAuthorizationScopePermissionUpdate,
+		// This resource can be imported using {{realm}}/{{resourceServerId}}/{{permissionId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakOpenidClientAuthorizationScopePermissionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"decision_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+				Default:      "UNANIMOUS",
+			},
+			"policies": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MinItems: 1,
+			},
+		},
+	}
+}
+
+func getOpenidClientAuthorizationScopePermissionFromData(data *schema.ResourceData) *keycloak.OpenidClientAuthorizationPermission {
+	return &keycloak.OpenidClientAuthorizationPermission{
+		Id:               data.Id(),
+		ResourceServerId: data.Get("resource_server_id").(string),
+		RealmId:          data.Get("realm_id").(string),
+		Name:             data.Get("name").(string),
+		Description:      data.Get("description").(string),
+		DecisionStrategy: data.Get("decision_strategy").(string),
+		Type:             "scope",
+		Policies:         interfaceSliceToStringSlice(data.Get("policies").(*schema.Set).List()),
+		Resources:        interfaceSliceToStringSlice(data.Get("resources").(*schema.Set).List()),
+		Scopes:           interfaceSliceToStringSlice(data.Get("scopes").(*schema.Set).List()),
+	}
+}
+
+func setOpenidClientAuthorizationScopePermissionData(data *schema.ResourceData, permission *keycloak.OpenidClientAuthorizationPermission) {
+	data.SetId(permission.Id)
+	data.Set("resource_server_id", permission.ResourceServerId)
+	data.Set("realm_id", permission.RealmId)
+	data.Set("name", permission.Name)
+	data.Set("description", permission.Description)
+	data.Set("decision_strategy", permission.DecisionStrategy)
+	data.Set("policies", permission.Policies)
+	data.Set("resources", permission.Resources)
+	data.Set("scopes", permission.Scopes)
+}
+
+func resourceKeycloakOpenidClientAuthorizationScopePermissionCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	permission := getOpenidClientAuthorizationScopePermissionFromData(data)
+
+	err := keycloakClient.NewOpenidClientAuthorizationScopePermission(permission)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(permission.Id)
+
+	return resourceKeycloakOpenidClientAuthorizationScopePermissionRead(data, meta)
+}
+
+func resourceKeycloakOpenidClientAuthorizationScopePermissionRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	permission, err := keycloakClient.GetOpenidClientAuthorizationScopePermission(realmId, resourceServerId, data.Id())
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setOpenidClientAuthorizationScopePermissionData(data, permission)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientAuthorizationScopePermissionUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	permission := getOpenidClientAuthorizationScopePermissionFromData(data)
+
+	err := keycloakClient.UpdateOpenidClientAuthorizationScopePermission(permission)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakOpenidClientAuthorizationScopePermissionRead(data, meta)
+}
+
+func resourceKeycloakOpenidClientAuthorizationScopePermissionDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	return keycloakClient.DeleteOpenidClientAuthorizationPermission(realmId, resourceServerId, data.Id())
+}
+
+func resourceKeycloakOpenidClientAuthorizationScopePermissionImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{resourceServerId}}/{{permissionId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("resource_server_id", parts[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakOpenidClientAuthorizationScopePermission_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+	scopeName := "terraform-" + acctest.RandString(10)
+	permissionName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_openid_client_authorization_scope_permission.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientAuthorizationScopePermissionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientAuthorizationScopePermission_basic(realmName, clientId, scopeName, permissionName, "UNANIMOUS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientAuthorizationScopePermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["resource_server_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				Config: testKeycloakOpenidClientAuthorizationScopePermission_basic(realmName, clientId, scopeName, permissionName, "AFFIRMATIVE"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "decision_strategy", "AFFIRMATIVE"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientAuthorizationScopePermissionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationScopePermission(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["resource_server_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting authorization scope permission with id %s: %s", rs.Primary.ID, err)
+		}
+
+		if permission.Type != "scope" {
+			return fmt.Errorf("expected permission with id %s to have type scope, got %s", rs.Primary.ID, permission.Type)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientAuthorizationScopePermissionDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_openid_client_authorization_scope_permission" {
+				continue
+			}
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			permission, _ := keycloakClient.GetOpenidClientAuthorizationScopePermission(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["resource_server_id"], rs.Primary.ID)
+			if permission != nil {
+				return fmt.Errorf("authorization scope permission with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenidClientAuthorizationScopePermission_basic(realm, clientId, scopeName, permissionName, decisionStrategy string) string {
+	return fmt.Sprintf(`
+resource keycloak_realm test {
+	realm = "%s"
+}
+
+resource keycloak_openid_client test {
+	client_id                = "%s"
+	realm_id                 = "${keycloak_realm.test.id}"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+	authorization {
+		policy_enforcement_mode = "ENFORCING"
+	}
+}
+
+data keycloak_openid_client_authorization_policy default {
+	realm_id           = "${keycloak_realm.test.id}"
+	resource_server_id = "${keycloak_openid_client.test.resource_server_id}"
+	name               = "default"
+}
+
+resource keycloak_openid_client_authorization_scope test {
+	resource_server_id = "${keycloak_openid_client.test.resource_server_id}"
+	realm_id           = "${keycloak_realm.test.id}"
+	name               = "%s"
+}
+
+resource keycloak_openid_client_authorization_resource test {
+	resource_server_id = "${keycloak_openid_client.test.resource_server_id}"
+	realm_id           = "${keycloak_realm.test.id}"
+	name               = "terraform-resource"
+
+	uris = [
+		"/endpoint/*"
+	]
+
+	scopes = ["${keycloak_openid_client_authorization_scope.test.name}"]
+}
+
+resource keycloak_openid_client_authorization_scope_permission test {
+	resource_server_id = "${keycloak_openid_client.test.resource_server_id}"
+	realm_id           = "${keycloak_realm.test.id}"
+	name               = "%s"
+	decision_strategy  = "%s"
+	policies           = ["${data.keycloak_openid_client_authorization_policy.default.id}"]
+	resources          = ["${keycloak_openid_client_authorization_resource.test.id}"]
+	scopes             = ["${keycloak_openid_client_authorization_scope.test.id}"]
+}
+	`, realm, clientId, scopeName, permissionName, decisionStrategy)
+}
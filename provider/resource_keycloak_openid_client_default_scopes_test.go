@@ -101,6 +101,35 @@ func TestAccKeycloakOpenidClientDefaultScopes_updateInPlace(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClientDefaultScopes_reorderedScopes(t *testing.T) {
+	realm := "terraform-realm-" + acctest.RandString(10)
+	client := "terraform-client-" + acctest.RandString(10)
+	clientScope := "terraform-client-scope-" + acctest.RandString(10)
+
+	allClientScopes := append(preAssignedDefaultClientScopes, clientScope)
+
+	var reorderedClientScopes []string
+	for i := len(allClientScopes) - 1; i >= 0; i-- {
+		reorderedClientScopes = append(reorderedClientScopes, allClientScopes[i])
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientDefaultScopes_listOfScopes(realm, client, clientScope, allClientScopes),
+				Check:  testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client_default_scopes.default_scopes", allClientScopes),
+			},
+			// default_scopes is a set, so changing the order of the scopes shouldn't produce a diff
+			{
+				Config:   testKeycloakOpenidClientDefaultScopes_listOfScopes(realm, client, clientScope, reorderedClientScopes),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClientDefaultScopes_validateClientDoesNotExist(t *testing.T) {
 	realm := "terraform-realm-" + acctest.RandString(10)
 	client := "terraform-client-" + acctest.RandString(10)
@@ -101,6 +101,35 @@ func TestAccKeycloakOpenidClientOptionalScopes_updateInPlace(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClientOptionalScopes_reorderedScopes(t *testing.T) {
+	realm := "terraform-realm-" + acctest.RandString(10)
+	client := "terraform-client-" + acctest.RandString(10)
+	clientScope := "terraform-client-scope-" + acctest.RandString(10)
+
+	allClientScopes := append(preAssignedOptionalClientScopes, clientScope)
+
+	var reorderedClientScopes []string
+	for i := len(allClientScopes) - 1; i >= 0; i-- {
+		reorderedClientScopes = append(reorderedClientScopes, allClientScopes[i])
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientOptionalScopes_listOfScopes(realm, client, clientScope, allClientScopes),
+				Check:  testAccCheckKeycloakOpenidClientHasOptionalScopes("keycloak_openid_client_optional_scopes.optional_scopes", allClientScopes),
+			},
+			// optional_scopes is a set, so changing the order of the scopes shouldn't produce a diff
+			{
+				Config:   testKeycloakOpenidClientOptionalScopes_listOfScopes(realm, client, clientScope, reorderedClientScopes),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClientOptionalScopes_validateClientDoesNotExist(t *testing.T) {
 	realm := "terraform-realm-" + acctest.RandString(10)
 	client := "terraform-client-" + acctest.RandString(10)
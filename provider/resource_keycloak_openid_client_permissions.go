@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"log"
+	"strings"
+)
+
+var keycloakOpenidClientPermissionsScopes = []scopePermissionAttribute{
+	{attribute: "view_scope", scope: "view"},
+	{attribute: "manage_scope", scope: "manage"},
+	{attribute: "configure_scope", scope: "configure"},
+	{attribute: "map_roles_scope", scope: "map-roles"},
+	{attribute: "map_roles_client_scope_scope", scope: "map-roles-client-scope"},
+	{attribute: "map_roles_composite_scope", scope: "map-roles-composite"},
+	{attribute: "token_exchange_scope", scope: "token-exchange"},
+}
+
+func resourceKeycloakOpenidClientPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakOpenidClientPermissionsCreate,
+		Read:   resourceKeycloakOpenidClientPermissionsRead,
+		Update: resourceKeycloakOpenidClientPermissionsUpdate,
+		Delete: resourceKeycloakOpenidClientPermissionsDelete,
+		// This resource can be imported using {{realm}}/{{clientId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakOpenidClientPermissionsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"authorization_resource_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"view_scope":                   scopePermissionSchema(),
+			"manage_scope":                 scopePermissionSchema(),
+			"configure_scope":              scopePermissionSchema(),
+			"map_roles_scope":              scopePermissionSchema(),
+			"map_roles_client_scope_scope": scopePermissionSchema(),
+			"map_roles_composite_scope":    scopePermissionSchema(),
+			"token_exchange_scope":         scopePermissionSchema(),
+		},
+	}
+}
+
+func openidClientPermissionsId(realmId, clientId string) string {
+	return fmt.Sprintf("%s/%s", realmId, clientId)
+}
+
+func resourceKeycloakOpenidClientPermissionsCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	err := keycloakClient.EnableOpenidClientPermissions(realmId, clientId)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(openidClientPermissionsId(realmId, clientId))
+
+	return resourceKeycloakOpenidClientPermissionsUpdate(data, meta)
+}
+
+func resourceKeycloakOpenidClientPermissionsRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(realmId, clientId)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	// permissions that were disabled outside of terraform no longer have any scope permissions, so they need to be
+	// enabled again
+	if !openidClientPermissions.Enabled {
+		log.Printf("[WARN] Removing resource with id %s from state as client permissions are no longer enabled", data.Id())
+		data.SetId("")
+
+		return nil
+	}
+
+	realmManagementClient, err := keycloakClient.GetRealmManagementClient(realmId)
+	if err != nil {
+		return err
+	}
+
+	err = setScopePermissionsData(keycloakClient, data, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions, keycloakOpenidClientPermissionsScopes)
+	if err != nil {
+		return err
+	}
+
+	data.Set("enabled", openidClientPermissions.Enabled)
+	data.Set("authorization_resource_server_id", realmManagementClient.Id)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientPermissionsUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(realmId, clientId)
+	if err != nil {
+		return err
+	}
+
+	realmManagementClient, err := keycloakClient.GetRealmManagementClient(realmId)
+	if err != nil {
+		return err
+	}
+
+	err = updateScopePermissionsFromData(keycloakClient, data, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions, keycloakOpenidClientPermissionsScopes)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakOpenidClientPermissionsRead(data, meta)
+}
+
+func resourceKeycloakOpenidClientPermissionsDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.DisableOpenidClientPermissions(data.Get("realm_id").(string), data.Get("client_id").(string))
+	if err != nil {
+		// the permissions were deleted along with the client, so there's nothing left to do
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientPermissionsImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{clientId}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("client_id", parts[1])
+	d.SetId(openidClientPermissionsId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
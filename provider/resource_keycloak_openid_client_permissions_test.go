@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakOpenidClientPermissions_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-client-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+	policyName := "terraform-policy-" + acctest.RandString(10)
+
+	resourceName := "keycloak_openid_client_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientPermissionsDisabled(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientPermissions_basic(realmName, clientId, roleName, policyName, "UNANIMOUS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorization_resource_server_id", "data.keycloak_openid_client.realm_management", "id"),
+					resource.TestCheckResourceAttr(resourceName, "token_exchange_scope.0.policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "view_scope.#", "0"),
+					testAccCheckKeycloakOpenidClientPermissionHasPolicies(resourceName, "token-exchange", 1),
+					testAccCheckKeycloakOpenidClientPermissionHasPolicies(resourceName, "view", 0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakOpenidClientPermissions_basic(realmName, clientId, roleName, policyName, "AFFIRMATIVE"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "token_exchange_scope.0.decision_strategy", "AFFIRMATIVE"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientPermissionHasPolicies(resourceName, scope string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		realmId := rs.Primary.Attributes["realm_id"]
+
+		openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(realmId, rs.Primary.Attributes["client_id"])
+		if err != nil {
+			return err
+		}
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationScopePermission(realmId, rs.Primary.Attributes["authorization_resource_server_id"], openidClientPermissions.ScopePermissions[scope])
+		if err != nil {
+			return err
+		}
+
+		if len(permission.Policies) != count {
+			return fmt.Errorf("expected %s permission to have %d policies, got %d", scope, count, len(permission.Policies))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientPermissionsDisabled() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_openid_client_permissions" {
+				continue
+			}
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			openidClientPermissions, _ := keycloakClient.GetOpenidClientPermissions(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["client_id"])
+			if openidClientPermissions != nil && openidClientPermissions.Enabled {
+				return fmt.Errorf("permissions are still enabled for client %s", rs.Primary.Attributes["client_id"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenidClientPermissions_basic(realmName, clientId, roleName, policyName, decisionStrategy string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = "${keycloak_realm.realm.id}"
+	client_id   = "%s"
+	access_type = "CONFIDENTIAL"
+}
+
+data "keycloak_openid_client" "realm_management" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "realm-management"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_openid_client_role_policy" "policy" {
+	realm_id           = "${keycloak_realm.realm.id}"
+	resource_server_id = "${data.keycloak_openid_client.realm_management.id}"
+	name               = "%s"
+
+	role {
+		id = "${keycloak_role.role.id}"
+	}
+}
+
+resource "keycloak_openid_client_permissions" "permissions" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.client.id}"
+
+	token_exchange_scope {
+		policies          = ["${keycloak_openid_client_role_policy.policy.id}"]
+		description       = "exchange tokens for this client"
+		decision_strategy = "%s"
+	}
+}
+	`, realmName, clientId, roleName, policyName, decisionStrategy)
+}
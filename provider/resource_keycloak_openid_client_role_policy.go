@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+var (
+	keycloakOpenidClientPolicyLogic = []string{"POSITIVE", "NEGATIVE"}
+)
+
+func resourceKeycloakOpenidClientRolePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakOpenidClientRolePolicyCreate,
+		Read:   resourceKeycloakOpenidClientRolePolicyRead,
+		Delete: resourceKeycloakOpenidClientRolePolicyDelete,
+		Update: resourceKeycloakOpenidClientRolePolicyUpdate,
+		// This resource can be imported using {{realm}}/{{resourceServerId}}/{{policyId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakOpenidClientRolePolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"decision_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+				Default:      "UNANIMOUS",
+			},
+			"logic": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientPolicyLogic, false),
+				Default:      "POSITIVE",
+			},
+			"role": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getOpenidClientRolePolicyFromData(data *schema.ResourceData) *keycloak.OpenidClientAuthorizationRolePolicy {
+	var roles []keycloak.OpenidClientAuthorizationRole
+	for _, r := range data.Get("role").(*schema.Set).List() {
+		role := r.(map[string]interface{})
+		roles = append(roles, keycloak.OpenidClientAuthorizationRole{
+			Id:       role["id"].(string),
+			Required: role["required"].(bool),
+		})
+	}
+
+	return &keycloak.OpenidClientAuthorizationRolePolicy{
+		Id:               data.Id(),
+		ResourceServerId: data.Get("resource_server_id").(string),
+		RealmId:          data.Get("realm_id").(string),
+		Name:             data.Get("name").(string),
+		Description:      data.Get("description").(string),
+		DecisionStrategy: data.Get("decision_strategy").(string),
+		Logic:            data.Get("logic").(string),
+		Roles:            roles,
+	}
+}
+
+func setOpenidClientRolePolicyData(data *schema.ResourceData, policy *keycloak.OpenidClientAuthorizationRolePolicy) {
+	var roles []interface{}
+	for _, role := range policy.Roles {
+		roles = append(roles, map[string]interface{}{
+			"id":       role.Id,
+			"required": role.Required,
+		})
+	}
+
+	data.SetId(policy.Id)
+	data.Set("resource_server_id", policy.ResourceServerId)
+	data.Set("realm_id", policy.RealmId)
+	data.Set("name", policy.Name)
+	data.Set("description", policy.Description)
+	data.Set("decision_strategy", policy.DecisionStrategy)
+	data.Set("logic", policy.Logic)
+	data.Set("role", roles)
+}
+
+func resourceKeycloakOpenidClientRolePolicyCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getOpenidClientRolePolicyFromData(data)
+
+	err := keycloakClient.NewOpenidClientAuthorizationRolePolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	setOpenidClientRolePolicyData(data, policy)
+
+	return resourceKeycloakOpenidClientRolePolicyRead(data, meta)
+}
+
+func resourceKeycloakOpenidClientRolePolicyRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	policy, err := keycloakClient.GetOpenidClientAuthorizationRolePolicy(realmId, resourceServerId, data.Id())
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setOpenidClientRolePolicyData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientRolePolicyUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getOpenidClientRolePolicyFromData(data)
+
+	err := keycloakClient.UpdateOpenidClientAuthorizationRolePolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	setOpenidClientRolePolicyData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientRolePolicyDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	return keycloakClient.DeleteOpenidClientAuthorizationRolePolicy(realmId, resourceServerId, data.Id())
+}
+
+func resourceKeycloakOpenidClientRolePolicyImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{resourceServerId}}/{{policyId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("resource_server_id", parts[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakOpenidClientRolePolicy_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+	roleName := "terraform-" + acctest.RandString(10)
+	policyName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientRolePolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientRolePolicy_basic(realmName, clientId, roleName, policyName, "POSITIVE", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientRolePolicyExists("keycloak_openid_client_role_policy.test"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_role_policy.test", "logic", "POSITIVE"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_role_policy.test", "role.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_openid_client_role_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getOpenidClientRolePolicyImportId("keycloak_openid_client_role_policy.test"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientRolePolicy_update(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+	roleName := "terraform-" + acctest.RandString(10)
+	policyName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientRolePolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientRolePolicy_basic(realmName, clientId, roleName, policyName, "POSITIVE", true),
+				Check:  testAccCheckKeycloakOpenidClientRolePolicyHasRoles("keycloak_openid_client_role_policy.test", true),
+			},
+			{
+				Config: testKeycloakOpenidClientRolePolicy_basic(realmName, clientId, roleName, policyName, "NEGATIVE", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientRolePolicyHasRoles("keycloak_openid_client_role_policy.test", false),
+					resource.TestCheckResourceAttr("keycloak_openid_client_role_policy.test", "logic", "NEGATIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientRolePolicy_createAfterManualDestroy(t *testing.T) {
+	var policy = &keycloak.OpenidClientAuthorizationRolePolicy{}
+
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+	roleName := "terraform-" + acctest.RandString(10)
+	policyName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientRolePolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientRolePolicy_basic(realmName, clientId, roleName, policyName, "POSITIVE", true),
+				Check:  testAccCheckKeycloakOpenidClientRolePolicyFetch("keycloak_openid_client_role_policy.test", policy),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					err := keycloakClient.DeleteOpenidClientAuthorizationRolePolicy(policy.RealmId, policy.ResourceServerId, policy.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakOpenidClientRolePolicy_basic(realmName, clientId, roleName, policyName, "POSITIVE", true),
+				Check:  testAccCheckKeycloakOpenidClientRolePolicyExists("keycloak_openid_client_role_policy.test"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientRolePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakOpenidClientRolePolicyFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientRolePolicyHasRoles(resourceName string, required bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policy, err := getKeycloakOpenidClientRolePolicyFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if len(policy.Roles) != 1 {
+			return fmt.Errorf("expected policy %s to have 1 role, but it has %d", policy.Id, len(policy.Roles))
+		}
+
+		if policy.Roles[0].Required != required {
+			return fmt.Errorf("expected role %s to have required set to %t", policy.Roles[0].Id, required)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientRolePolicyFetch(resourceName string, policy *keycloak.OpenidClientAuthorizationRolePolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedPolicy, err := getKeycloakOpenidClientRolePolicyFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		policy.ResourceServerId = fetchedPolicy.ResourceServerId
+		policy.RealmId = fetchedPolicy.RealmId
+		policy.Id = fetchedPolicy.Id
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientRolePolicyDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_openid_client_role_policy" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			resourceServerId := rs.Primary.Attributes["resource_server_id"]
+			id := rs.Primary.ID
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			policy, _ := keycloakClient.GetOpenidClientAuthorizationRolePolicy(realmId, resourceServerId, id)
+			if policy != nil {
+				return fmt.Errorf("role policy with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakOpenidClientRolePolicyFromState(s *terraform.State, resourceName string) (*keycloak.OpenidClientAuthorizationRolePolicy, error) {
+	keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realmId := rs.Primary.Attributes["realm_id"]
+	resourceServerId := rs.Primary.Attributes["resource_server_id"]
+	id := rs.Primary.ID
+
+	policy, err := keycloakClient.GetOpenidClientAuthorizationRolePolicy(realmId, resourceServerId, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting role policy with id %s: %s", id, err)
+	}
+
+	return policy, nil
+}
+
+func getOpenidClientRolePolicyImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		resourceServerId := rs.Primary.Attributes["resource_server_id"]
+		id := rs.Primary.ID
+
+		return fmt.Sprintf("%s/%s/%s", realmId, resourceServerId, id), nil
+	}
+}
+
+func testKeycloakOpenidClientRolePolicy_basic(realm, clientId, roleName, policyName, logic string, required bool) string {
+	return fmt.Sprintf(`
+resource keycloak_realm test {
+	realm = "%s"
+}
+
+resource keycloak_openid_client test {
+	client_id                = "%s"
+	realm_id                 = "${keycloak_realm.test.id}"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+	authorization {
+		policy_enforcement_mode = "ENFORCING"
+	}
+}
+
+resource keycloak_role test {
+	realm_id = "${keycloak_realm.test.id}"
+	name     = "%s"
+}
+
+resource keycloak_openid_client_role_policy test {
+	resource_server_id = "${keycloak_openid_client.test.resource_server_id}"
+	realm_id           = "${keycloak_realm.test.id}"
+	name               = "%s"
+	logic              = "%s"
+
+	role {
+		id       = "${keycloak_role.test.id}"
+		required = %t
+	}
+}
+	`, realm, clientId, roleName, policyName, logic, required)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strconv"
 	"strings"
 )
 
@@ -35,6 +36,16 @@ func resourceKeycloakOpenidClientScope() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"include_in_token_scope": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When set, the name of this client scope will be added to the access token's scope claim.",
+			},
+			"gui_order": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -54,6 +65,12 @@ func getClientScopeFromData(data *schema.ResourceData) *keycloak.OpenidClientSco
 		clientScope.Attributes.DisplayOnConsentScreen = "false"
 	}
 
+	clientScope.Attributes.IncludeInTokenScope = strconv.FormatBool(data.Get("include_in_token_scope").(bool))
+
+	if guiOrder, ok := data.GetOk("gui_order"); ok {
+		clientScope.Attributes.GuiOrder = strconv.Itoa(guiOrder.(int))
+	}
+
 	return clientScope
 }
 
@@ -67,6 +84,19 @@ func setClientScopeData(data *schema.ResourceData, clientScope *keycloak.OpenidC
 	if clientScope.Attributes.DisplayOnConsentScreen == "true" {
 		data.Set("consent_screen_text", clientScope.Attributes.ConsentScreenText)
 	}
+
+	// scopes created before this attribute existed don't have it, and keycloak treats them as included
+	if includeInTokenScope, err := strconv.ParseBool(clientScope.Attributes.IncludeInTokenScope); err == nil {
+		data.Set("include_in_token_scope", includeInTokenScope)
+	} else {
+		data.Set("include_in_token_scope", true)
+	}
+
+	if guiOrder, err := strconv.Atoi(clientScope.Attributes.GuiOrder); err == nil {
+		data.Set("gui_order", guiOrder)
+	} else {
+		data.Set("gui_order", nil)
+	}
 }
 
 func resourceKeycloakOpenidClientScopeCreate(data *schema.ResourceData, meta interface{}) error {
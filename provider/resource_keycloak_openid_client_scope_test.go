@@ -119,6 +119,40 @@ func TestAccKeycloakClientScope_consentScreenText(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakClientScope_guiOrderAndIncludeInTokenScope(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientScopeName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakClientScopeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientScope_basic(realmName, clientScopeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client_scope.client_scope", "include_in_token_scope", "true"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_scope.client_scope", "gui_order", "0"),
+				),
+			},
+			{
+				Config: testKeycloakClientScope_guiOrderAndIncludeInTokenScope(realmName, clientScopeName, 1, false),
+				Check:  testAccCheckKeycloakClientScopeHasGuiOrderAndIncludeInTokenScope("keycloak_openid_client_scope.client_scope", "1", "false"),
+			},
+			{
+				ResourceName:        "keycloak_openid_client_scope.client_scope",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+			{
+				Config: testKeycloakClientScope_guiOrderAndIncludeInTokenScope(realmName, clientScopeName, 2, true),
+				Check:  testAccCheckKeycloakClientScopeHasGuiOrderAndIncludeInTokenScope("keycloak_openid_client_scope.client_scope", "2", "true"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakClientScopeExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		clientScope, err := getClientScopeFromState(s, resourceName)
@@ -185,6 +219,25 @@ func testAccCheckKeycloakClientScopeDestroy() resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckKeycloakClientScopeHasGuiOrderAndIncludeInTokenScope(resourceName, guiOrder, includeInTokenScope string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clientScope, err := getClientScopeFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if clientScope.Attributes.GuiOrder != guiOrder {
+			return fmt.Errorf("expected client scope to have gui order %s, got %s", guiOrder, clientScope.Attributes.GuiOrder)
+		}
+
+		if clientScope.Attributes.IncludeInTokenScope != includeInTokenScope {
+			return fmt.Errorf("expected client scope to have include in token scope set to %s, got %s", includeInTokenScope, clientScope.Attributes.IncludeInTokenScope)
+		}
+
+		return nil
+	}
+}
+
 func getClientScopeFromState(s *terraform.State, resourceName string) (*keycloak.OpenidClientScope, error) {
 	keycloakClientScope := testAccProvider.Meta().(*keycloak.KeycloakClient)
 
@@ -269,3 +322,21 @@ resource "keycloak_openid_client_scope" "client_scope" {
 }
 	`, realmOne, realmTwo, clientScopeName)
 }
+
+func testKeycloakClientScope_guiOrderAndIncludeInTokenScope(realm, clientScopeName string, guiOrder int, includeInTokenScope bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name                   = "%s"
+	realm_id               = "${keycloak_realm.realm.id}"
+
+	description            = "test description"
+
+	gui_order              = %d
+	include_in_token_scope = %t
+}
+	`, realm, clientScopeName, guiOrder, includeInTokenScope)
+}
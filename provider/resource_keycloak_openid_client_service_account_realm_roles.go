@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"sort"
+	"strings"
+)
+
+func resourceKeycloakOpenidClientServiceAccountRealmRoles() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakOpenidClientServiceAccountRealmRolesCreate,
+		Read:   resourceKeycloakOpenidClientServiceAccountRealmRolesRead,
+		Update: resourceKeycloakOpenidClientServiceAccountRealmRolesUpdate,
+		Delete: resourceKeycloakOpenidClientServiceAccountRealmRolesDelete,
+		// This resource can be imported using {{realm}}/{{clientId}}, where clientId is the client's unique ID
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakOpenidClientServiceAccountRealmRolesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_account_user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Required: true,
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func openidClientServiceAccountRealmRolesId(realmId, clientId string) string {
+	return fmt.Sprintf("%s/%s", realmId, clientId)
+}
+
+// identifies the realm roles that an exclusive resource manages, so it can claim the service account user like an
+// exclusive keycloak_user_roles resource does
+func serviceAccountRealmRolesClaim(roles *schema.Set) string {
+	names := interfaceSliceToStringSlice(roles.List())
+	sort.Strings(names)
+
+	return "keycloak_openid_client_service_account_realm_roles:" + strings.Join(names, ",")
+}
+
+// returns the realm roles that are directly assigned to a service account user, keyed by name
+func getRealmRolesByNameFromServiceAccount(keycloakClient *keycloak.KeycloakClient, serviceAccountUser *keycloak.User) (map[string]*keycloak.Role, error) {
+	roles := make(map[string]*keycloak.Role)
+
+	realmRoles, err := keycloakClient.GetUserRealmRoleMappings(serviceAccountUser.RealmId, serviceAccountUser.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range realmRoles {
+		roles[role.Name] = role
+	}
+
+	return roles, nil
+}
+
+// assigns the realm roles in `wanted` that the service account doesn't have yet, then removes any other realm roles it
+// has for which `shouldRemove` returns true
+func syncServiceAccountRealmRoles(keycloakClient *keycloak.KeycloakClient, serviceAccountUser *keycloak.User, wanted *schema.Set, shouldRemove func(name string) bool) error {
+	defer keycloakClient.LockUser(serviceAccountUser.RealmId, serviceAccountUser.Id)()
+
+	remoteRoles, err := getRealmRolesByNameFromServiceAccount(keycloakClient, serviceAccountUser)
+	if err != nil {
+		return err
+	}
+
+	cache := newRoleCache(keycloakClient, serviceAccountUser.RealmId)
+
+	var rolesToAdd []*keycloak.Role
+	for _, name := range wanted.List() {
+		if _, ok := remoteRoles[name.(string)]; ok {
+			continue
+		}
+
+		role, err := cache.getRoleByName("", name.(string))
+		if err != nil {
+			return err
+		}
+
+		rolesToAdd = append(rolesToAdd, role)
+	}
+
+	// the roles to remove come from the service account's role mappings, so roles that no longer exist are never looked up
+	var rolesToRemove []*keycloak.Role
+	for name, role := range remoteRoles {
+		if !wanted.Contains(name) && shouldRemove(name) {
+			rolesToRemove = append(rolesToRemove, role)
+		}
+	}
+
+	if len(rolesToAdd) != 0 {
+		err = keycloakClient.AddRealmRolesToUser(serviceAccountUser.RealmId, serviceAccountUser.Id, rolesToAdd)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(rolesToRemove) != 0 {
+		return keycloakClient.RemoveRealmRolesFromUser(serviceAccountUser.RealmId, serviceAccountUser.Id, rolesToRemove)
+	}
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientServiceAccountRealmRolesCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(realmId, clientId)
+	if err != nil {
+		return err
+	}
+
+	exclusive := data.Get("exclusive").(bool)
+	roles := data.Get("roles").(*schema.Set)
+
+	err = withUserRolesClaim(keycloakClient, exclusive, realmId, serviceAccountUser.Id, "", serviceAccountRealmRolesClaim(roles), func() error {
+		return syncServiceAccountRealmRoles(keycloakClient, serviceAccountUser, roles, func(string) bool {
+			return exclusive
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	data.SetId(openidClientServiceAccountRealmRolesId(realmId, clientId))
+
+	return resourceKeycloakOpenidClientServiceAccountRealmRolesRead(data, meta)
+}
+
+func resourceKeycloakOpenidClientServiceAccountRealmRolesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(realmId, clientId)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	remoteRoles, err := getRealmRolesByNameFromServiceAccount(keycloakClient, serviceAccountUser)
+	if err != nil {
+		return err
+	}
+
+	var roleNames []string
+	for name := range remoteRoles {
+		roleNames = append(roleNames, name)
+	}
+
+	// when this resource isn't exclusive, only track the roles that it manages so roles assigned elsewhere don't cause drift
+	if !data.Get("exclusive").(bool) {
+		roleNames = filterToManagedIds(roleNames, data.Get("roles").(*schema.Set))
+	} else {
+		// an existing resource claims the service account user as well, so a conflict is found even when this resource doesn't change
+		err = claimExclusiveUserRoles(keycloakClient, realmId, serviceAccountUser.Id, serviceAccountRealmRolesClaim(data.Get("roles").(*schema.Set)), serviceAccountRealmRolesClaim(schema.NewSet(schema.HashString, stringSliceToInterfaceSlice(roleNames))))
+		if err != nil {
+			return err
+		}
+	}
+
+	data.Set("roles", roleNames)
+	data.Set("service_account_user_id", serviceAccountUser.Id)
+	data.SetId(openidClientServiceAccountRealmRolesId(realmId, clientId))
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientServiceAccountRealmRolesUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(realmId, clientId)
+	if err != nil {
+		return err
+	}
+
+	oldRoles, newRoles := data.GetChange("roles")
+	exclusive := data.Get("exclusive").(bool)
+
+	// when this resource isn't exclusive, only roles that were removed from the configuration are removed from the service account
+	err = withUserRolesClaim(keycloakClient, exclusive, realmId, serviceAccountUser.Id, serviceAccountRealmRolesClaim(oldRoles.(*schema.Set)), serviceAccountRealmRolesClaim(newRoles.(*schema.Set)), func() error {
+		return syncServiceAccountRealmRoles(keycloakClient, serviceAccountUser, newRoles.(*schema.Set), func(name string) bool {
+			return exclusive || oldRoles.(*schema.Set).Contains(name)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakOpenidClientServiceAccountRealmRolesRead(data, meta)
+}
+
+func resourceKeycloakOpenidClientServiceAccountRealmRolesDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	managed := data.Get("roles").(*schema.Set)
+
+	keycloakClient.ReleaseUserRoles(realmId, data.Get("service_account_user_id").(string), serviceAccountRealmRolesClaim(managed))
+
+	serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(realmId, clientId)
+	if err != nil {
+		// the service account's roles were deleted along with the client, so there's nothing left to do
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return syncServiceAccountRealmRoles(keycloakClient, serviceAccountUser, schema.NewSet(schema.HashString, nil), func(name string) bool {
+		return managed.Contains(name)
+	})
+}
+
+func resourceKeycloakOpenidClientServiceAccountRealmRolesImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{clientId}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("client_id", parts[1])
+	d.Set("exclusive", true)
+
+	d.SetId(openidClientServiceAccountRealmRolesId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
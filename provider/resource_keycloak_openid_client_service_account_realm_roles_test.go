@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestAccKeycloakOpenidClientServiceAccountRealmRoles_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+	roleOne := "terraform-role-" + acctest.RandString(10)
+	roleTwo := "terraform-role-" + acctest.RandString(10)
+	resourceName := "keycloak_openid_client_service_account_realm_roles.roles"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientServiceAccountRealmRoles_basic(realmName, clientId, roleOne, roleTwo, []string{roleOne, roleTwo}, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "service_account_user_id", "keycloak_openid_client.client", "service_account_user_id"),
+					testAccCheckKeycloakOpenidClientServiceAccountHasRealmRoles(resourceName, []string{roleOne, roleTwo}, true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakOpenidClientServiceAccountRealmRoles_basic(realmName, clientId, roleOne, roleTwo, []string{roleTwo}, true),
+				Check:  testAccCheckKeycloakOpenidClientServiceAccountHasRealmRoles(resourceName, []string{roleTwo}, true),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientServiceAccountRealmRoles_nonExclusive(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+	roleOne := "terraform-role-" + acctest.RandString(10)
+	roleTwo := "terraform-role-" + acctest.RandString(10)
+	resourceName := "keycloak_openid_client_service_account_realm_roles.roles"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientServiceAccountRealmRoles_basic(realmName, clientId, roleOne, roleTwo, []string{roleOne}, false),
+				Check: resource.ComposeTestCheckFunc(
+					// keycloak assigns the realm's default roles to every service account, which shouldn't show up in state
+					testAccCheckKeycloakOpenidClientServiceAccountHasRealmRoles(resourceName, []string{roleOne}, false),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientServiceAccountRealmRoles_basic(realmName, clientId, roleOne, roleTwo, []string{roleTwo}, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientServiceAccountHasRealmRoles(resourceName, []string{roleTwo}, false),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// when `exact` is false, the service account may have other realm roles in addition to `roleNames`
+// an exclusive resource manages every realm role of the service account user, so it conflicts with an exclusive
+// keycloak_user_roles resource for the same user just like two keycloak_user_roles resources do
+func TestKeycloakOpenidClientServiceAccountRealmRoles_conflictsWithExclusiveUserRoles(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/clients/client-id/service-account-user":
+			w.Write([]byte(`{"id": "service-account-id", "username": "service-account-client"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	err := claimExclusiveUserRoles(keycloakClient, "test", "service-account-id", "", userRolesClaim(schema.NewSet(schema.HashString, []interface{}{"role-a"})))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakOpenidClientServiceAccountRealmRoles().Schema, map[string]interface{}{
+		"realm_id":  "test",
+		"client_id": "client-id",
+		"roles":     []interface{}{"b"},
+	})
+
+	err = resourceKeycloakOpenidClientServiceAccountRealmRolesCreate(data, keycloakClient)
+	if err == nil || !strings.Contains(err.Error(), "more than one resource with exclusive = true") {
+		t.Fatalf("expected an error when an exclusive keycloak_user_roles resource manages the same user, got %v", err)
+	}
+}
+
+func testAccCheckKeycloakOpenidClientServiceAccountHasRealmRoles(resourceName string, roleNames []string, exact bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		roleMappings, err := keycloakClient.GetUserRoleMappings(rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["service_account_user_id"])
+		if err != nil {
+			return err
+		}
+
+		var remoteRoleNames []string
+		assigned := make(map[string]bool)
+		for _, role := range roleMappings.RealmMappings {
+			remoteRoleNames = append(remoteRoleNames, role.Name)
+			assigned[role.Name] = true
+		}
+
+		sort.Strings(remoteRoleNames)
+
+		for _, roleName := range roleNames {
+			if !assigned[roleName] {
+				return fmt.Errorf("expected service account to have realm role %s, but it has %s", roleName, strings.Join(remoteRoleNames, ", "))
+			}
+		}
+
+		if exact && len(remoteRoleNames) != len(roleNames) {
+			return fmt.Errorf("expected service account to only have realm roles %s, but it has %s", strings.Join(roleNames, ", "), strings.Join(remoteRoleNames, ", "))
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenidClientServiceAccountRealmRoles_basic(realmName, clientId, roleOne, roleTwo string, roles []string, exclusive bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                = "%s"
+	realm_id                 = "${keycloak_realm.realm.id}"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+resource "keycloak_role" "role_one" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_role" "role_two" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_openid_client_service_account_realm_roles" "roles" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.client.id}"
+	roles     = ["%s"]
+	exclusive = %t
+
+	depends_on = [
+		"keycloak_role.role_one",
+		"keycloak_role.role_two",
+	]
+}
+	`, realmName, clientId, roleOne, roleTwo, strings.Join(roles, `", "`), exclusive)
+}
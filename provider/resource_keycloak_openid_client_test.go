@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -289,6 +290,58 @@ func TestAccKeycloakOpenidClient_bearerClientNoGrantsValidation(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClient_authorizationServicesRequireServiceAccounts(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_authorization(realmName, clientId, false),
+				ExpectError: regexp.MustCompile("validation error: authorization services require service accounts to be enabled"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_authorization(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_basic(realmName, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "authorization_services_enabled", "false"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "resource_server_id", ""),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_authorization(realmName, clientId, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "authorization_services_enabled", "true"),
+					resource.TestCheckResourceAttrPair("keycloak_openid_client.client", "resource_server_id", "keycloak_openid_client.client", "id"),
+					resource.TestCheckResourceAttrPair("keycloak_openid_client_authorization_scope.scope", "resource_server_id", "keycloak_openid_client.client", "id"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_basic(realmName, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "authorization_services_enabled", "false"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "resource_server_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClient_pkceCodeChallengeMethod(t *testing.T) {
 	realmName := "terraform-" + acctest.RandString(10)
 	clientId := "terraform-" + acctest.RandString(10)
@@ -334,6 +387,77 @@ func TestAccKeycloakOpenidClient_pkceCodeChallengeMethod(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClient_pkceCodeChallengeMethodS256RoundTrips(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_pkceChallengeMethod(realmName, clientId, "S256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasPkceCodeChallengeMethod("keycloak_openid_client.client", "S256"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "pkce_code_challenge_method", "S256"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_openid_client.client",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+			// applying the same config again shouldn't show a diff
+			{
+				Config:   testKeycloakOpenidClient_pkceChallengeMethod(realmName, clientId, "S256"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_attributes(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+	resourceName := "keycloak_openid_client.client"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_attributes(realmName, clientId, "5m", "https://example.com/logout", "keycloak"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "access.token.lifespan", "300"),
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "backchannel.logout.url", "https://example.com/logout"),
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "login_theme", "keycloak"),
+					resource.TestCheckResourceAttr(resourceName, "client_session_idle_timeout", "30m0s"),
+					resource.TestCheckResourceAttr(resourceName, "extra_config.%", "1"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_attributes(realmName, clientId, "1h", "", "base"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "access.token.lifespan", "3600"),
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "backchannel.logout.url", ""),
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "login_theme", "base"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_basic(realmName, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "access.token.lifespan", ""),
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "login_theme", ""),
+					resource.TestCheckResourceAttr(resourceName, "extra_config.%", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClient_excludeSessionStateFromAuthResponse(t *testing.T) {
 	realmName := "terraform-" + acctest.RandString(10)
 	clientId := "terraform-" + acctest.RandString(10)
@@ -375,6 +499,71 @@ func TestAccKeycloakOpenidClient_excludeSessionStateFromAuthResponse(t *testing.
 	})
 }
 
+func TestAccKeycloakOpenidClient_authenticationFlowBindingOverrides(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_authenticationFlowBindingOverrides(realmName, clientId),
+				Check:  testAccCheckKeycloakOpenidClientAuthenticationFlowBindingOverrides("keycloak_openid_client.client", "keycloak_authentication_flow.browser", "keycloak_authentication_flow.direct_grant"),
+			},
+			{
+				ResourceName:            "keycloak_openid_client.client",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     realmName + "/",
+				ImportStateVerifyIgnore: []string{"exclude_session_state_from_auth_response"},
+			},
+			{
+				Config: testKeycloakOpenidClient_authenticationFlowBindingOverridesRemoved(realmName, clientId),
+				Check:  testAccCheckKeycloakOpenidClientAuthenticationFlowBindingOverrides("keycloak_openid_client.client", "", ""),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_consent(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+	resourceName := "keycloak_openid_client.client"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_consent(realmName, clientId, true, "Access to your profile"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientConsentRequired(resourceName, true),
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "display.on.consent.screen", "true"),
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "consent.screen.text", "Access to your profile"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     realmName + "/",
+				ImportStateVerifyIgnore: []string{"exclude_session_state_from_auth_response"},
+			},
+			{
+				Config: testKeycloakOpenidClient_consent(realmName, clientId, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientConsentRequired(resourceName, false),
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "display.on.consent.screen", ""),
+					testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "consent.screen.text", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getOpenidClientFromState(s, resourceName)
@@ -490,6 +679,34 @@ func testAccCheckKeycloakOpenidClientDestroy() resource.TestCheckFunc {
 	}
 }
 
+// checks the raw value of an attribute, whether it has an argument of its own or is managed by `extra_config`
+func testAccCheckKeycloakOpenidClientHasAttribute(resourceName, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		attributes, err := json.Marshal(client.Attributes)
+		if err != nil {
+			return err
+		}
+
+		var attributeValues map[string]interface{}
+
+		err = json.Unmarshal(attributes, &attributeValues)
+		if err != nil {
+			return err
+		}
+
+		if actual, _ := attributeValues[key].(string); actual != value {
+			return fmt.Errorf("expected openid client %s to have attribute %s with value %q, but got %q", client.ClientId, key, value, actual)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOpenidClientHasPkceCodeChallengeMethod(resourceName, pkceCodeChallengeMethod string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getOpenidClientFromState(s, resourceName)
@@ -520,6 +737,60 @@ func testAccCheckKeycloakOpenidClientHasExcludeSessionStateFromAuthResponse(reso
 	}
 }
 
+func testAccCheckKeycloakOpenidClientConsentRequired(resourceName string, consentRequired bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.ConsentRequired != consentRequired {
+			return fmt.Errorf("expected openid client %s to have consent_required value of %t, but got %t", client.ClientId, consentRequired, client.ConsentRequired)
+		}
+
+		return nil
+	}
+}
+
+// `browserFlowResourceName` and `directGrantFlowResourceName` can be left empty to check that the client has no override for that flow
+func testAccCheckKeycloakOpenidClientAuthenticationFlowBindingOverrides(resourceName, browserFlowResourceName, directGrantFlowResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		flowId := func(flowResourceName string) (string, error) {
+			if flowResourceName == "" {
+				return "", nil
+			}
+
+			rs, ok := s.RootModule().Resources[flowResourceName]
+			if !ok {
+				return "", fmt.Errorf("resource not found: %s", flowResourceName)
+			}
+
+			return rs.Primary.ID, nil
+		}
+
+		browserId, err := flowId(browserFlowResourceName)
+		if err != nil {
+			return err
+		}
+
+		directGrantId, err := flowId(directGrantFlowResourceName)
+		if err != nil {
+			return err
+		}
+
+		if overrides := client.AuthenticationFlowBindingOverrides; overrides.BrowserId != browserId || overrides.DirectGrantId != directGrantId {
+			return fmt.Errorf("expected openid client %s to have browser flow override %q and direct grant flow override %q, but got %q and %q", client.ClientId, browserId, directGrantId, overrides.BrowserId, overrides.DirectGrantId)
+		}
+
+		return nil
+	}
+}
+
 func getOpenidClientFromState(s *terraform.State, resourceName string) (*keycloak.OpenidClient, error) {
 	keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
 
@@ -553,6 +824,84 @@ resource "keycloak_openid_client" "client" {
 	`, realm, clientId)
 }
 
+func testKeycloakOpenidClient_authorization(realm, clientId string, serviceAccountsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                = "%s"
+	realm_id                 = "${keycloak_realm.realm.id}"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = %t
+
+	authorization {
+		policy_enforcement_mode = "ENFORCING"
+	}
+}
+
+resource "keycloak_openid_client_authorization_scope" "scope" {
+	resource_server_id = "${keycloak_openid_client.client.resource_server_id}"
+	realm_id           = "${keycloak_realm.realm.id}"
+	name               = "scope"
+}
+	`, realm, clientId, serviceAccountsEnabled)
+}
+
+func testKeycloakOpenidClient_authenticationFlowBindingOverrides(realm, clientId string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "browser" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "custom-browser"
+}
+
+resource "keycloak_authentication_flow" "direct_grant" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "custom-direct-grant"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "CONFIDENTIAL"
+
+	authentication_flow_binding_overrides {
+		browser_id      = "${keycloak_authentication_flow.browser.id}"
+		direct_grant_id = "${keycloak_authentication_flow.direct_grant.id}"
+	}
+}
+	`, realm, clientId)
+}
+
+func testKeycloakOpenidClient_authenticationFlowBindingOverridesRemoved(realm, clientId string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "browser" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "custom-browser"
+}
+
+resource "keycloak_authentication_flow" "direct_grant" {
+	realm_id = "${keycloak_realm.realm.id}"
+	alias    = "custom-direct-grant"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "CONFIDENTIAL"
+}
+	`, realm, clientId)
+}
+
 func testKeycloakOpenidClient_accessType(realm, clientId, accessType string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -583,6 +932,48 @@ resource "keycloak_openid_client" "client" {
 	`, realm, clientId, pkceChallengeMethod)
 }
 
+func testKeycloakOpenidClient_attributes(realm, clientId, accessTokenLifespan, backchannelLogoutUrl, loginTheme string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "CONFIDENTIAL"
+
+	access_token_lifespan       = "%s"
+	client_session_idle_timeout = "30m"
+	backchannel_logout_url      = "%s"
+
+	extra_config = {
+		"login_theme" = "%s"
+	}
+}
+	`, realm, clientId, accessTokenLifespan, backchannelLogoutUrl, loginTheme)
+}
+
+func testKeycloakOpenidClient_consent(realm, clientId string, consent bool, consentScreenText string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id             = "%s"
+	realm_id              = "${keycloak_realm.realm.id}"
+	access_type           = "PUBLIC"
+	standard_flow_enabled = true
+	valid_redirect_uris   = ["https://example.com/callback"]
+
+	consent_required          = %t
+	display_on_consent_screen = %t
+	consent_screen_text       = "%s"
+}
+	`, realm, clientId, consent, consent, consentScreenText)
+}
+
 func testKeycloakOpenidClient_excludeSessionStateFromAuthResponse(realm, clientId string, excludeSessionStateFromAuthResponse bool) string {
 
 	return fmt.Sprintf(`
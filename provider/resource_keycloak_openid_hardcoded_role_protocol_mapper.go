@@ -46,8 +46,16 @@ func resourceKeycloakOpenIdHardcodedRoleProtocolMapper() *schema.Resource {
 				ConflictsWith: []string{"client_id"},
 			},
 			"role_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The ID of the role to map. Cannot be used at the same time as role.",
+				ConflictsWith: []string{"role"},
+			},
+			"role": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The name of the role to map, in the format <clientId>.<roleName> for client roles. Cannot be used at the same time as role_id.",
+				ConflictsWith: []string{"role_id"},
 			},
 		},
 	}
@@ -62,6 +70,7 @@ func mapFromDataToOpenIdHardcodedRoleProtocolMapper(data *schema.ResourceData) *
 		ClientScopeId: data.Get("client_scope_id").(string),
 
 		RoleId: data.Get("role_id").(string),
+		Role:   data.Get("role").(string),
 	}
 }
 
@@ -76,7 +85,12 @@ func mapFromOpenIdHardcodedRoleMapperToData(mapper *keycloak.OpenIdHardcodedRole
 		data.Set("client_scope_id", mapper.ClientScopeId)
 	}
 
-	data.Set("role_id", mapper.RoleId)
+	// only one of these is part of the configuration, so only that one is tracked. imported mappers use `role_id`
+	if data.Get("role").(string) != "" {
+		data.Set("role", mapper.Role)
+	} else {
+		data.Set("role_id", mapper.RoleId)
+	}
 }
 
 func resourceKeycloakOpenIdHardcodedRoleProtocolMapperCreate(data *schema.ResourceData, meta interface{}) error {
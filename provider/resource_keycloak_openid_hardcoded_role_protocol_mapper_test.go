@@ -73,6 +73,39 @@ func TestAccKeycloakOpenIdHardcodedRoleProtocolMapper_basicRealmRole_clientScope
 	})
 }
 
+func TestAccKeycloakOpenIdHardcodedRoleProtocolMapper_roleName_clientScope(t *testing.T) {
+	realmName := "terraform-realm-" + acctest.RandString(10)
+	clientIdForRole := "terraform-client-" + acctest.RandString(10)
+	role := "terraform-role-" + acctest.RandString(10)
+	clientScopeId := "terraform-client-scope-" + acctest.RandString(10)
+	mapperName := "terraform-openid-connect-hardcoded-role-mapper-" + acctest.RandString(5)
+
+	resourceName := "keycloak_openid_hardcoded_role_protocol_mapper.hardcoded_role_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKeycloakOpenIdHardcodedRoleProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdHardcodedRoleProtocolMapper_roleName_clientScope(realmName, clientIdForRole, role, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdHardcodedRoleProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", clientIdForRole+"."+role),
+					resource.TestCheckResourceAttr(resourceName, "role_id", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       getGenericProtocolMapperIdForClientScope(resourceName),
+				ImportStateVerifyIgnore: []string{"role", "role_id"},
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenIdHardcodedRoleProtocolMapper_import(t *testing.T) {
 	realmName := "terraform-realm-" + acctest.RandString(10)
 	role := "terraform-role-" + acctest.RandString(10)
@@ -418,3 +451,35 @@ resource "keycloak_openid_hardcoded_role_protocol_mapper" "hardcoded_role_mapper
 	role_id        = "${keycloak_role.role.id}"
 }`, realmName, clientIdForRole, role, clientId, mapperName)
 }
+
+func testKeycloakOpenIdHardcodedRoleProtocolMapper_roleName_clientScope(realmName, clientIdForRole, role, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client_for_role" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_role" "role" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.openid_client_for_role.id}"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_openid_hardcoded_role_protocol_mapper" "hardcoded_role_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = "${keycloak_realm.realm.id}"
+	client_scope_id = "${keycloak_openid_client_scope.client_scope.id}"
+	role            = "${keycloak_openid_client.openid_client_for_role.client_id}.${keycloak_role.role.name}"
+}`, realmName, clientIdForRole, role, clientScopeId, mapperName)
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+func resourceKeycloakOrganization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakOrganizationCreate,
+		Read:   resourceKeycloakOrganizationRead,
+		Update: resourceKeycloakOrganizationUpdate,
+		Delete: resourceKeycloakOrganizationDelete,
+		// This resource can be imported using {{realm}}/{{organizationId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakOrganizationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// keycloak uses the name when an alias isn't given, and doesn't allow it to be changed afterwards
+			"alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domains": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"verified": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func mapFromDataToOrganization(data *schema.ResourceData) *keycloak.Organization {
+	var domains []keycloak.OrganizationDomain
+	for _, d := range data.Get("domains").(*schema.Set).List() {
+		domain := d.(map[string]interface{})
+
+		domains = append(domains, keycloak.OrganizationDomain{
+			Name:     domain["name"].(string),
+			Verified: domain["verified"].(bool),
+		})
+	}
+
+	attributes := map[string][]string{}
+	if v, ok := data.GetOk("attributes"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			attributes[key] = splitLen(value.(string), MAX_ATTRIBUTE_VALUE_LEN)
+		}
+	}
+
+	return &keycloak.Organization{
+		Id:          data.Id(),
+		RealmId:     data.Get("realm_id").(string),
+		Name:        data.Get("name").(string),
+		Alias:       data.Get("alias").(string),
+		Enabled:     data.Get("enabled").(bool),
+		Description: data.Get("description").(string),
+		Domains:     domains,
+		Attributes:  attributes,
+	}
+}
+
+func mapFromOrganizationToData(data *schema.ResourceData, organization *keycloak.Organization) {
+	var domains []interface{}
+	for _, domain := range organization.Domains {
+		domains = append(domains, map[string]interface{}{
+			"name":     domain.Name,
+			"verified": domain.Verified,
+		})
+	}
+
+	attributes := map[string]string{}
+	for k, v := range organization.Attributes {
+		attributes[k] = strings.Join(v, "")
+	}
+
+	data.SetId(organization.Id)
+	data.Set("realm_id", organization.RealmId)
+	data.Set("name", organization.Name)
+	data.Set("alias", organization.Alias)
+	data.Set("enabled", organization.Enabled)
+	data.Set("description", organization.Description)
+	data.Set("domains", domains)
+	data.Set("attributes", attributes)
+}
+
+func resourceKeycloakOrganizationCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.ValidateOrganizationsSupported()
+	if err != nil {
+		return err
+	}
+
+	organization := mapFromDataToOrganization(data)
+
+	err = keycloakClient.NewOrganization(organization)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(organization.Id)
+
+	return resourceKeycloakOrganizationRead(data, meta)
+}
+
+func resourceKeycloakOrganizationRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	organization, err := keycloakClient.GetOrganization(data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	mapFromOrganizationToData(data, organization)
+
+	return nil
+}
+
+func resourceKeycloakOrganizationUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.UpdateOrganization(mapFromDataToOrganization(data))
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakOrganizationRead(data, meta)
+}
+
+func resourceKeycloakOrganizationDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return keycloakClient.DeleteOrganization(data.Get("realm_id").(string), data.Id())
+}
+
+func resourceKeycloakOrganizationImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{organizationId}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+func resourceKeycloakOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakOrganizationMembersCreate,
+		Read:   resourceKeycloakOrganizationMembersRead,
+		Update: resourceKeycloakOrganizationMembersUpdate,
+		Delete: resourceKeycloakOrganizationMembersDelete,
+		// This resource can be imported using {{realm}}/{{organizationId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakOrganizationMembersImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Required: true,
+			},
+			// when false, members added to the organization outside of terraform are left alone
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func organizationMembersId(realmId, organizationId string) string {
+	return fmt.Sprintf("%s/%s", realmId, organizationId)
+}
+
+func getOrganizationMemberIds(keycloakClient *keycloak.KeycloakClient, realmId, organizationId string) ([]string, error) {
+	members, err := keycloakClient.GetOrganizationMembers(realmId, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var userIds []string
+	for _, member := range members {
+		userIds = append(userIds, member.Id)
+	}
+
+	return userIds, nil
+}
+
+func addUsersToOrganization(keycloakClient *keycloak.KeycloakClient, realmId, organizationId string, userIds []string) error {
+	for _, userId := range userIds {
+		err := keycloakClient.AddUserToOrganization(realmId, organizationId, userId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func removeUsersFromOrganization(keycloakClient *keycloak.KeycloakClient, realmId, organizationId string, userIds []string) error {
+	for _, userId := range userIds {
+		err := keycloakClient.RemoveUserFromOrganization(realmId, organizationId, userId)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// adds the users in `wanted` that aren't members yet, then removes any other members for which `shouldRemove` returns true
+func syncOrganizationMembers(keycloakClient *keycloak.KeycloakClient, realmId, organizationId string, wanted *schema.Set, shouldRemove func(userId string) bool) error {
+	memberIds, err := getOrganizationMemberIds(keycloakClient, realmId, organizationId)
+	if err != nil {
+		return err
+	}
+
+	members := make(map[string]bool)
+	var userIdsToRemove []string
+	for _, memberId := range memberIds {
+		members[memberId] = true
+
+		if !wanted.Contains(memberId) && shouldRemove(memberId) {
+			userIdsToRemove = append(userIdsToRemove, memberId)
+		}
+	}
+
+	var userIdsToAdd []string
+	for _, userId := range interfaceSliceToStringSlice(wanted.List()) {
+		if !members[userId] {
+			userIdsToAdd = append(userIdsToAdd, userId)
+		}
+	}
+
+	err = addUsersToOrganization(keycloakClient, realmId, organizationId, userIdsToAdd)
+	if err != nil {
+		return err
+	}
+
+	return removeUsersFromOrganization(keycloakClient, realmId, organizationId, userIdsToRemove)
+}
+
+func resourceKeycloakOrganizationMembersCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.ValidateOrganizationsSupported()
+	if err != nil {
+		return err
+	}
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	exclusive := data.Get("exclusive").(bool)
+
+	err = syncOrganizationMembers(keycloakClient, realmId, organizationId, data.Get("user_ids").(*schema.Set), func(string) bool {
+		return exclusive
+	})
+	if err != nil {
+		return err
+	}
+
+	data.SetId(organizationMembersId(realmId, organizationId))
+
+	return resourceKeycloakOrganizationMembersRead(data, meta)
+}
+
+func resourceKeycloakOrganizationMembersRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+
+	userIds, err := getOrganizationMemberIds(keycloakClient, realmId, organizationId)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	// when this resource isn't exclusive, only track the members that it manages so other members don't cause drift
+	if !data.Get("exclusive").(bool) {
+		userIds = filterToManagedIds(userIds, data.Get("user_ids").(*schema.Set))
+	}
+
+	data.Set("user_ids", userIds)
+	data.SetId(organizationMembersId(realmId, organizationId))
+
+	return nil
+}
+
+func resourceKeycloakOrganizationMembersUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	exclusive := data.Get("exclusive").(bool)
+
+	// when this resource isn't exclusive, only members that were removed from `user_ids` are removed from the organization
+	oldUserIds, newUserIds := data.GetChange("user_ids")
+
+	err := syncOrganizationMembers(keycloakClient, realmId, organizationId, newUserIds.(*schema.Set), func(userId string) bool {
+		return exclusive || oldUserIds.(*schema.Set).Contains(userId)
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakOrganizationMembersRead(data, meta)
+}
+
+func resourceKeycloakOrganizationMembersDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+
+	return removeUsersFromOrganization(keycloakClient, realmId, organizationId, interfaceSliceToStringSlice(data.Get("user_ids").(*schema.Set).List()))
+}
+
+func resourceKeycloakOrganizationMembersImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{organizationId}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("organization_id", parts[1])
+	d.Set("exclusive", true)
+
+	d.SetId(organizationMembersId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+// organizations were added in keycloak 25
+const organizationMinimumKeycloakVersion = 25
+
+func TestAccKeycloakOrganization_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	organizationName := "terraform-organization-" + acctest.RandString(10)
+	resourceName := "keycloak_organization.organization"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckKeycloakVersion(t, organizationMinimumKeycloakVersion)
+		},
+		CheckDestroy: testAccCheckKeycloakOrganizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganization_basic(realmName, organizationName, "example.com", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOrganizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias", organizationName),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+			{
+				Config: testKeycloakOrganization_basic(realmName, organizationName, "example.org", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOrganizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOrganizationMembers_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	organizationName := "terraform-organization-" + acctest.RandString(10)
+	resourceName := "keycloak_organization_members.members"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckKeycloakVersion(t, organizationMinimumKeycloakVersion)
+		},
+		CheckDestroy: testAccCheckKeycloakOrganizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganizationMembers_basic(realmName, organizationName, 2),
+				Check:  resource.TestCheckResourceAttr(resourceName, "user_ids.#", "2"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakOrganizationMembers_basic(realmName, organizationName, 1),
+				Check:  resource.TestCheckResourceAttr(resourceName, "user_ids.#", "1"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOrganizationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getOrganizationFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakOrganizationDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_organization" {
+				continue
+			}
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			organization, _ := keycloakClient.GetOrganization(rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if organization != nil {
+				return fmt.Errorf("organization with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getOrganizationFromState(s *terraform.State, resourceName string) (*keycloak.Organization, error) {
+	keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	organization, err := keycloakClient.GetOrganization(rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting organization with id %s: %s", rs.Primary.ID, err)
+	}
+
+	return organization, nil
+}
+
+func testKeycloakOrganization_basic(realmName, organizationName, domain string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	attributes = {
+		organizationsEnabled = "true"
+	}
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+	enabled  = %t
+
+	domains {
+		name = "%s"
+	}
+
+	attributes = {
+		foo = "bar"
+	}
+}
+	`, realmName, organizationName, enabled, domain)
+}
+
+func testKeycloakOrganizationMembers_basic(realmName, organizationName string, userCount int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	attributes = {
+		organizationsEnabled = "true"
+	}
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+
+	domains {
+		name = "example.com"
+	}
+}
+
+resource "keycloak_user" "user" {
+	count    = %d
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "user-${count.index}"
+}
+
+resource "keycloak_organization_members" "members" {
+	realm_id        = "${keycloak_realm.realm.id}"
+	organization_id = "${keycloak_organization.organization.id}"
+
+	user_ids = "${keycloak_user.user.*.id}"
+}
+	`, realmName, organizationName, userCount)
+}
@@ -2,9 +2,86 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 )
 
+var (
+	keycloakRealmOtpPolicyTypes      = []string{"totp", "hotp"}
+	keycloakRealmOtpPolicyAlgorithms = []string{"HmacSHA1", "HmacSHA256", "HmacSHA512"}
+
+	keycloakRealmWebAuthnPolicySignatureAlgorithms              = []string{"ES256", "ES384", "ES512", "RS256", "RS384", "RS512", "RS1", "PS256", "PS384", "PS512", "Ed25519"}
+	keycloakRealmWebAuthnPolicyAttestationConveyancePreferences = []string{"not specified", "none", "indirect", "direct"}
+	keycloakRealmWebAuthnPolicyAuthenticatorAttachments         = []string{"not specified", "platform", "cross-platform"}
+	keycloakRealmWebAuthnPolicyRequireResidentKeys              = []string{"not specified", "Yes", "No"}
+	keycloakRealmWebAuthnPolicyUserVerificationRequirements     = []string{"not specified", "required", "preferred", "discouraged"}
+)
+
+// the `web_authn_policy` and `web_authn_passwordless_policy` blocks have the same arguments
+func realmWebAuthnPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"rp_entity_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "keycloak",
+		},
+		"signature_algorithms": { // the default depends on the keycloak version
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(keycloakRealmWebAuthnPolicySignatureAlgorithms, false),
+			},
+		},
+		"rp_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"attestation_conveyance_preference": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "not specified",
+			ValidateFunc: validation.StringInSlice(keycloakRealmWebAuthnPolicyAttestationConveyancePreferences, false),
+		},
+		"authenticator_attachment": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "not specified",
+			ValidateFunc: validation.StringInSlice(keycloakRealmWebAuthnPolicyAuthenticatorAttachments, false),
+		},
+		"require_resident_key": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "not specified",
+			ValidateFunc: validation.StringInSlice(keycloakRealmWebAuthnPolicyRequireResidentKeys, false),
+		},
+		"user_verification_requirement": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "not specified",
+			ValidateFunc: validation.StringInSlice(keycloakRealmWebAuthnPolicyUserVerificationRequirements, false),
+		},
+		"create_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"avoid_same_authenticator_register": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"acceptable_aaguids": {
+			Type:     schema.TypeSet,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+			Optional: true,
+		},
+	}
+}
+
 func resourceKeycloakRealm() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceKeycloakRealmCreate,
@@ -365,47 +442,128 @@ func resourceKeycloakRealm() *schema.Resource {
 				},
 			},
 			"password_policy": {
-				Type:        schema.TypeString,
-				Description: "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
-				Optional:    true,
+				Type:          schema.TypeString,
+				Description:   "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
+				Optional:      true,
+				ConflictsWith: []string{"password_policy_settings"},
+			},
+			// a structured alternative to `password_policy`, which is compared policy by policy instead of as a string
+			"password_policy_settings": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"password_policy"},
+				Elem: &schema.Resource{
+					Schema: realmPasswordPolicySettingsSchema(),
+				},
+			},
+
+			"otp_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "totp",
+							ValidateFunc: validation.StringInSlice(keycloakRealmOtpPolicyTypes, false),
+						},
+						"algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "HmacSHA1",
+							ValidateFunc: validation.StringInSlice(keycloakRealmOtpPolicyAlgorithms, false),
+						},
+						"digits": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntInSlice([]int{6, 8}),
+						},
+						"period": { // only used by totp
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"look_ahead_window": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"initial_counter": { // only used by hotp
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"supported_applications": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"web_authn_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: realmWebAuthnPolicySchema(),
+				},
+			},
+			"web_authn_passwordless_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: realmWebAuthnPolicySchema(),
+				},
 			},
 
-			//flow bindings
+			// flow bindings. these may be managed by a `keycloak_authentication_bindings` resource instead, so the bindings
+			// that aren't set here are left alone
 			"browser_flow": {
 				Type:        schema.TypeString,
 				Description: "Which flow should be used for BrowserFlow",
 				Optional:    true,
-				Default:     "browser",
+				Computed:    true,
 			},
 			"registration_flow": {
 				Type:        schema.TypeString,
 				Description: "Which flow should be used for RegistrationFlow",
 				Optional:    true,
-				Default:     "registration",
+				Computed:    true,
 			},
 			"direct_grant_flow": {
 				Type:        schema.TypeString,
 				Description: "Which flow should be used for DirectGrantFlow",
 				Optional:    true,
-				Default:     "direct grant",
+				Computed:    true,
 			},
 			"reset_credentials_flow": {
 				Type:        schema.TypeString,
 				Description: "Which flow should be used for ResetCredentialsFlow",
 				Optional:    true,
-				Default:     "reset credentials",
+				Computed:    true,
 			},
 			"client_authentication_flow": {
 				Type:        schema.TypeString,
 				Description: "Which flow should be used for ClientAuthenticationFlow",
 				Optional:    true,
-				Default:     "clients",
+				Computed:    true,
 			},
 			"docker_authentication_flow": {
 				Type:        schema.TypeString,
 				Description: "Which flow should be used for DockerAuthenticationFlow",
 				Optional:    true,
-				Default:     "docker auth",
+				Computed:    true,
 			},
 			"attributes": {
 				Type:     schema.TypeMap,
@@ -657,6 +815,58 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 		realm.PasswordPolicy = passwordPolicy.(string)
 	}
 
+	if v, ok := data.GetOk("password_policy_settings"); ok {
+		// an empty block is read as a nil element, which means there aren't any policies
+		if settings, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			realm.PasswordPolicy = getRealmPasswordPolicyFromSettings(settings)
+		}
+	}
+
+	//otp policy
+	if v, ok := data.GetOk("otp_policy"); ok {
+		otpPolicySettings := v.([]interface{})[0].(map[string]interface{})
+
+		realm.OtpPolicyType = otpPolicySettings["type"].(string)
+		realm.OtpPolicyAlgorithm = otpPolicySettings["algorithm"].(string)
+		realm.OtpPolicyDigits = otpPolicySettings["digits"].(int)
+		realm.OtpPolicyPeriod = otpPolicySettings["period"].(int)
+		realm.OtpPolicyLookAheadWindow = otpPolicySettings["look_ahead_window"].(int)
+		realm.OtpPolicyInitialCounter = otpPolicySettings["initial_counter"].(int)
+	} else {
+		setDefaultOtpPolicy(realm)
+	}
+
+	//webauthn policies. when they're omitted, nothing is sent and keycloak uses its defaults
+	if v, ok := data.GetOk("web_authn_policy"); ok {
+		webAuthnPolicySettings := v.([]interface{})[0].(map[string]interface{})
+
+		realm.WebAuthnPolicyRpEntityName = webAuthnPolicySettings["rp_entity_name"].(string)
+		realm.WebAuthnPolicySignatureAlgorithms = interfaceSliceToStringSlice(webAuthnPolicySettings["signature_algorithms"].([]interface{}))
+		realm.WebAuthnPolicyRpId = webAuthnPolicySettings["rp_id"].(string)
+		realm.WebAuthnPolicyAttestationConveyancePreference = webAuthnPolicySettings["attestation_conveyance_preference"].(string)
+		realm.WebAuthnPolicyAuthenticatorAttachment = webAuthnPolicySettings["authenticator_attachment"].(string)
+		realm.WebAuthnPolicyRequireResidentKey = webAuthnPolicySettings["require_resident_key"].(string)
+		realm.WebAuthnPolicyUserVerificationRequirement = webAuthnPolicySettings["user_verification_requirement"].(string)
+		realm.WebAuthnPolicyCreateTimeout = webAuthnPolicySettings["create_timeout"].(int)
+		realm.WebAuthnPolicyAvoidSameAuthenticatorRegister = webAuthnPolicySettings["avoid_same_authenticator_register"].(bool)
+		realm.WebAuthnPolicyAcceptableAaguids = interfaceSliceToStringSlice(webAuthnPolicySettings["acceptable_aaguids"].(*schema.Set).List())
+	}
+
+	if v, ok := data.GetOk("web_authn_passwordless_policy"); ok {
+		webAuthnPolicySettings := v.([]interface{})[0].(map[string]interface{})
+
+		realm.WebAuthnPolicyPasswordlessRpEntityName = webAuthnPolicySettings["rp_entity_name"].(string)
+		realm.WebAuthnPolicyPasswordlessSignatureAlgorithms = interfaceSliceToStringSlice(webAuthnPolicySettings["signature_algorithms"].([]interface{}))
+		realm.WebAuthnPolicyPasswordlessRpId = webAuthnPolicySettings["rp_id"].(string)
+		realm.WebAuthnPolicyPasswordlessAttestationConveyancePreference = webAuthnPolicySettings["attestation_conveyance_preference"].(string)
+		realm.WebAuthnPolicyPasswordlessAuthenticatorAttachment = webAuthnPolicySettings["authenticator_attachment"].(string)
+		realm.WebAuthnPolicyPasswordlessRequireResidentKey = webAuthnPolicySettings["require_resident_key"].(string)
+		realm.WebAuthnPolicyPasswordlessUserVerificationRequirement = webAuthnPolicySettings["user_verification_requirement"].(string)
+		realm.WebAuthnPolicyPasswordlessCreateTimeout = webAuthnPolicySettings["create_timeout"].(int)
+		realm.WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister = webAuthnPolicySettings["avoid_same_authenticator_register"].(bool)
+		realm.WebAuthnPolicyPasswordlessAcceptableAaguids = interfaceSliceToStringSlice(webAuthnPolicySettings["acceptable_aaguids"].(*schema.Set).List())
+	}
+
 	//Flow Bindings
 	if flow, ok := data.GetOk("browser_flow"); ok {
 		realm.BrowserFlow = flow.(string)
@@ -716,6 +926,15 @@ func setDefaultSecuritySettingsBruteForceDetection(realm *keycloak.Realm) {
 	realm.MaxDeltaTimeSeconds = 43200
 }
 
+func setDefaultOtpPolicy(realm *keycloak.Realm) {
+	realm.OtpPolicyType = "totp"
+	realm.OtpPolicyAlgorithm = "HmacSHA1"
+	realm.OtpPolicyDigits = 6
+	realm.OtpPolicyPeriod = 30
+	realm.OtpPolicyLookAheadWindow = 1
+	realm.OtpPolicyInitialCounter = 0
+}
+
 func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	data.SetId(realm.Realm)
 
@@ -812,9 +1031,41 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 			securityDefensesSettings["brute_force_detection"] = []interface{}{getBruteForceDetectionSettings(realm)}
 			data.Set("security_defenses", []interface{}{securityDefensesSettings})
 		}
+	} else if realm.BruteForceProtected {
+		// brute force detection was enabled outside of terraform (or the realm is being imported), so it's read back
+		// in order to show up as a change. the headers always have a value, so they're only read when configured
+		securityDefensesSettings := make(map[string]interface{})
+		securityDefensesSettings["brute_force_detection"] = []interface{}{getBruteForceDetectionSettings(realm)}
+		data.Set("security_defenses", []interface{}{securityDefensesSettings})
+	}
+
+	// the policies are only read into the block when it's used, so `password_policy` keeps working for everyone else
+	if _, ok := data.GetOk("password_policy_settings"); ok {
+		data.Set("password_policy", "")
+		data.Set("password_policy_settings", []interface{}{getRealmPasswordPolicySettings(realm.PasswordPolicy)})
+	} else {
+		data.Set("password_policy", realm.PasswordPolicy)
+		data.Set("password_policy_settings", nil)
+	}
+
+	// like brute force detection, the otp policy is read back when it was changed outside of terraform even if it isn't
+	// configured
+	defaultOtpPolicy := &keycloak.Realm{}
+	setDefaultOtpPolicy(defaultOtpPolicy)
+	if _, ok := data.GetOk("otp_policy"); ok || !isDefaultOtpPolicy(realm, defaultOtpPolicy) {
+		data.Set("otp_policy", []interface{}{getOtpPolicySettings(realm)})
+	} else {
+		data.Set("otp_policy", nil)
+	}
+
+	// keycloak's defaults for the webauthn policies depend on its version, so they're only read back when configured
+	if _, ok := data.GetOk("web_authn_policy"); ok {
+		data.Set("web_authn_policy", []interface{}{getWebAuthnPolicySettings(realm)})
 	}
 
-	data.Set("password_policy", realm.PasswordPolicy)
+	if _, ok := data.GetOk("web_authn_passwordless_policy"); ok {
+		data.Set("web_authn_passwordless_policy", []interface{}{getWebAuthnPasswordlessPolicySettings(realm)})
+	}
 
 	//Flow Bindings
 	data.Set("browser_flow", realm.BrowserFlow)
@@ -846,6 +1097,57 @@ func getBruteForceDetectionSettings(realm *keycloak.Realm) map[string]interface{
 	return bruteForceDetectionSettings
 }
 
+func getOtpPolicySettings(realm *keycloak.Realm) map[string]interface{} {
+	otpPolicySettings := make(map[string]interface{})
+	otpPolicySettings["type"] = realm.OtpPolicyType
+	otpPolicySettings["algorithm"] = realm.OtpPolicyAlgorithm
+	otpPolicySettings["digits"] = realm.OtpPolicyDigits
+	otpPolicySettings["period"] = realm.OtpPolicyPeriod
+	otpPolicySettings["look_ahead_window"] = realm.OtpPolicyLookAheadWindow
+	otpPolicySettings["initial_counter"] = realm.OtpPolicyInitialCounter
+	otpPolicySettings["supported_applications"] = realm.OtpSupportedApplications
+	return otpPolicySettings
+}
+
+func getWebAuthnPolicySettings(realm *keycloak.Realm) map[string]interface{} {
+	webAuthnPolicySettings := make(map[string]interface{})
+	webAuthnPolicySettings["rp_entity_name"] = realm.WebAuthnPolicyRpEntityName
+	webAuthnPolicySettings["signature_algorithms"] = realm.WebAuthnPolicySignatureAlgorithms
+	webAuthnPolicySettings["rp_id"] = realm.WebAuthnPolicyRpId
+	webAuthnPolicySettings["attestation_conveyance_preference"] = realm.WebAuthnPolicyAttestationConveyancePreference
+	webAuthnPolicySettings["authenticator_attachment"] = realm.WebAuthnPolicyAuthenticatorAttachment
+	webAuthnPolicySettings["require_resident_key"] = realm.WebAuthnPolicyRequireResidentKey
+	webAuthnPolicySettings["user_verification_requirement"] = realm.WebAuthnPolicyUserVerificationRequirement
+	webAuthnPolicySettings["create_timeout"] = realm.WebAuthnPolicyCreateTimeout
+	webAuthnPolicySettings["avoid_same_authenticator_register"] = realm.WebAuthnPolicyAvoidSameAuthenticatorRegister
+	webAuthnPolicySettings["acceptable_aaguids"] = realm.WebAuthnPolicyAcceptableAaguids
+	return webAuthnPolicySettings
+}
+
+func getWebAuthnPasswordlessPolicySettings(realm *keycloak.Realm) map[string]interface{} {
+	webAuthnPolicySettings := make(map[string]interface{})
+	webAuthnPolicySettings["rp_entity_name"] = realm.WebAuthnPolicyPasswordlessRpEntityName
+	webAuthnPolicySettings["signature_algorithms"] = realm.WebAuthnPolicyPasswordlessSignatureAlgorithms
+	webAuthnPolicySettings["rp_id"] = realm.WebAuthnPolicyPasswordlessRpId
+	webAuthnPolicySettings["attestation_conveyance_preference"] = realm.WebAuthnPolicyPasswordlessAttestationConveyancePreference
+	webAuthnPolicySettings["authenticator_attachment"] = realm.WebAuthnPolicyPasswordlessAuthenticatorAttachment
+	webAuthnPolicySettings["require_resident_key"] = realm.WebAuthnPolicyPasswordlessRequireResidentKey
+	webAuthnPolicySettings["user_verification_requirement"] = realm.WebAuthnPolicyPasswordlessUserVerificationRequirement
+	webAuthnPolicySettings["create_timeout"] = realm.WebAuthnPolicyPasswordlessCreateTimeout
+	webAuthnPolicySettings["avoid_same_authenticator_register"] = realm.WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister
+	webAuthnPolicySettings["acceptable_aaguids"] = realm.WebAuthnPolicyPasswordlessAcceptableAaguids
+	return webAuthnPolicySettings
+}
+
+func isDefaultOtpPolicy(realm, defaultOtpPolicy *keycloak.Realm) bool {
+	return realm.OtpPolicyType == defaultOtpPolicy.OtpPolicyType &&
+		realm.OtpPolicyAlgorithm == defaultOtpPolicy.OtpPolicyAlgorithm &&
+		realm.OtpPolicyDigits == defaultOtpPolicy.OtpPolicyDigits &&
+		realm.OtpPolicyPeriod == defaultOtpPolicy.OtpPolicyPeriod &&
+		realm.OtpPolicyLookAheadWindow == defaultOtpPolicy.OtpPolicyLookAheadWindow &&
+		realm.OtpPolicyInitialCounter == defaultOtpPolicy.OtpPolicyInitialCounter
+}
+
 func getHeaderSettings(realm *keycloak.Realm) map[string]interface{} {
 	headersSettings := make(map[string]interface{})
 	headersSettings["content_security_policy"] = realm.BrowserSecurityHeaders.ContentSecurityPolicy
@@ -894,11 +1196,23 @@ func resourceKeycloakRealmRead(data *schema.ResourceData, meta interface{}) erro
 		realm.SmtpServer.Password = smtpPassword
 	}
 
+	// the smtp config may be managed by a `keycloak_realm_smtp` resource instead, so it's only tracked here when this resource
+	// manages it. a realm that's being imported has no state yet, so its smtp config is always tracked
+	if !realmSmtpServerIsManaged(data) && data.Get("realm").(string) != "" {
+		realm.SmtpServer = keycloak.SmtpServer{}
+	}
+
 	setRealmData(data, realm)
 
 	return nil
 }
 
+func realmSmtpServerIsManaged(data *schema.ResourceData) bool {
+	oldSmtpServer, newSmtpServer := data.GetChange("smtp_server")
+
+	return len(oldSmtpServer.([]interface{})) != 0 || len(newSmtpServer.([]interface{})) != 0
+}
+
 func resourceKeycloakRealmUpdate(data *schema.ResourceData, meta interface{}) error {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -912,11 +1226,27 @@ func resourceKeycloakRealmUpdate(data *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	// keep the smtp config that's already there when this resource doesn't manage it, instead of removing it
+	smtpServerIsManaged := realmSmtpServerIsManaged(data)
+	if !smtpServerIsManaged {
+		remoteRealm, err := keycloakClient.GetRealm(data.Id())
+		if err != nil {
+			return err
+		}
+
+		// keycloak keeps the current password when it's sent the masked value it responded with
+		realm.SmtpServer = remoteRealm.SmtpServer
+	}
+
 	err = keycloakClient.UpdateRealm(realm)
 	if err != nil {
 		return err
 	}
 
+	if !smtpServerIsManaged {
+		realm.SmtpServer = keycloak.SmtpServer{}
+	}
+
 	setRealmData(data, realm)
 
 	return nil
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmClientPolicies() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmClientPoliciesCreate,
+		Read:   resourceKeycloakRealmClientPoliciesRead,
+		Update: resourceKeycloakRealmClientPoliciesUpdate,
+		Delete: resourceKeycloakRealmClientPoliciesDelete,
+		// This resource can be imported using {{realm}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmClientPoliciesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"configuration": {
+										Type:     schema.TypeMap,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Optional: true,
+									},
+								},
+							},
+						},
+						"profiles": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+					},
+				},
+			},
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When false, policies that aren't defined by this resource are left alone.",
+			},
+		},
+	}
+}
+
+func getRealmClientPoliciesFromData(data interface{}) []*keycloak.RealmClientPolicy {
+	var policies []*keycloak.RealmClientPolicy
+
+	for _, p := range data.([]interface{}) {
+		policyData := p.(map[string]interface{})
+
+		policy := &keycloak.RealmClientPolicy{
+			Name:        policyData["name"].(string),
+			Description: policyData["description"].(string),
+			Enabled:     policyData["enabled"].(bool),
+			Conditions:  []*keycloak.RealmClientPolicyCondition{},
+			Profiles:    []string{},
+		}
+
+		policy.Profiles = append(policy.Profiles, interfaceSliceToStringSlice(policyData["profiles"].([]interface{}))...)
+
+		for _, c := range policyData["condition"].([]interface{}) {
+			conditionData := c.(map[string]interface{})
+
+			policy.Conditions = append(policy.Conditions, &keycloak.RealmClientPolicyCondition{
+				Condition:     conditionData["condition"].(string),
+				Configuration: clientPolicyConfigurationFromData(conditionData["configuration"]),
+			})
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+func getRealmClientPolicyNames(policies []*keycloak.RealmClientPolicy) map[string]bool {
+	names := make(map[string]bool)
+
+	for _, policy := range policies {
+		names[policy.Name] = true
+	}
+
+	return names
+}
+
+// the policies are replaced as a whole, so the remote policies that aren't managed by this resource are sent back
+// along with the wanted ones
+func mergeRealmClientPolicies(remote, wanted []*keycloak.RealmClientPolicy, managed map[string]bool) []*keycloak.RealmClientPolicy {
+	var policies []*keycloak.RealmClientPolicy
+
+	wantedNames := getRealmClientPolicyNames(wanted)
+	for _, policy := range remote {
+		if !managed[policy.Name] && !wantedNames[policy.Name] {
+			policies = append(policies, policy)
+		}
+	}
+
+	return append(policies, wanted...)
+}
+
+func setRealmClientPoliciesData(data *schema.ResourceData, policies []*keycloak.RealmClientPolicy) {
+	// when this resource isn't exclusive, only the policies that it manages are tracked
+	managed := getRealmClientPolicyNames(getRealmClientPoliciesFromData(data.Get("policy")))
+	exclusive := data.Get("exclusive").(bool)
+
+	var policiesData []interface{}
+	for _, policy := range policies {
+		if !exclusive && !managed[policy.Name] {
+			continue
+		}
+
+		var conditionsData []interface{}
+		for _, condition := range policy.Conditions {
+			conditionsData = append(conditionsData, map[string]interface{}{
+				"condition":     condition.Condition,
+				"configuration": userProfileMapToData(condition.Configuration),
+			})
+		}
+
+		policiesData = append(policiesData, map[string]interface{}{
+			"name":        policy.Name,
+			"description": policy.Description,
+			"enabled":     policy.Enabled,
+			"condition":   conditionsData,
+			"profiles":    policy.Profiles,
+		})
+	}
+
+	data.Set("policy", policiesData)
+}
+
+func resourceKeycloakRealmClientPoliciesCreate(data *schema.ResourceData, meta interface{}) error {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmClientPoliciesUpdate(data, meta)
+}
+
+func resourceKeycloakRealmClientPoliciesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmClientPolicies, err := keycloakClient.GetRealmClientPolicies(data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setRealmClientPoliciesData(data, realmClientPolicies.Policies)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientPoliciesUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	oldPolicies, newPolicies := data.GetChange("policy")
+	wanted := getRealmClientPoliciesFromData(newPolicies)
+
+	policies := wanted
+	if !data.Get("exclusive").(bool) {
+		realmClientPolicies, err := keycloakClient.GetRealmClientPolicies(realmId)
+		if err != nil {
+			return err
+		}
+
+		// policies that were removed from the configuration are deleted, everything else that isn't managed here is kept
+		previouslyManaged := getRealmClientPolicyNames(getRealmClientPoliciesFromData(oldPolicies))
+		policies = mergeRealmClientPolicies(realmClientPolicies.Policies, wanted, previouslyManaged)
+	}
+
+	err := keycloakClient.UpdateRealmClientPolicies(realmId, policies)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakRealmClientPoliciesRead(data, meta)
+}
+
+func resourceKeycloakRealmClientPoliciesDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	var policies []*keycloak.RealmClientPolicy
+	if !data.Get("exclusive").(bool) {
+		realmClientPolicies, err := keycloakClient.GetRealmClientPolicies(realmId)
+		if err != nil {
+			// the policies were deleted along with the realm, so there's nothing left to do
+			if keycloak.ErrorIs404(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		managed := getRealmClientPolicyNames(getRealmClientPoliciesFromData(data.Get("policy")))
+		policies = mergeRealmClientPolicies(realmClientPolicies.Policies, nil, managed)
+	}
+
+	err := keycloakClient.UpdateRealmClientPolicies(realmId, policies)
+	if keycloak.ErrorIs404(err) {
+		return nil
+	}
+
+	return err
+}
+
+func resourceKeycloakRealmClientPoliciesImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+	d.Set("exclusive", true)
+
+	return []*schema.ResourceData{d}, nil
+}
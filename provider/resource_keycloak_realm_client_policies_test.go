@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakRealmClientPolicies_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckKeycloakVersion(t, clientPoliciesMinimumKeycloakVersion)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicies_basic(realmName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPoliciesHavePolicy("keycloak_realm_client_policies.policies", "confidential-clients", true),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policies.policies", "policy.0.profiles.0", "pkce"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policies.policies", "policy.0.condition.0.configuration.type", "[\"confidential\"]"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_client_policies.policies",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmClientPolicies_basic(realmName, false),
+				Check:  testAccCheckKeycloakRealmClientPoliciesHavePolicy("keycloak_realm_client_policies.policies", "confidential-clients", false),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmClientPoliciesHavePolicy(resourceName, policyName string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmClientPolicies, err := keycloakClient.GetRealmClientPolicies(rs.Primary.Attributes["realm_id"])
+		if err != nil {
+			return err
+		}
+
+		for _, policy := range realmClientPolicies.Policies {
+			if policy.Name != policyName {
+				continue
+			}
+
+			if policy.Enabled != enabled {
+				return fmt.Errorf("expected client policy %s to have enabled set to %t, but was %t", policyName, enabled, policy.Enabled)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("expected realm to have client policy %s", policyName)
+	}
+}
+
+func testKeycloakRealmClientPolicies_basic(realmName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_profiles" "profiles" {
+	realm_id = "${keycloak_realm.realm.id}"
+
+	profile {
+		name = "pkce"
+
+		executor {
+			executor      = "pkce-enforcer"
+			configuration = {
+				auto-configure = "false"
+			}
+		}
+	}
+}
+
+resource "keycloak_realm_client_policies" "policies" {
+	realm_id = "${keycloak_realm.realm.id}"
+
+	policy {
+		name     = "confidential-clients"
+		enabled  = %t
+		profiles = ["${keycloak_realm_client_profiles.profiles.profile.0.name}"]
+
+		condition {
+			condition     = "client-access-type"
+			configuration = {
+				type = "[\"confidential\"]"
+			}
+		}
+	}
+}
+	`, realmName, enabled)
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmClientProfiles() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmClientProfilesCreate,
+		Read:   resourceKeycloakRealmClientProfilesRead,
+		Update: resourceKeycloakRealmClientProfilesUpdate,
+		Delete: resourceKeycloakRealmClientProfilesDelete,
+		// This resource can be imported using {{realm}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmClientProfilesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"executor": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"executor": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"configuration": {
+										Type:     schema.TypeMap,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When false, profiles that aren't defined by this resource are left alone.",
+			},
+		},
+	}
+}
+
+// executor and condition configs can hold any JSON value, so they're handled the same way as the user profile's
+// validator configs. keycloak expects an object even when there's nothing to configure
+func clientPolicyConfigurationFromData(data interface{}) map[string]interface{} {
+	configuration := userProfileMapFromData(data)
+	if configuration == nil {
+		return map[string]interface{}{}
+	}
+
+	return configuration
+}
+
+func getRealmClientProfilesFromData(data interface{}) []*keycloak.RealmClientProfile {
+	var profiles []*keycloak.RealmClientProfile
+
+	for _, p := range data.([]interface{}) {
+		profileData := p.(map[string]interface{})
+
+		profile := &keycloak.RealmClientProfile{
+			Name:        profileData["name"].(string),
+			Description: profileData["description"].(string),
+			Executors:   []*keycloak.RealmClientPolicyExecutor{},
+		}
+
+		for _, e := range profileData["executor"].([]interface{}) {
+			executorData := e.(map[string]interface{})
+
+			profile.Executors = append(profile.Executors, &keycloak.RealmClientPolicyExecutor{
+				Executor:      executorData["executor"].(string),
+				Configuration: clientPolicyConfigurationFromData(executorData["configuration"]),
+			})
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles
+}
+
+func getRealmClientProfileNames(profiles []*keycloak.RealmClientProfile) map[string]bool {
+	names := make(map[string]bool)
+
+	for _, profile := range profiles {
+		names[profile.Name] = true
+	}
+
+	return names
+}
+
+// the profiles are replaced as a whole, so the remote profiles that aren't managed by this resource are sent back
+// along with the wanted ones
+func mergeRealmClientProfiles(remote, wanted []*keycloak.RealmClientProfile, managed map[string]bool) []*keycloak.RealmClientProfile {
+	var profiles []*keycloak.RealmClientProfile
+
+	wantedNames := getRealmClientProfileNames(wanted)
+	for _, profile := range remote {
+		if !managed[profile.Name] && !wantedNames[profile.Name] {
+			profiles = append(profiles, profile)
+		}
+	}
+
+	return append(profiles, wanted...)
+}
+
+func setRealmClientProfilesData(data *schema.ResourceData, profiles []*keycloak.RealmClientProfile) {
+	// when this resource isn't exclusive, only the profiles that it manages are tracked
+	managed := getRealmClientProfileNames(getRealmClientProfilesFromData(data.Get("profile")))
+	exclusive := data.Get("exclusive").(bool)
+
+	var profilesData []interface{}
+	for _, profile := range profiles {
+		if !exclusive && !managed[profile.Name] {
+			continue
+		}
+
+		var executorsData []interface{}
+		for _, executor := range profile.Executors {
+			executorsData = append(executorsData, map[string]interface{}{
+				"executor":      executor.Executor,
+				"configuration": userProfileMapToData(executor.Configuration),
+			})
+		}
+
+		profilesData = append(profilesData, map[string]interface{}{
+			"name":        profile.Name,
+			"description": profile.Description,
+			"executor":    executorsData,
+		})
+	}
+
+	data.Set("profile", profilesData)
+}
+
+func resourceKeycloakRealmClientProfilesCreate(data *schema.ResourceData, meta interface{}) error {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmClientProfilesUpdate(data, meta)
+}
+
+func resourceKeycloakRealmClientProfilesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmClientProfiles, err := keycloakClient.GetRealmClientProfiles(data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setRealmClientProfilesData(data, realmClientProfiles.Profiles)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientProfilesUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	oldProfiles, newProfiles := data.GetChange("profile")
+	wanted := getRealmClientProfilesFromData(newProfiles)
+
+	profiles := wanted
+	if !data.Get("exclusive").(bool) {
+		realmClientProfiles, err := keycloakClient.GetRealmClientProfiles(realmId)
+		if err != nil {
+			return err
+		}
+
+		// profiles that were removed from the configuration are deleted, everything else that isn't managed here is kept
+		previouslyManaged := getRealmClientProfileNames(getRealmClientProfilesFromData(oldProfiles))
+		profiles = mergeRealmClientProfiles(realmClientProfiles.Profiles, wanted, previouslyManaged)
+	}
+
+	err := keycloakClient.UpdateRealmClientProfiles(realmId, profiles)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakRealmClientProfilesRead(data, meta)
+}
+
+func resourceKeycloakRealmClientProfilesDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	var profiles []*keycloak.RealmClientProfile
+	if !data.Get("exclusive").(bool) {
+		realmClientProfiles, err := keycloakClient.GetRealmClientProfiles(realmId)
+		if err != nil {
+			// the profiles were deleted along with the realm, so there's nothing left to do
+			if keycloak.ErrorIs404(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		managed := getRealmClientProfileNames(getRealmClientProfilesFromData(data.Get("profile")))
+		profiles = mergeRealmClientProfiles(realmClientProfiles.Profiles, nil, managed)
+	}
+
+	err := keycloakClient.UpdateRealmClientProfiles(realmId, profiles)
+	if keycloak.ErrorIs404(err) {
+		return nil
+	}
+
+	return err
+}
+
+func resourceKeycloakRealmClientProfilesImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+	d.Set("exclusive", true)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+// client policies are enabled by default starting with keycloak 15
+const clientPoliciesMinimumKeycloakVersion = 15
+
+func TestAccKeycloakRealmClientProfiles_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckKeycloakVersion(t, clientPoliciesMinimumKeycloakVersion)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientProfiles_basic(realmName, "Enforces PKCE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientProfilesHaveProfile("keycloak_realm_client_profiles.profiles", "pkce"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_profiles.profiles", "profile.0.description", "Enforces PKCE"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_profiles.profiles", "profile.0.executor.0.configuration.auto-configure", "false"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_client_profiles.profiles",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmClientProfiles_basic(realmName, "Requires PKCE"),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_client_profiles.profiles", "profile.0.description", "Requires PKCE"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientProfiles_nonExclusive(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckKeycloakVersion(t, clientPoliciesMinimumKeycloakVersion)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientProfiles_basic(realmName, "Enforces PKCE"),
+				Check:  testAccCheckKeycloakRealmClientProfilesHaveProfile("keycloak_realm_client_profiles.profiles", "pkce"),
+			},
+			{
+				Config: testKeycloakRealmClientProfiles_nonExclusive(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientProfilesHaveProfile("keycloak_realm_client_profiles.profiles", "pkce"),
+					testAccCheckKeycloakRealmClientProfilesHaveProfile("keycloak_realm_client_profiles.more_profiles", "holder-of-key"),
+				),
+			},
+		},
+	})
+}
+
+func TestKeycloakRealmClientProfiles_updateKeepsProfilesManagedElsewhere(t *testing.T) {
+	var sent keycloak.RealmClientProfiles
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /auth/admin/realms/test/client-policies/profiles":
+			w.Write([]byte(`{"profiles": [{"name": "other", "executors": []}, {"name": "pkce", "description": "old", "executors": []}]}`))
+		case "PUT /auth/admin/realms/test/client-policies/profiles":
+			body, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(body, &sent)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakRealmClientProfiles().Schema, map[string]interface{}{
+		"realm_id":  "test",
+		"exclusive": false,
+		"profile": []interface{}{
+			map[string]interface{}{
+				"name":        "pkce",
+				"description": "new",
+				"executor": []interface{}{
+					map[string]interface{}{
+						"executor": "pkce-enforcer",
+						"configuration": map[string]interface{}{
+							"auto-configure": "true",
+						},
+					},
+				},
+			},
+		},
+	})
+	data.SetId("test")
+
+	err := resourceKeycloakRealmClientProfilesUpdate(data, keycloakClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sent.Profiles) != 2 || sent.Profiles[0].Name != "other" || sent.Profiles[1].Name != "pkce" {
+		t.Fatalf("expected the other profile to be kept and the pkce profile to be replaced, got %v", sent.Profiles)
+	}
+
+	if sent.Profiles[1].Description != "new" || len(sent.Profiles[1].Executors) != 1 {
+		t.Errorf("expected the pkce profile to be replaced with the configured one, got %v", sent.Profiles[1])
+	}
+}
+
+func testAccCheckKeycloakRealmClientProfilesHaveProfile(resourceName, profileName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmClientProfiles, err := keycloakClient.GetRealmClientProfiles(rs.Primary.Attributes["realm_id"])
+		if err != nil {
+			return err
+		}
+
+		for _, profile := range realmClientProfiles.Profiles {
+			if profile.Name == profileName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected realm to have client profile %s", profileName)
+	}
+}
+
+func testKeycloakRealmClientProfiles_basic(realmName, description string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_profiles" "profiles" {
+	realm_id = "${keycloak_realm.realm.id}"
+
+	profile {
+		name        = "pkce"
+		description = "%s"
+
+		executor {
+			executor      = "pkce-enforcer"
+			configuration = {
+				auto-configure = "false"
+			}
+		}
+	}
+}
+	`, realmName, description)
+}
+
+func testKeycloakRealmClientProfiles_nonExclusive(realmName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_profiles" "profiles" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	exclusive = false
+
+	profile {
+		name        = "pkce"
+		description = "Enforces PKCE"
+
+		executor {
+			executor      = "pkce-enforcer"
+			configuration = {
+				auto-configure = "false"
+			}
+		}
+	}
+}
+
+resource "keycloak_realm_client_profiles" "more_profiles" {
+	realm_id  = "${keycloak_realm_client_profiles.profiles.realm_id}"
+	exclusive = false
+
+	profile {
+		name = "holder-of-key"
+
+		executor {
+			executor      = "holder-of-key-enforcer"
+			configuration = {
+				auto-configure = "true"
+			}
+		}
+	}
+}
+	`, realmName)
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmEvents() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmEventsCreate,
+		Read:   resourceKeycloakRealmEventsRead,
+		Update: resourceKeycloakRealmEventsUpdate,
+		Delete: resourceKeycloakRealmEventsDelete,
+		// This resource can be imported using {{realm}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmEventsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"events_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"events_expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			// keycloak saves every event type when this is empty, and newer versions report that as the full list
+			"enabled_event_types": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Optional: true,
+				Computed: true,
+			},
+			"admin_events_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"admin_events_details_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"events_listeners": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getRealmEventsConfigFromData(data *schema.ResourceData) *keycloak.RealmEventsConfig {
+	enabledEventTypes := make([]string, 0)
+	if v, ok := data.GetOk("enabled_event_types"); ok {
+		enabledEventTypes = interfaceSliceToStringSlice(v.(*schema.Set).List())
+	}
+
+	// jboss-logging is the listener every realm starts with
+	eventsListeners := []string{"jboss-logging"}
+	if v, ok := data.GetOk("events_listeners"); ok {
+		eventsListeners = interfaceSliceToStringSlice(v.(*schema.Set).List())
+	}
+
+	return &keycloak.RealmEventsConfig{
+		EventsEnabled:             data.Get("events_enabled").(bool),
+		EventsExpiration:          data.Get("events_expiration").(int),
+		EnabledEventTypes:         enabledEventTypes,
+		AdminEventsEnabled:        data.Get("admin_events_enabled").(bool),
+		AdminEventsDetailsEnabled: data.Get("admin_events_details_enabled").(bool),
+		EventsListeners:           eventsListeners,
+	}
+}
+
+func setRealmEventsConfigData(data *schema.ResourceData, realmEventsConfig *keycloak.RealmEventsConfig) {
+	data.Set("events_enabled", realmEventsConfig.EventsEnabled)
+	data.Set("events_expiration", realmEventsConfig.EventsExpiration)
+	data.Set("enabled_event_types", realmEventsConfig.EnabledEventTypes)
+	data.Set("admin_events_enabled", realmEventsConfig.AdminEventsEnabled)
+	data.Set("admin_events_details_enabled", realmEventsConfig.AdminEventsDetailsEnabled)
+	data.Set("events_listeners", realmEventsConfig.EventsListeners)
+}
+
+func resourceKeycloakRealmEventsCreate(data *schema.ResourceData, meta interface{}) error {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmEventsUpdate(data, meta)
+}
+
+func resourceKeycloakRealmEventsRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmEventsConfig, err := keycloakClient.GetRealmEventsConfig(data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setRealmEventsConfigData(data, realmEventsConfig)
+
+	return nil
+}
+
+func resourceKeycloakRealmEventsUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.UpdateRealmEventsConfig(data.Get("realm_id").(string), getRealmEventsConfigFromData(data))
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakRealmEventsRead(data, meta)
+}
+
+// a realm always has an events config, so it can't be deleted. instead, it's reset to the config a new realm starts with
+func resourceKeycloakRealmEventsDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return keycloakClient.UpdateRealmEventsConfig(data.Get("realm_id").(string), &keycloak.RealmEventsConfig{
+		EnabledEventTypes: []string{},
+		EventsListeners:   []string{"jboss-logging"},
+	})
+}
+
+func resourceKeycloakRealmEventsImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakRealmEvents_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	resourceName := "keycloak_realm_events.realm_events"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmEventsDestroy(realmName),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmEvents_basic(realmName, 3600, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmEventsEnabled(realmName, true),
+					resource.TestCheckResourceAttr(resourceName, "events_expiration", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_event_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "events_listeners.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmEvents_basic(realmName, 7200, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmEventsEnabled(realmName, false),
+					resource.TestCheckResourceAttr(resourceName, "events_expiration", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmEventsEnabled(realmName string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		realmEventsConfig, err := keycloakClient.GetRealmEventsConfig(realmName)
+		if err != nil {
+			return err
+		}
+
+		if realmEventsConfig.EventsEnabled != enabled || realmEventsConfig.AdminEventsEnabled != enabled {
+			return fmt.Errorf("expected events and admin events to have enabled set to %t, but got %t and %t", enabled, realmEventsConfig.EventsEnabled, realmEventsConfig.AdminEventsEnabled)
+		}
+
+		return nil
+	}
+}
+
+// the realm is destroyed along with the events config, so this only checks that the realm is gone
+func testAccCheckKeycloakRealmEventsDestroy(realmName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		realm, _ := keycloakClient.GetRealm(realmName)
+		if realm != nil {
+			return fmt.Errorf("realm %s still exists", realmName)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmEvents_basic(realmName string, expiration int, enabled bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_events" "realm_events" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	events_enabled    = %[3]t
+	events_expiration = %[2]d
+
+	enabled_event_types = [
+		"LOGIN",
+		"LOGOUT",
+	]
+
+	admin_events_enabled         = %[3]t
+	admin_events_details_enabled = %[3]t
+
+	events_listeners = [
+		"jboss-logging",
+	]
+}
+	`, realmName, expiration, enabled)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakRealmKeystoreAesSecretSizes = []int{16, 24, 32}
+
+func resourceKeycloakRealmKeystoreAesGenerated() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmKeystoreAesGeneratedCreate,
+		Read:   resourceKeycloakRealmKeystoreAesGeneratedRead,
+		Update: resourceKeycloakRealmKeystoreAesGeneratedUpdate,
+		Delete: resourceKeycloakRealmKeystoreAesGeneratedDelete,
+		// This resource can be imported using {{realm}}/{{keystore_id}}. The keystore ID is displayed in the GUI
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmKeystoreGenericImport,
+		},
+		Schema: mergeSchemas(realmKeystoreSchema(), map[string]*schema.Schema{
+			"secret_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      16,
+				ValidateFunc: validation.IntInSlice(keycloakRealmKeystoreAesSecretSizes),
+				Description:  "Size in bytes for the generated secret.",
+			},
+		}),
+	}
+}
+
+func getRealmKeystoreAesGeneratedFromData(data *schema.ResourceData) *keycloak.RealmKeystoreAesGenerated {
+	return &keycloak.RealmKeystoreAesGenerated{
+		Id:      data.Id(),
+		Name:    data.Get("name").(string),
+		RealmId: data.Get("realm_id").(string),
+
+		Active:   data.Get("active").(bool),
+		Enabled:  data.Get("enabled").(bool),
+		Priority: data.Get("priority").(int),
+
+		SecretSize: data.Get("secret_size").(int),
+	}
+}
+
+func setRealmKeystoreAesGeneratedData(data *schema.ResourceData, keystore *keycloak.RealmKeystoreAesGenerated) {
+	data.SetId(keystore.Id)
+
+	data.Set("name", keystore.Name)
+	data.Set("realm_id", keystore.RealmId)
+	data.Set("active", keystore.Active)
+	data.Set("enabled", keystore.Enabled)
+	data.Set("priority", keystore.Priority)
+	data.Set("secret_size", keystore.SecretSize)
+}
+
+func resourceKeycloakRealmKeystoreAesGeneratedCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreAesGeneratedFromData(data)
+
+	err := keycloakClient.NewRealmKeystoreAesGenerated(keystore)
+	if err != nil {
+		return err
+	}
+
+	setRealmKeystoreAesGeneratedData(data, keystore)
+
+	return resourceKeycloakRealmKeystoreAesGeneratedRead(data, meta)
+}
+
+func resourceKeycloakRealmKeystoreAesGeneratedRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	keystore, err := keycloakClient.GetRealmKeystoreAesGenerated(realmId, id)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setRealmKeystoreAesGeneratedData(data, keystore)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreAesGeneratedUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreAesGeneratedFromData(data)
+
+	err := keycloakClient.UpdateRealmKeystoreAesGenerated(keystore)
+	if err != nil {
+		return err
+	}
+
+	setRealmKeystoreAesGeneratedData(data, keystore)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreAesGeneratedDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return keycloakClient.DeleteRealmKeystoreAesGenerated(realmId, id)
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmKeystoreAesGenerated_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	keystoreName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_realm_keystore_aes_generated.keystore"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmKeystoreAesGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreAesGenerated_basic(realmName, keystoreName, 16, 100),
+				Check:  testAccCheckKeycloakRealmKeystoreAesGeneratedExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+			{
+				Config: testKeycloakRealmKeystoreAesGenerated_basic(realmName, keystoreName, 32, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmKeystoreAesGeneratedExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "secret_size", "32"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreAesGenerated_createAfterManualDestroy(t *testing.T) {
+	var keystore = &keycloak.RealmKeystoreAesGenerated{}
+
+	realmName := "terraform-" + acctest.RandString(10)
+	keystoreName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_realm_keystore_aes_generated.keystore"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmKeystoreAesGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreAesGenerated_basic(realmName, keystoreName, 16, 100),
+				Check:  testAccCheckKeycloakRealmKeystoreAesGeneratedFetch(resourceName, keystore),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					err := keycloakClient.DeleteRealmKeystoreAesGenerated(keystore.RealmId, keystore.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmKeystoreAesGenerated_basic(realmName, keystoreName, 16, 100),
+				Check:  testAccCheckKeycloakRealmKeystoreAesGeneratedExists(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmKeystoreAesGeneratedExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getRealmKeystoreAesGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreAesGeneratedFetch(resourceName string, keystore *keycloak.RealmKeystoreAesGenerated) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedKeystore, err := getRealmKeystoreAesGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		keystore.Id = fetchedKeystore.Id
+		keystore.RealmId = fetchedKeystore.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreAesGeneratedDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_keystore_aes_generated" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			keystore, _ := keycloakClient.GetRealmKeystoreAesGenerated(realm, id)
+			if keystore != nil {
+				return fmt.Errorf("aes generated keystore with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getRealmKeystoreAesGeneratedFromState(s *terraform.State, resourceName string) (*keycloak.RealmKeystoreAesGenerated, error) {
+	keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	keystore, err := keycloakClient.GetRealmKeystoreAesGenerated(realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting aes generated keystore with id %s: %s", id, err)
+	}
+
+	return keystore, nil
+}
+
+func testKeycloakRealmKeystoreAesGenerated_basic(realm, keystoreName string, secretSize, priority int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_aes_generated" "keystore" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+
+	secret_size = %d
+	priority = %d
+}
+	`, realm, keystoreName, secretSize, priority)
+}
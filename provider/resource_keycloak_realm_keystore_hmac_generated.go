@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakRealmKeystoreHmacAlgorithms = []string{"HS256", "HS384", "HS512"}
+
+var keycloakRealmKeystoreHmacSecretSizes = []int{16, 24, 32, 64, 128, 256, 512}
+
+func resourceKeycloakRealmKeystoreHmacGenerated() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmKeystoreHmacGeneratedCreate,
+		Read:   resourceKeycloakRealmKeystoreHmacGeneratedRead,
+		Update: resourceKeycloakRealmKeystoreHmacGeneratedUpdate,
+		Delete: resourceKeycloakRealmKeystoreHmacGeneratedDelete,
+		// This resource can be imported using {{realm}}/{{keystore_id}}. The keystore ID is displayed in the GUI
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmKeystoreGenericImport,
+		},
+		Schema: mergeSchemas(realmKeystoreSchema(), map[string]*schema.Schema{
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HS256",
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreHmacAlgorithms, false),
+				Description:  "Intended algorithm for the key.",
+			},
+			"secret_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      64,
+				ValidateFunc: validation.IntInSlice(keycloakRealmKeystoreHmacSecretSizes),
+				Description:  "Size in bytes for the generated secret.",
+			},
+		}),
+	}
+}
+
+func getRealmKeystoreHmacGeneratedFromData(data *schema.ResourceData) *keycloak.RealmKeystoreHmacGenerated {
+	return &keycloak.RealmKeystoreHmacGenerated{
+		Id:      data.Id(),
+		Name:    data.Get("name").(string),
+		RealmId: data.Get("realm_id").(string),
+
+		Active:   data.Get("active").(bool),
+		Enabled:  data.Get("enabled").(bool),
+		Priority: data.Get("priority").(int),
+
+		Algorithm:  data.Get("algorithm").(string),
+		SecretSize: data.Get("secret_size").(int),
+	}
+}
+
+func setRealmKeystoreHmacGeneratedData(data *schema.ResourceData, keystore *keycloak.RealmKeystoreHmacGenerated) {
+	data.SetId(keystore.Id)
+
+	data.Set("name", keystore.Name)
+	data.Set("realm_id", keystore.RealmId)
+	data.Set("active", keystore.Active)
+	data.Set("enabled", keystore.Enabled)
+	data.Set("priority", keystore.Priority)
+	data.Set("algorithm", keystore.Algorithm)
+	data.Set("secret_size", keystore.SecretSize)
+}
+
+func resourceKeycloakRealmKeystoreHmacGeneratedCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreHmacGeneratedFromData(data)
+
+	err := keycloakClient.NewRealmKeystoreHmacGenerated(keystore)
+	if err != nil {
+		return err
+	}
+
+	setRealmKeystoreHmacGeneratedData(data, keystore)
+
+	return resourceKeycloakRealmKeystoreHmacGeneratedRead(data, meta)
+}
+
+func resourceKeycloakRealmKeystoreHmacGeneratedRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	keystore, err := keycloakClient.GetRealmKeystoreHmacGenerated(realmId, id)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setRealmKeystoreHmacGeneratedData(data, keystore)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreHmacGeneratedUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreHmacGeneratedFromData(data)
+
+	err := keycloakClient.UpdateRealmKeystoreHmacGenerated(keystore)
+	if err != nil {
+		return err
+	}
+
+	setRealmKeystoreHmacGeneratedData(data, keystore)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreHmacGeneratedDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return keycloakClient.DeleteRealmKeystoreHmacGenerated(realmId, id)
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmKeystoreHmacGenerated_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	keystoreName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_realm_keystore_hmac_generated.keystore"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmKeystoreHmacGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreHmacGenerated_basic(realmName, keystoreName, 32, 100),
+				Check:  testAccCheckKeycloakRealmKeystoreHmacGeneratedExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+			{
+				Config: testKeycloakRealmKeystoreHmacGenerated_basic(realmName, keystoreName, 64, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmKeystoreHmacGeneratedExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "secret_size", "64"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreHmacGenerated_createAfterManualDestroy(t *testing.T) {
+	var keystore = &keycloak.RealmKeystoreHmacGenerated{}
+
+	realmName := "terraform-" + acctest.RandString(10)
+	keystoreName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_realm_keystore_hmac_generated.keystore"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmKeystoreHmacGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreHmacGenerated_basic(realmName, keystoreName, 32, 100),
+				Check:  testAccCheckKeycloakRealmKeystoreHmacGeneratedFetch(resourceName, keystore),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					err := keycloakClient.DeleteRealmKeystoreHmacGenerated(keystore.RealmId, keystore.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmKeystoreHmacGenerated_basic(realmName, keystoreName, 32, 100),
+				Check:  testAccCheckKeycloakRealmKeystoreHmacGeneratedExists(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmKeystoreHmacGeneratedExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getRealmKeystoreHmacGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreHmacGeneratedFetch(resourceName string, keystore *keycloak.RealmKeystoreHmacGenerated) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedKeystore, err := getRealmKeystoreHmacGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		keystore.Id = fetchedKeystore.Id
+		keystore.RealmId = fetchedKeystore.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreHmacGeneratedDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_keystore_hmac_generated" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			keystore, _ := keycloakClient.GetRealmKeystoreHmacGenerated(realm, id)
+			if keystore != nil {
+				return fmt.Errorf("hmac generated keystore with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getRealmKeystoreHmacGeneratedFromState(s *terraform.State, resourceName string) (*keycloak.RealmKeystoreHmacGenerated, error) {
+	keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	keystore, err := keycloakClient.GetRealmKeystoreHmacGenerated(realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting hmac generated keystore with id %s: %s", id, err)
+	}
+
+	return keystore, nil
+}
+
+func testKeycloakRealmKeystoreHmacGenerated_basic(realm, keystoreName string, secretSize, priority int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_hmac_generated" "keystore" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+
+	secret_size = %d
+	priority = %d
+}
+	`, realm, keystoreName, secretSize, priority)
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+var keycloakRealmKeystoreRsaAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}
+
+// the arguments shared by every kind of realm keystore
+func realmKeystoreSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Display name of the provider when displayed in the console.",
+		},
+		"realm_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The realm this keystore exists in.",
+		},
+		"active": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "When false, the keys are available for verification but not for signing.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "When false, the keys are not used at all.",
+		},
+		"priority": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     0,
+			Description: "Priority for the provider. The active key with the highest priority is used for signing.",
+		},
+	}
+}
+
+func resourceKeycloakRealmKeystoreRsa() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmKeystoreRsaCreate,
+		Read:   resourceKeycloakRealmKeystoreRsaRead,
+		Update: resourceKeycloakRealmKeystoreRsaUpdate,
+		Delete: resourceKeycloakRealmKeystoreRsaDelete,
+		// This resource can be imported using {{realm}}/{{keystore_id}}. The keystore ID is displayed in the GUI
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmKeystoreGenericImport,
+		},
+		CustomizeDiff: regenerateRealmKeystoreRsaCertificate,
+		Schema: mergeSchemas(realmKeystoreSchema(), map[string]*schema.Schema{
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RS256",
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreRsaAlgorithms, false),
+				Description:  "Intended algorithm for the key.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Private RSA key encoded in PEM format.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "X509 certificate encoded in PEM format. Keycloak generates one from the private key when this isn't set.",
+			},
+		}),
+	}
+}
+
+// the certificate in state belongs to the old key once the private key changes. unless a new certificate is configured
+// along with the key, it is marked as computed so it isn't sent with the update and keycloak generates one for the new key
+func regenerateRealmKeystoreRsaCertificate(diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("private_key") || diff.HasChange("certificate") {
+		return nil
+	}
+
+	return diff.SetNewComputed("certificate")
+}
+
+func getRealmKeystoreRsaFromData(data *schema.ResourceData) *keycloak.RealmKeystoreRsa {
+	return &keycloak.RealmKeystoreRsa{
+		Id:      data.Id(),
+		Name:    data.Get("name").(string),
+		RealmId: data.Get("realm_id").(string),
+
+		Active:   data.Get("active").(bool),
+		Enabled:  data.Get("enabled").(bool),
+		Priority: data.Get("priority").(int),
+
+		Algorithm:   data.Get("algorithm").(string),
+		PrivateKey:  data.Get("private_key").(string),
+		Certificate: data.Get("certificate").(string),
+	}
+}
+
+// keycloak doesn't return the private key, so the value from the configuration is kept as is
+func setRealmKeystoreRsaData(data *schema.ResourceData, keystore *keycloak.RealmKeystoreRsa) {
+	data.SetId(keystore.Id)
+
+	data.Set("name", keystore.Name)
+	data.Set("realm_id", keystore.RealmId)
+	data.Set("active", keystore.Active)
+	data.Set("enabled", keystore.Enabled)
+	data.Set("priority", keystore.Priority)
+	data.Set("algorithm", keystore.Algorithm)
+	data.Set("certificate", keystore.Certificate)
+}
+
+func resourceKeycloakRealmKeystoreRsaCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreRsaFromData(data)
+
+	err := keycloakClient.NewRealmKeystoreRsa(keystore)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(keystore.Id)
+
+	return resourceKeycloakRealmKeystoreRsaRead(data, meta)
+}
+
+func resourceKeycloakRealmKeystoreRsaRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	keystore, err := keycloakClient.GetRealmKeystoreRsa(realmId, id)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setRealmKeystoreRsaData(data, keystore)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreRsaUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreRsaFromData(data)
+
+	err := keycloakClient.UpdateRealmKeystoreRsa(keystore)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakRealmKeystoreRsaRead(data, meta)
+}
+
+func resourceKeycloakRealmKeystoreRsaDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return keycloakClient.DeleteRealmKeystoreRsa(realmId, id)
+}
+
+func resourceKeycloakRealmKeystoreGenericImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{keystoreId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakRealmKeystoreRsaKeySizes = []int{1024, 2048, 4096}
+
+func resourceKeycloakRealmKeystoreRsaGenerated() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmKeystoreRsaGeneratedCreate,
+		Read:   resourceKeycloakRealmKeystoreRsaGeneratedRead,
+		Update: resourceKeycloakRealmKeystoreRsaGeneratedUpdate,
+		Delete: resourceKeycloakRealmKeystoreRsaGeneratedDelete,
+		// This resource can be imported using {{realm}}/{{keystore_id}}. The keystore ID is displayed in the GUI
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmKeystoreGenericImport,
+		},
+		Schema: mergeSchemas(realmKeystoreSchema(), map[string]*schema.Schema{
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RS256",
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreRsaAlgorithms, false),
+				Description:  "Intended algorithm for the key.",
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2048,
+				ValidateFunc: validation.IntInSlice(keycloakRealmKeystoreRsaKeySizes),
+				Description:  "Size for the generated keys.",
+			},
+		}),
+	}
+}
+
+func getRealmKeystoreRsaGeneratedFromData(data *schema.ResourceData) *keycloak.RealmKeystoreRsaGenerated {
+	return &keycloak.RealmKeystoreRsaGenerated{
+		Id:      data.Id(),
+		Name:    data.Get("name").(string),
+		RealmId: data.Get("realm_id").(string),
+
+		Active:   data.Get("active").(bool),
+		Enabled:  data.Get("enabled").(bool),
+		Priority: data.Get("priority").(int),
+
+		Algorithm: data.Get("algorithm").(string),
+		KeySize:   data.Get("key_size").(int),
+	}
+}
+
+func setRealmKeystoreRsaGeneratedData(data *schema.ResourceData, keystore *keycloak.RealmKeystoreRsaGenerated) {
+	data.SetId(keystore.Id)
+
+	data.Set("name", keystore.Name)
+	data.Set("realm_id", keystore.RealmId)
+	data.Set("active", keystore.Active)
+	data.Set("enabled", keystore.Enabled)
+	data.Set("priority", keystore.Priority)
+	data.Set("algorithm", keystore.Algorithm)
+	data.Set("key_size", keystore.KeySize)
+}
+
+func resourceKeycloakRealmKeystoreRsaGeneratedCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreRsaGeneratedFromData(data)
+
+	err := keycloakClient.NewRealmKeystoreRsaGenerated(keystore)
+	if err != nil {
+		return err
+	}
+
+	setRealmKeystoreRsaGeneratedData(data, keystore)
+
+	return resourceKeycloakRealmKeystoreRsaGeneratedRead(data, meta)
+}
+
+func resourceKeycloakRealmKeystoreRsaGeneratedRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	keystore, err := keycloakClient.GetRealmKeystoreRsaGenerated(realmId, id)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setRealmKeystoreRsaGeneratedData(data, keystore)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreRsaGeneratedUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keystore := getRealmKeystoreRsaGeneratedFromData(data)
+
+	err := keycloakClient.UpdateRealmKeystoreRsaGenerated(keystore)
+	if err != nil {
+		return err
+	}
+
+	setRealmKeystoreRsaGeneratedData(data, keystore)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeystoreRsaGeneratedDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return keycloakClient.DeleteRealmKeystoreRsaGenerated(realmId, id)
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmKeystoreRsaGenerated_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	keystoreName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_realm_keystore_rsa_generated.keystore"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmKeystoreRsaGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsaGenerated_basic(realmName, keystoreName, 2048, 100),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaGeneratedExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+			{
+				Config: testKeycloakRealmKeystoreRsaGenerated_basic(realmName, keystoreName, 4096, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmKeystoreRsaGeneratedExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "4096"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsaGenerated_createAfterManualDestroy(t *testing.T) {
+	var keystore = &keycloak.RealmKeystoreRsaGenerated{}
+
+	realmName := "terraform-" + acctest.RandString(10)
+	keystoreName := "terraform-" + acctest.RandString(10)
+
+	resourceName := "keycloak_realm_keystore_rsa_generated.keystore"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmKeystoreRsaGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsaGenerated_basic(realmName, keystoreName, 2048, 100),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaGeneratedFetch(resourceName, keystore),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					err := keycloakClient.DeleteRealmKeystoreRsaGenerated(keystore.RealmId, keystore.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmKeystoreRsaGenerated_basic(realmName, keystoreName, 2048, 100),
+				Check:  testAccCheckKeycloakRealmKeystoreRsaGeneratedExists(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmKeystoreRsaGeneratedExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getRealmKeystoreRsaGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreRsaGeneratedFetch(resourceName string, keystore *keycloak.RealmKeystoreRsaGenerated) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedKeystore, err := getRealmKeystoreRsaGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		keystore.Id = fetchedKeystore.Id
+		keystore.RealmId = fetchedKeystore.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreRsaGeneratedDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_keystore_rsa_generated" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			keystore, _ := keycloakClient.GetRealmKeystoreRsaGenerated(realm, id)
+			if keystore != nil {
+				return fmt.Errorf("rsa generated keystore with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getRealmKeystoreRsaGeneratedFromState(s *terraform.State, resourceName string) (*keycloak.RealmKeystoreRsaGenerated, error) {
+	keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	keystore, err := keycloakClient.GetRealmKeystoreRsaGenerated(realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rsa generated keystore with id %s: %s", id, err)
+	}
+
+	return keystore, nil
+}
+
+func testKeycloakRealmKeystoreRsaGenerated_basic(realm, keystoreName string, keySize, priority int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa_generated" "keystore" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+
+	key_size = %d
+	priority = %d
+}
+	`, realm, keystoreName, keySize, priority)
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmKeystoreRsa_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	keystoreName := "terraform-" + acctest.RandString(10)
+	privateKey := generateRsaPrivateKeyPem(t)
+
+	resourceName := "keycloak_realm_keystore_rsa.keystore"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsa_basic(realmName, keystoreName, privateKey, "RS256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmKeystoreRsaExists(resourceName),
+					// keycloak generates a certificate for the key when one isn't given
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       getRealmKeystoreGenericImportId(resourceName),
+				ImportStateVerifyIgnore: []string{"private_key"},
+			},
+			{
+				Config: testKeycloakRealmKeystoreRsa_basic(realmName, keystoreName, privateKey, "PS256"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "algorithm", "PS256"),
+			},
+		},
+	})
+}
+
+func TestKeycloakRealmKeystoreRsa_privateKeyChangeRegeneratesCertificate(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "f3f3a0b5-0d43-4b31-b7e8-5a6b0c3e1a9d",
+		Attributes: map[string]string{
+			"id":          "f3f3a0b5-0d43-4b31-b7e8-5a6b0c3e1a9d",
+			"name":        "rsa",
+			"realm_id":    "test",
+			"active":      "true",
+			"enabled":     "true",
+			"priority":    "0",
+			"algorithm":   "RS256",
+			"private_key": "old-key",
+			"certificate": "old-certificate",
+		},
+	}
+
+	diff, err := resourceKeycloakRealmKeystoreRsa().Diff(state, newTestResourceConfig(t, map[string]interface{}{
+		"name":        "rsa",
+		"realm_id":    "test",
+		"private_key": "new-key",
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if certificate, ok := diff.Attributes["certificate"]; !ok || !certificate.NewComputed {
+		t.Fatalf("expected the certificate to be recomputed when only the private key changes, got %+v", diff.Attributes["certificate"])
+	}
+
+	diff, err = resourceKeycloakRealmKeystoreRsa().Diff(state, newTestResourceConfig(t, map[string]interface{}{
+		"name":        "rsa",
+		"realm_id":    "test",
+		"private_key": "new-key",
+		"certificate": "new-certificate",
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if certificate := diff.Attributes["certificate"]; certificate == nil || certificate.NewComputed || certificate.New != "new-certificate" {
+		t.Fatalf("expected the configured certificate to be used, got %+v", certificate)
+	}
+}
+
+func newTestResourceConfig(t *testing.T, raw map[string]interface{}) *terraform.ResourceConfig {
+	rawConfig, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return terraform.NewResourceConfig(rawConfig)
+}
+
+func generateRsaPrivateKeyPem(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+}
+
+func getRealmKeystoreGenericImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreRsaExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetRealmKeystoreRsa(rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting rsa keystore with id %s: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeystoreRsaDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_keystore_rsa" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			keystore, _ := keycloakClient.GetRealmKeystoreRsa(realm, id)
+			if keystore != nil {
+				return fmt.Errorf("rsa keystore with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmKeystoreRsa_basic(realm, keystoreName, privateKey, algorithm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa" "keystore" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	algorithm = "%s"
+	priority  = 100
+
+	private_key = <<EOT
+%sEOT
+}
+	`, realm, keystoreName, algorithm, privateKey)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+func resourceKeycloakRealmLocalization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmLocalizationCreate,
+		Read:   resourceKeycloakRealmLocalizationRead,
+		Update: resourceKeycloakRealmLocalizationUpdate,
+		Delete: resourceKeycloakRealmLocalizationDelete,
+		// This resource can be imported using {{realm}}/{{locale}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmLocalizationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// this resource manages every text for the locale, so texts added outside of terraform show up as a change
+			"texts": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func realmLocalizationId(realmId, locale string) string {
+	return fmt.Sprintf("%s/%s", realmId, locale)
+}
+
+func getRealmLocalizationTextsFromData(texts interface{}) map[string]string {
+	result := make(map[string]string)
+
+	for key, text := range texts.(map[string]interface{}) {
+		result[key] = text.(string)
+	}
+
+	return result
+}
+
+func resourceKeycloakRealmLocalizationCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	locale := data.Get("locale").(string)
+
+	texts := getRealmLocalizationTextsFromData(data.Get("texts"))
+	if len(texts) != 0 {
+		err := keycloakClient.UpdateRealmLocalizationTexts(realmId, locale, texts)
+		if err != nil {
+			return err
+		}
+	}
+
+	data.SetId(realmLocalizationId(realmId, locale))
+
+	return resourceKeycloakRealmLocalizationRead(data, meta)
+}
+
+func resourceKeycloakRealmLocalizationRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	texts, err := keycloakClient.GetRealmLocalizationTexts(data.Get("realm_id").(string), data.Get("locale").(string))
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	data.Set("texts", texts)
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	locale := data.Get("locale").(string)
+
+	oldTexts, newTexts := data.GetChange("texts")
+	texts := getRealmLocalizationTextsFromData(newTexts)
+
+	if len(texts) != 0 {
+		err := keycloakClient.UpdateRealmLocalizationTexts(realmId, locale, texts)
+		if err != nil {
+			return err
+		}
+	}
+
+	for key := range getRealmLocalizationTextsFromData(oldTexts) {
+		if _, ok := texts[key]; ok {
+			continue
+		}
+
+		err := keycloakClient.DeleteRealmLocalizationText(realmId, locale, key)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return err
+		}
+	}
+
+	return resourceKeycloakRealmLocalizationRead(data, meta)
+}
+
+func resourceKeycloakRealmLocalizationDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return keycloakClient.DeleteRealmLocalizationTexts(data.Get("realm_id").(string), data.Get("locale").(string))
+}
+
+func resourceKeycloakRealmLocalizationImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{locale}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("locale", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
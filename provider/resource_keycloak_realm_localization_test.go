@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakRealmLocalization_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	resourceName := "keycloak_realm_localization.localization"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmLocalizationDestroy(realmName, "de"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, map[string]string{
+					"loginTitle":   "Anmelden",
+					"doLogIn":      "Los",
+					"emailSubject": "Hallo",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmLocalizationTexts(realmName, "de", map[string]string{
+						"loginTitle":   "Anmelden",
+						"doLogIn":      "Los",
+						"emailSubject": "Hallo",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "texts.%", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     realmName + "/de",
+				ImportStateVerify: true,
+			},
+			// changing one text and removing another should leave only what's configured
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, map[string]string{
+					"loginTitle": "Willkommen",
+					"doLogIn":    "Los",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmLocalizationTexts(realmName, "de", map[string]string{
+						"loginTitle": "Willkommen",
+						"doLogIn":    "Los",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "texts.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmLocalization_textAddedOutsideOfTerraform(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	texts := map[string]string{
+		"loginTitle": "Anmelden",
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmLocalizationDestroy(realmName, "de"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, texts),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					err := keycloakClient.UpdateRealmLocalizationTexts(realmName, "de", map[string]string{"doLogIn": "Los"})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmLocalization_basic(realmName, texts),
+				Check:  testAccCheckKeycloakRealmLocalizationTexts(realmName, "de", texts),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmLocalizationTexts(realmName, locale string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		texts, err := keycloakClient.GetRealmLocalizationTexts(realmName, locale)
+		if err != nil {
+			return err
+		}
+
+		if len(texts) != len(expected) {
+			return fmt.Errorf("expected locale %s to have %d texts, got %v", locale, len(expected), texts)
+		}
+
+		for key, text := range expected {
+			if texts[key] != text {
+				return fmt.Errorf("expected text for key %s in locale %s to be %s, got %s", key, locale, text, texts[key])
+			}
+		}
+
+		return nil
+	}
+}
+
+// the realm may be destroyed before the texts are checked, in which case there's nothing left to check
+func testAccCheckKeycloakRealmLocalizationDestroy(realmName, locale string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		texts, err := keycloakClient.GetRealmLocalizationTexts(realmName, locale)
+		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if len(texts) != 0 {
+			return fmt.Errorf("expected locale %s in realm %s to have no texts, got %v", locale, realmName, texts)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmLocalization_basic(realmName string, texts map[string]string) string {
+	var textsConfig string
+	for key, text := range texts {
+		textsConfig += fmt.Sprintf("\t\t%s = \"%s\"\n", key, text)
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_localization" "localization" {
+	realm_id = "${keycloak_realm.realm.id}"
+	locale   = "de"
+
+	texts = {
+%s	}
+}
+	`, realmName, textsConfig)
+}
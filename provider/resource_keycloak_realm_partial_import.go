@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakRealmPartialImportIfResourceExists = []string{"FAIL", "SKIP", "OVERWRITE"}
+
+// the resources created by a partial import can't be told apart from any others in the realm, so they are not tracked
+// individually. every argument forces a new import, and destroying this resource leaves everything it imported in place
+func resourceKeycloakRealmPartialImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmPartialImportCreate,
+		Read:   resourceKeycloakRealmPartialImportRead,
+		Delete: resourceKeycloakRealmPartialImportDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"if_resource_exists": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "FAIL",
+				ValidateFunc: validation.StringInSlice(keycloakRealmPartialImportIfResourceExists, false),
+			},
+			"user": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
+						"realm_roles": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+							ForceNew: true,
+						},
+						// the paths of the groups the user is a member of, such as "/parent/child"
+						"groups": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
+						"realm_roles": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"realm_role": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"added": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"skipped": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"overwritten": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getPartialImportAttributes(attributes map[string]interface{}) map[string][]string {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	partialImportAttributes := make(map[string][]string)
+	for key, value := range attributes {
+		partialImportAttributes[key] = splitLen(value.(string), MAX_ATTRIBUTE_VALUE_LEN)
+	}
+
+	return partialImportAttributes
+}
+
+func getRealmPartialImportFromData(data *schema.ResourceData) *keycloak.PartialImport {
+	partialImport := &keycloak.PartialImport{
+		RealmId:          data.Get("realm_id").(string),
+		IfResourceExists: data.Get("if_resource_exists").(string),
+	}
+
+	for _, u := range data.Get("user").([]interface{}) {
+		user := u.(map[string]interface{})
+
+		partialImport.Users = append(partialImport.Users, &keycloak.PartialImportUser{
+			Username:   user["username"].(string),
+			Email:      user["email"].(string),
+			FirstName:  user["first_name"].(string),
+			LastName:   user["last_name"].(string),
+			Enabled:    user["enabled"].(bool),
+			Attributes: getPartialImportAttributes(user["attributes"].(map[string]interface{})),
+			RealmRoles: interfaceSliceToStringSlice(user["realm_roles"].([]interface{})),
+			Groups:     interfaceSliceToStringSlice(user["groups"].([]interface{})),
+		})
+	}
+
+	for _, g := range data.Get("group").([]interface{}) {
+		group := g.(map[string]interface{})
+
+		partialImport.Groups = append(partialImport.Groups, &keycloak.Group{
+			Name:       group["name"].(string),
+			Attributes: getPartialImportAttributes(group["attributes"].(map[string]interface{})),
+			RealmRoles: interfaceSliceToStringSlice(group["realm_roles"].([]interface{})),
+		})
+	}
+
+	if realmRoles := data.Get("realm_role").([]interface{}); len(realmRoles) != 0 {
+		partialImport.Roles = &keycloak.PartialImportRoles{}
+
+		for _, r := range realmRoles {
+			role := r.(map[string]interface{})
+
+			partialImport.Roles.Realm = append(partialImport.Roles.Realm, &keycloak.Role{
+				Name:        role["name"].(string),
+				Description: role["description"].(string),
+			})
+		}
+	}
+
+	return partialImport
+}
+
+func resourceKeycloakRealmPartialImportCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	partialImport := getRealmPartialImportFromData(data)
+
+	result, err := keycloakClient.PartialImport(partialImport)
+	if err != nil {
+		return err
+	}
+
+	data.Set("added", result.Added)
+	data.Set("skipped", result.Skipped)
+	data.Set("overwritten", result.Overwritten)
+	data.SetId(partialImport.RealmId)
+
+	return resourceKeycloakRealmPartialImportRead(data, meta)
+}
+
+// there is nothing to read back, but if the realm is gone then so is everything that was imported into it
+func resourceKeycloakRealmPartialImportRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	_, err := keycloakClient.GetRealm(data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmPartialImportDelete(data *schema.ResourceData, _ interface{}) error {
+	log.Printf("[DEBUG] Removing partial import for realm %s from state. The users, groups, and roles it imported are kept", data.Get("realm_id").(string))
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmPartialImport_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+	groupName := "terraform-group-" + acctest.RandString(10)
+	usernameOne := "terraform-user-" + acctest.RandString(10)
+	usernameTwo := "terraform-user-" + acctest.RandString(10)
+
+	resourceName := "keycloak_realm_partial_import.import"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmPartialImport_basic(realmName, roleName, groupName, usernameOne, usernameTwo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "added", "4"),
+					resource.TestCheckResourceAttr(resourceName, "skipped", "0"),
+					resource.TestCheckResourceAttr(resourceName, "overwritten", "0"),
+					testAccCheckKeycloakUserIsInGroup(realmName, usernameOne, groupName),
+					testAccCheckKeycloakUserIsInGroup(realmName, usernameTwo, groupName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmPartialImport_skipExistingUser(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resourceName := "keycloak_realm_partial_import.import"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmPartialImport_existingUser(realmName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "added", "0"),
+					resource.TestCheckResourceAttr(resourceName, "skipped", "1"),
+					testAccCheckKeycloakUserHasFirstName(realmName, username, "Existing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserIsInGroup(realmId, username, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		user, err := keycloakClient.GetUserByUsername(realmId, username)
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return fmt.Errorf("expected user %s to have been imported into realm %s", username, realmId)
+		}
+
+		groups, err := keycloakClient.GetUserGroups(realmId, user.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, group := range groups {
+			if group.Name == groupName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected user %s to be a member of group %s", username, groupName)
+	}
+}
+
+// used to make sure a user that already existed is left as is
+func testAccCheckKeycloakUserHasFirstName(realmId, username, firstName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		user, err := keycloakClient.GetUserByUsername(realmId, username)
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return fmt.Errorf("user %s does not exist in realm %s", username, realmId)
+		}
+
+		if user.FirstName != firstName {
+			return fmt.Errorf("expected user %s to have first name %s, got %s", username, firstName, user.FirstName)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmPartialImport_basic(realm, roleName, groupName, usernameOne, usernameTwo string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%[1]s"
+}
+
+resource "keycloak_realm_partial_import" "import" {
+	realm_id = "${keycloak_realm.realm.id}"
+
+	realm_role {
+		name        = "%[2]s"
+		description = "imported role"
+	}
+
+	group {
+		name        = "%[3]s"
+		realm_roles = ["%[2]s"]
+	}
+
+	user {
+		username   = "%[4]s"
+		email      = "%[4]s@example.com"
+		first_name = "One"
+		groups     = ["/%[3]s"]
+
+		attributes = {
+			foo = "bar"
+		}
+	}
+
+	user {
+		username    = "%[5]s"
+		enabled     = false
+		groups      = ["/%[3]s"]
+		realm_roles = ["%[2]s"]
+	}
+}
+	`, realm, roleName, groupName, usernameOne, usernameTwo)
+}
+
+func testKeycloakRealmPartialImport_existingUser(realm, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%[1]s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id   = "${keycloak_realm.realm.id}"
+	username   = "%[2]s"
+	first_name = "Existing"
+}
+
+resource "keycloak_realm_partial_import" "import" {
+	realm_id           = "${keycloak_realm.realm.id}"
+	if_resource_exists = "SKIP"
+
+	user {
+		username   = "${keycloak_user.user.username}"
+		first_name = "Imported"
+	}
+}
+	`, realm, username)
+}
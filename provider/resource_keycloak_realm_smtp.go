@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// keycloak never returns the smtp password, it responds with this instead
+const realmSmtpMaskedPassword = "**********"
+
+func resourceKeycloakRealmSmtp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmSmtpCreate,
+		Read:   resourceKeycloakRealmSmtpRead,
+		Update: resourceKeycloakRealmSmtpUpdate,
+		Delete: resourceKeycloakRealmSmtpDelete,
+		// This resource can be imported using {{realm}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmSmtpImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"port": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"from_display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reply_to": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reply_to_display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"envelope_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"starttls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ssl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"auth": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getSmtpServerFromData(data *schema.ResourceData) keycloak.SmtpServer {
+	smtpServer := keycloak.SmtpServer{
+		Host:               data.Get("host").(string),
+		Port:               data.Get("port").(string),
+		From:               data.Get("from").(string),
+		FromDisplayName:    data.Get("from_display_name").(string),
+		ReplyTo:            data.Get("reply_to").(string),
+		ReplyToDisplayName: data.Get("reply_to_display_name").(string),
+		EnvelopeFrom:       data.Get("envelope_from").(string),
+		StartTls:           keycloak.KeycloakBoolQuoted(data.Get("starttls").(bool)),
+		Ssl:                keycloak.KeycloakBoolQuoted(data.Get("ssl").(bool)),
+	}
+
+	if authConfig := data.Get("auth").([]interface{}); len(authConfig) == 1 {
+		auth := authConfig[0].(map[string]interface{})
+
+		smtpServer.Auth = true
+		smtpServer.User = auth["username"].(string)
+		smtpServer.Password = auth["password"].(string)
+	}
+
+	return smtpServer
+}
+
+func setSmtpServerData(data *schema.ResourceData, smtpServer keycloak.SmtpServer) {
+	data.Set("host", smtpServer.Host)
+	data.Set("port", smtpServer.Port)
+	data.Set("from", smtpServer.From)
+	data.Set("from_display_name", smtpServer.FromDisplayName)
+	data.Set("reply_to", smtpServer.ReplyTo)
+	data.Set("reply_to_display_name", smtpServer.ReplyToDisplayName)
+	data.Set("envelope_from", smtpServer.EnvelopeFrom)
+	data.Set("starttls", bool(smtpServer.StartTls))
+	data.Set("ssl", bool(smtpServer.Ssl))
+
+	if !smtpServer.Auth {
+		data.Set("auth", nil)
+
+		return
+	}
+
+	password := smtpServer.Password
+	// the password can't be read back, so the last known password is kept. this means a password changed outside of terraform won't be detected
+	if password == realmSmtpMaskedPassword {
+		password = ""
+		if authConfig := data.Get("auth").([]interface{}); len(authConfig) == 1 {
+			password = authConfig[0].(map[string]interface{})["password"].(string)
+		}
+	}
+
+	data.Set("auth", []interface{}{
+		map[string]interface{}{
+			"username": smtpServer.User,
+			"password": password,
+		},
+	})
+}
+
+func resourceKeycloakRealmSmtpCreate(data *schema.ResourceData, meta interface{}) error {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmSmtpUpdate(data, meta)
+}
+
+func resourceKeycloakRealmSmtpRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	// an empty smtp config means the config was removed outside of terraform
+	if (keycloak.SmtpServer{}) == realm.SmtpServer {
+		data.SetId("")
+
+		return nil
+	}
+
+	setSmtpServerData(data, realm.SmtpServer)
+
+	return nil
+}
+
+func resourceKeycloakRealmSmtpUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// the smtp config is part of the realm, so the realm is read first to avoid changing any of its other settings
+	realm, err := keycloakClient.GetRealm(data.Get("realm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	realm.SmtpServer = getSmtpServerFromData(data)
+
+	err = keycloakClient.UpdateRealm(realm)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakRealmSmtpRead(data, meta)
+}
+
+func resourceKeycloakRealmSmtpDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(data.Get("realm_id").(string))
+	if err != nil {
+		// the smtp config was deleted along with the realm, so there's nothing left to do
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	realm.SmtpServer = keycloak.SmtpServer{}
+
+	return keycloakClient.UpdateRealm(realm)
+}
+
+func resourceKeycloakRealmSmtpImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakRealmSmtp_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	resourceName := "keycloak_realm_smtp.realm_smtp"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmSmtpDestroy(realmName),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtp_basic(realmName, "myhost.com", "user", "password"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSmtpHost(realmName, "myhost.com"),
+					resource.TestCheckResourceAttr(resourceName, "auth.0.username", "user"),
+					resource.TestCheckResourceAttr(resourceName, "auth.0.password", "password"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth.0.password"},
+			},
+			// changing only the password has to be detected, even though keycloak never returns it
+			{
+				Config: testKeycloakRealmSmtp_basic(realmName, "myhost.com", "user", "new-password"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "auth.0.password", "new-password"),
+			},
+			{
+				Config: testKeycloakRealmSmtp_basic(realmName, "myhost2.com", "user2", "new-password"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSmtpHost(realmName, "myhost2.com"),
+					resource.TestCheckResourceAttr(resourceName, "auth.0.username", "user2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtp_removedFromRealm(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmSmtpDestroy(realmName),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtp_basic(realmName, "myhost.com", "user", "password"),
+				Check:  testAccCheckKeycloakRealmSmtpHost(realmName, "myhost.com"),
+			},
+			{
+				Config: testKeycloakRealmSmtp_realmOnly(realmName),
+				Check:  testAccCheckKeycloakRealmSmtpHost(realmName, ""),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmSmtpHost(realmName, host string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		realm, err := keycloakClient.GetRealm(realmName)
+		if err != nil {
+			return err
+		}
+
+		if realm.SmtpServer.Host != host {
+			return fmt.Errorf("expected realm %s to have smtp host %s, but got %s", realmName, host, realm.SmtpServer.Host)
+		}
+
+		return nil
+	}
+}
+
+// the realm is destroyed along with the smtp config, so this only checks that the realm is gone
+func testAccCheckKeycloakRealmSmtpDestroy(realmName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		realm, _ := keycloakClient.GetRealm(realmName)
+		if realm != nil {
+			return fmt.Errorf("realm %s still exists", realmName)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmSmtp_basic(realmName, host, username, password string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_smtp" "realm_smtp" {
+	realm_id = "${keycloak_realm.realm.id}"
+	host     = "%s"
+	port     = "25"
+	from     = "no-reply@example.com"
+	starttls = true
+
+	auth {
+		username = "%s"
+		password = "%s"
+	}
+}
+	`, realmName, host, username, password)
+}
+
+func testKeycloakRealmSmtp_realmOnly(realmName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+	`, realmName)
+}
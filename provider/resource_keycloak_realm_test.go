@@ -482,6 +482,113 @@ func TestAccKeycloakRealm_securityDefensesBruteForceDetection(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_securityDefensesBruteForceDetectionEnabledOutsideTerraform(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmDisplayName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_basic(realmName, realmDisplayName),
+				Check:  testAccCheckKeycloakRealmSecurityDefensesBruteForceDetection("keycloak_realm.realm", false),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					realm, err := keycloakClient.GetRealm(realmName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					realm.BruteForceProtected = true
+
+					err = keycloakClient.UpdateRealm(realm)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testKeycloakRealm_basic(realmName, realmDisplayName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKeycloakRealm_basic(realmName, realmDisplayName),
+				Check:  testAccCheckKeycloakRealmSecurityDefensesBruteForceDetection("keycloak_realm.realm", false),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_otpPolicy(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmDisplayName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_basic(realmName, realmDisplayName),
+				Check:  testAccCheckKeycloakRealmOtpPolicy("keycloak_realm.realm", "totp", "HmacSHA1", 6, 0),
+			},
+			{
+				Config: testKeycloakRealm_otpPolicy(realmName, realmDisplayName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmOtpPolicy("keycloak_realm.realm", "hotp", "HmacSHA256", 8, 5),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "otp_policy.0.look_ahead_window", "2"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm.realm",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testKeycloakRealm_otpPolicy(realmName, realmDisplayName, 7),
+				ExpectError: regexp.MustCompile("expected otp_policy.0.digits to be one of \\[6 8\\], got 7"),
+			},
+			{
+				Config: testKeycloakRealm_basic(realmName, realmDisplayName),
+				Check:  testAccCheckKeycloakRealmOtpPolicy("keycloak_realm.realm", "totp", "HmacSHA1", 6, 0),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_webAuthnPasswordlessPolicy(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmDisplayName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_webAuthnPasswordlessPolicy(realmName, realmDisplayName, "required"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmWebAuthnPasswordlessPolicy("keycloak_realm.realm", "Yes", "required"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "web_authn_passwordless_policy.0.signature_algorithms.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "web_authn_passwordless_policy.0.create_timeout", "60"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_webAuthnPasswordlessPolicy(realmName, realmDisplayName, "preferred"),
+				Check:  testAccCheckKeycloakRealmWebAuthnPasswordlessPolicy("keycloak_realm.realm", "Yes", "preferred"),
+			},
+			{
+				Config:      testKeycloakRealm_webAuthnPasswordlessPolicy(realmName, realmDisplayName, "always"),
+				ExpectError: regexp.MustCompile("expected web_authn_passwordless_policy.0.user_verification_requirement to be one of"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_securityDefenses(t *testing.T) {
 	realmName := "terraform-" + acctest.RandString(10)
 	realmDisplayName := "terraform-" + acctest.RandString(10)
@@ -579,6 +686,54 @@ func TestAccKeycloakRealm_passwordPolicy(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_passwordPolicySettings(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmDisplayName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_passwordPolicySettings(realmName, realmDisplayName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "digits(1) and length(8) and notUsername(undefined)"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy", ""),
+				),
+			},
+			{
+				// the same policies in a different order, and without the value the admin console sends, aren't drift
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					realm, err := keycloakClient.GetRealm(realmName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					realm.PasswordPolicy = "notUsername and length(8) and digits(1)"
+
+					err = keycloakClient.UpdateRealm(realm)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testKeycloakRealm_passwordPolicySettings(realmName, realmDisplayName, 8),
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakRealm_passwordPolicySettings(realmName, realmDisplayName, 12),
+				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "digits(1) and length(12) and notUsername(undefined)"),
+			},
+			{
+				Config: testKeycloakRealm_basic(realmName, realmDisplayName),
+				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", ""),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_browserFlow(t *testing.T) {
 	realmName := "terraform-" + acctest.RandString(10)
 	realmDisplayName := "terraform-" + acctest.RandString(10)
@@ -598,7 +753,12 @@ func TestAccKeycloakRealm_browserFlow(t *testing.T) {
 				Check:  testAccCheckKeycloakRealmBrowserFlow("keycloak_realm.realm", newBrowserFlow),
 			},
 			{
+				// bindings that aren't set are left alone, so they can be managed by `keycloak_authentication_bindings`
 				Config: testKeycloakRealm_basic(realmName, realmDisplayName),
+				Check:  testAccCheckKeycloakRealmBrowserFlow("keycloak_realm.realm", newBrowserFlow),
+			},
+			{
+				Config: testKeycloakRealm_browserFlow(realmName, realmDisplayName, "browser"),
 				Check:  testAccCheckKeycloakRealmBrowserFlow("keycloak_realm.realm", "browser"),
 			},
 		},
@@ -903,6 +1063,52 @@ func testAccCheckKeycloakRealmSecurityDefensesBruteForceDetectionFailureFactor(r
 	}
 }
 
+func testAccCheckKeycloakRealmOtpPolicy(resourceName, otpPolicyType, algorithm string, digits, initialCounter int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if realm.OtpPolicyType != otpPolicyType {
+			return fmt.Errorf("expected realm %s to have otpPolicyType %s, but was %s", realm.Realm, otpPolicyType, realm.OtpPolicyType)
+		}
+
+		if realm.OtpPolicyAlgorithm != algorithm {
+			return fmt.Errorf("expected realm %s to have otpPolicyAlgorithm %s, but was %s", realm.Realm, algorithm, realm.OtpPolicyAlgorithm)
+		}
+
+		if realm.OtpPolicyDigits != digits {
+			return fmt.Errorf("expected realm %s to have otpPolicyDigits %d, but was %d", realm.Realm, digits, realm.OtpPolicyDigits)
+		}
+
+		if realm.OtpPolicyInitialCounter != initialCounter {
+			return fmt.Errorf("expected realm %s to have otpPolicyInitialCounter %d, but was %d", realm.Realm, initialCounter, realm.OtpPolicyInitialCounter)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmWebAuthnPasswordlessPolicy(resourceName, requireResidentKey, userVerificationRequirement string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if realm.WebAuthnPolicyPasswordlessRequireResidentKey != requireResidentKey {
+			return fmt.Errorf("expected realm %s to have webAuthnPolicyPasswordlessRequireResidentKey %s, but was %s", realm.Realm, requireResidentKey, realm.WebAuthnPolicyPasswordlessRequireResidentKey)
+		}
+
+		if realm.WebAuthnPolicyPasswordlessUserVerificationRequirement != userVerificationRequirement {
+			return fmt.Errorf("expected realm %s to have webAuthnPolicyPasswordlessUserVerificationRequirement %s, but was %s", realm.Realm, userVerificationRequirement, realm.WebAuthnPolicyPasswordlessUserVerificationRequirement)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakRealmPasswordPolicy(resourceName, passwordPolicy string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		realm, err := getRealmFromState(s, resourceName)
@@ -1233,6 +1439,43 @@ resource "keycloak_realm" "realm" {
 	`, realm, realmDisplayName, maxLoginFailures)
 }
 
+func testKeycloakRealm_otpPolicy(realm, realmDisplayName string, digits int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+
+	otp_policy {
+		type              = "hotp"
+		algorithm         = "HmacSHA256"
+		digits            = %d
+		look_ahead_window = 2
+		initial_counter   = 5
+	}
+}
+	`, realm, realmDisplayName, digits)
+}
+
+func testKeycloakRealm_webAuthnPasswordlessPolicy(realm, realmDisplayName, userVerificationRequirement string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+
+	web_authn_passwordless_policy {
+		rp_entity_name                = "example"
+		signature_algorithms          = ["ES256", "RS256"]
+		authenticator_attachment      = "platform"
+		require_resident_key          = "Yes"
+		user_verification_requirement = "%s"
+		create_timeout                = 60
+	}
+}
+	`, realm, realmDisplayName, userVerificationRequirement)
+}
+
 func testKeycloakRealm_securityDefenses(realm, realmDisplayName, xFrameOptions string, maxLoginFailures int) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1274,6 +1517,22 @@ resource "keycloak_realm" "realm" {
 	`, realm, realmDisplayName, passwordPolicy)
 }
 
+func testKeycloakRealm_passwordPolicySettings(realm, realmDisplayName string, length int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+
+	password_policy_settings {
+		length       = %d
+		digits       = 1
+		not_username = true
+	}
+}
+	`, realm, realmDisplayName, length)
+}
+
 func testKeycloakRealm_browserFlow(realm, realmDisplayName, browserFlow string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
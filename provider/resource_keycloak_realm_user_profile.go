@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+var keycloakRealmUserProfileUnmanagedAttributePolicies = []string{"ENABLED", "ADMIN_VIEW", "ADMIN_EDIT"}
+
+func resourceKeycloakRealmUserProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakRealmUserProfileCreate,
+		Read:   resourceKeycloakRealmUserProfileRead,
+		Update: resourceKeycloakRealmUserProfileUpdate,
+		Delete: resourceKeycloakRealmUserProfileDelete,
+		// This resource can be imported using {{realm}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakRealmUserProfileImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"unmanaged_attribute_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakRealmUserProfileUnmanagedAttributePolicies, false),
+			},
+			"attribute": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"group": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled_when_scope": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Optional: true,
+						},
+						"required_for_roles": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Optional: true,
+						},
+						"required_for_scopes": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Optional: true,
+						},
+						"permissions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"view": {
+										Type:     schema.TypeSet,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+										Optional: true,
+									},
+									"edit": {
+										Type:     schema.TypeSet,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"validator": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"config": {
+										Type:     schema.TypeMap,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Optional: true,
+									},
+								},
+							},
+						},
+						"annotations": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+					},
+				},
+			},
+			"group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"display_header": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"display_description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"annotations": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validator configs and annotations can hold any JSON value, but terraform maps can only hold strings. values that are
+// JSON arrays or objects (such as the options for the `options` validator) are written in terraform as JSON
+func userProfileValueFromString(value string) interface{} {
+	if strings.HasPrefix(value, "[") || strings.HasPrefix(value, "{") {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err == nil {
+			return decoded
+		}
+	}
+
+	return value
+}
+
+func userProfileValueToString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(encoded)
+}
+
+func userProfileMapFromData(data interface{}) map[string]interface{} {
+	values := make(map[string]interface{})
+
+	for k, v := range data.(map[string]interface{}) {
+		values[k] = userProfileValueFromString(v.(string))
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return values
+}
+
+func userProfileMapToData(values map[string]interface{}) map[string]interface{} {
+	data := make(map[string]interface{})
+
+	for k, v := range values {
+		data[k] = userProfileValueToString(v)
+	}
+
+	return data
+}
+
+func getRealmUserProfileFromData(data *schema.ResourceData) *keycloak.RealmUserProfile {
+	realmUserProfile := &keycloak.RealmUserProfile{
+		Attributes:               []*keycloak.RealmUserProfileAttribute{},
+		UnmanagedAttributePolicy: data.Get("unmanaged_attribute_policy").(string),
+	}
+
+	for _, a := range data.Get("attribute").([]interface{}) {
+		attributeData := a.(map[string]interface{})
+
+		attribute := &keycloak.RealmUserProfileAttribute{
+			Name:        attributeData["name"].(string),
+			DisplayName: attributeData["display_name"].(string),
+			Group:       attributeData["group"].(string),
+			Annotations: userProfileMapFromData(attributeData["annotations"]),
+		}
+
+		if scopes := attributeData["enabled_when_scope"].(*schema.Set); scopes.Len() != 0 {
+			attribute.Selector = &keycloak.RealmUserProfileSelector{
+				Scopes: interfaceSliceToStringSlice(scopes.List()),
+			}
+		}
+
+		roles := attributeData["required_for_roles"].(*schema.Set)
+		scopes := attributeData["required_for_scopes"].(*schema.Set)
+		if roles.Len() != 0 || scopes.Len() != 0 {
+			attribute.Required = &keycloak.RealmUserProfileRequired{
+				Roles:  interfaceSliceToStringSlice(roles.List()),
+				Scopes: interfaceSliceToStringSlice(scopes.List()),
+			}
+		}
+
+		if permissions := attributeData["permissions"].([]interface{}); len(permissions) == 1 && permissions[0] != nil {
+			permissionsData := permissions[0].(map[string]interface{})
+
+			attribute.Permissions = &keycloak.RealmUserProfilePermissions{
+				View: interfaceSliceToStringSlice(permissionsData["view"].(*schema.Set).List()),
+				Edit: interfaceSliceToStringSlice(permissionsData["edit"].(*schema.Set).List()),
+			}
+		}
+
+		for _, v := range attributeData["validator"].(*schema.Set).List() {
+			validatorData := v.(map[string]interface{})
+
+			if attribute.Validations == nil {
+				attribute.Validations = make(map[string]map[string]interface{})
+			}
+
+			config := userProfileMapFromData(validatorData["config"])
+			if config == nil {
+				config = make(map[string]interface{})
+			}
+
+			attribute.Validations[validatorData["name"].(string)] = config
+		}
+
+		realmUserProfile.Attributes = append(realmUserProfile.Attributes, attribute)
+	}
+
+	for _, g := range data.Get("group").([]interface{}) {
+		groupData := g.(map[string]interface{})
+
+		realmUserProfile.Groups = append(realmUserProfile.Groups, &keycloak.RealmUserProfileGroup{
+			Name:               groupData["name"].(string),
+			DisplayHeader:      groupData["display_header"].(string),
+			DisplayDescription: groupData["display_description"].(string),
+			Annotations:        userProfileMapFromData(groupData["annotations"]),
+		})
+	}
+
+	return realmUserProfile
+}
+
+func setRealmUserProfileData(data *schema.ResourceData, realmUserProfile *keycloak.RealmUserProfile) {
+	var attributes []interface{}
+	for _, attribute := range realmUserProfile.Attributes {
+		attributeData := map[string]interface{}{
+			"name":         attribute.Name,
+			"display_name": attribute.DisplayName,
+			"group":        attribute.Group,
+			"annotations":  userProfileMapToData(attribute.Annotations),
+		}
+
+		if attribute.Selector != nil {
+			attributeData["enabled_when_scope"] = attribute.Selector.Scopes
+		}
+
+		if attribute.Required != nil {
+			attributeData["required_for_roles"] = attribute.Required.Roles
+			attributeData["required_for_scopes"] = attribute.Required.Scopes
+		}
+
+		if attribute.Permissions != nil {
+			attributeData["permissions"] = []interface{}{
+				map[string]interface{}{
+					"view": attribute.Permissions.View,
+					"edit": attribute.Permissions.Edit,
+				},
+			}
+		}
+
+		var validators []interface{}
+		for name, config := range attribute.Validations {
+			validators = append(validators, map[string]interface{}{
+				"name":   name,
+				"config": userProfileMapToData(config),
+			})
+		}
+		attributeData["validator"] = validators
+
+		attributes = append(attributes, attributeData)
+	}
+
+	var groups []interface{}
+	for _, group := range realmUserProfile.Groups {
+		groups = append(groups, map[string]interface{}{
+			"name":                group.Name,
+			"display_header":      group.DisplayHeader,
+			"display_description": group.DisplayDescription,
+			"annotations":         userProfileMapToData(group.Annotations),
+		})
+	}
+
+	data.Set("attribute", attributes)
+	data.Set("group", groups)
+	data.Set("unmanaged_attribute_policy", realmUserProfile.UnmanagedAttributePolicy)
+}
+
+func resourceKeycloakRealmUserProfileCreate(data *schema.ResourceData, meta interface{}) error {
+	data.SetId(data.Get("realm_id").(string))
+
+	return resourceKeycloakRealmUserProfileUpdate(data, meta)
+}
+
+func resourceKeycloakRealmUserProfileRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmUserProfile, err := keycloakClient.GetRealmUserProfile(data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	setRealmUserProfileData(data, realmUserProfile)
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.UpdateRealmUserProfile(data.Get("realm_id").(string), getRealmUserProfileFromData(data))
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakRealmUserProfileRead(data, meta)
+}
+
+// a realm always has a user profile, so it can't be deleted. instead, it's reset to only contain the attributes that
+// keycloak requires
+func resourceKeycloakRealmUserProfileDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return keycloakClient.UpdateRealmUserProfile(data.Get("realm_id").(string), &keycloak.RealmUserProfile{
+		Attributes: []*keycloak.RealmUserProfileAttribute{
+			{Name: "username"},
+			{Name: "email"},
+		},
+	})
+}
+
+func resourceKeycloakRealmUserProfileImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+// the user profile is always enabled starting with keycloak 24
+const userProfileMinimumKeycloakVersion = 24
+
+func TestAccKeycloakRealmUserProfile_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckKeycloakVersion(t, userProfileMinimumKeycloakVersion)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfile_basic(realmName, "Department"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmUserProfileHasAttribute("keycloak_realm_user_profile.user_profile", "department"),
+					resource.TestCheckResourceAttr("keycloak_realm_user_profile.user_profile", "attribute.2.display_name", "Department"),
+					resource.TestCheckResourceAttr("keycloak_realm_user_profile.user_profile", "unmanaged_attribute_policy", "ADMIN_VIEW"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_user_profile.user_profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmUserProfile_basic(realmName, "Team"),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_user_profile.user_profile", "attribute.2.display_name", "Team"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmUserProfileHasAttribute(resourceName, attributeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmUserProfile, err := keycloakClient.GetRealmUserProfile(rs.Primary.Attributes["realm_id"])
+		if err != nil {
+			return err
+		}
+
+		for _, attribute := range realmUserProfile.Attributes {
+			if attribute.Name == attributeName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected user profile to have attribute %s", attributeName)
+	}
+}
+
+func testKeycloakRealmUserProfile_basic(realmName, displayName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_user_profile" "user_profile" {
+	realm_id                   = "${keycloak_realm.realm.id}"
+	unmanaged_attribute_policy = "ADMIN_VIEW"
+
+	attribute {
+		name = "username"
+	}
+
+	attribute {
+		name = "email"
+
+		validator {
+			name = "email"
+		}
+	}
+
+	attribute {
+		name               = "department"
+		display_name       = "%s"
+		group              = "work"
+		required_for_roles = ["user"]
+
+		permissions {
+			view = ["admin", "user"]
+			edit = ["admin"]
+		}
+
+		validator {
+			name   = "options"
+			config = {
+				options = "[\"engineering\",\"sales\"]"
+			}
+		}
+
+		annotations = {
+			inputType = "select"
+		}
+	}
+
+	group {
+		name           = "work"
+		display_header = "Work information"
+	}
+}
+	`, realmName, displayName)
+}
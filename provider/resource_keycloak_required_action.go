@@ -22,10 +22,12 @@ func resourceKeycloakRequiredAction() *schema.Resource {
 			"realm_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"alias": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -44,11 +46,21 @@ func resourceKeycloakRequiredAction() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"config": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
 		},
 	}
 }
 
 func getRequiredActionFromData(data *schema.ResourceData) (*keycloak.RequiredAction, error) {
+	config := make(map[string]string)
+	for key, value := range data.Get("config").(map[string]interface{}) {
+		config[key] = value.(string)
+	}
+
 	action := &keycloak.RequiredAction{
 		Id:            fmt.Sprintf("%s/%s", data.Get("realm_id").(string), data.Get("alias").(string)),
 		RealmId:       data.Get("realm_id").(string),
@@ -57,7 +69,7 @@ func getRequiredActionFromData(data *schema.ResourceData) (*keycloak.RequiredAct
 		Enabled:       data.Get("enabled").(bool),
 		DefaultAction: data.Get("default_action").(bool),
 		Priority:      data.Get("priority").(int),
-		Config:        make(map[string][]string),
+		Config:        config,
 	}
 
 	return action, nil
@@ -71,6 +83,7 @@ func setRequiredActionData(data *schema.ResourceData, action *keycloak.RequiredA
 	data.Set("enabled", action.Enabled)
 	data.Set("default_action", action.DefaultAction)
 	data.Set("priority", action.Priority)
+	data.Set("config", action.Config)
 }
 
 func resourceKeycloakRequiredActionsCreate(data *schema.ResourceData, meta interface{}) error {
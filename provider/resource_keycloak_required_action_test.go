@@ -95,6 +95,35 @@ func TestAccKeycloakRequiredAction_computedPriority(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRequiredAction_config(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	requiredActionAlias := "UPDATE_PASSWORD"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRequiredAction_config(realmName, requiredActionAlias, "300"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRequiresActionHasConfig(realmName, requiredActionAlias, "max_auth_age", "300"),
+					resource.TestCheckResourceAttr("keycloak_required_action.required_action", "config.max_auth_age", "300"),
+				),
+			},
+			{
+				Config: testKeycloakRequiredAction_config(realmName, requiredActionAlias, "600"),
+				Check:  testAccCheckKeycloakRequiresActionHasConfig(realmName, requiredActionAlias, "max_auth_age", "600"),
+			},
+			{
+				ResourceName:      "keycloak_required_action.required_action",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName + "/" + requiredActionAlias,
+			},
+		},
+	})
+}
+
 func testKeycloakRequiredAction_basic(realm, requiredActionAlias string, priority int) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -180,6 +209,41 @@ func testAccCheckKeycloakRequiresActionExistsWithCorrectPriority(realm, required
 	}
 }
 
+func testKeycloakRequiredAction_config(realm, requiredActionAlias, maxAuthAge string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_required_action" "required_action" {
+	realm_id	= "${keycloak_realm.realm.realm}"
+	alias		= "%s"
+	enabled		= true
+	name		= "Update Password"
+
+	config = {
+		max_auth_age = "%s"
+	}
+}
+	`, realm, requiredActionAlias, maxAuthAge)
+}
+
+func testAccCheckKeycloakRequiresActionHasConfig(realm, requiredActionAlias, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+		action, err := keycloakClient.GetRequiredAction(realm, requiredActionAlias)
+		if err != nil {
+			return fmt.Errorf("required action not found: %s", requiredActionAlias)
+		}
+
+		if action.Config[key] != value {
+			return fmt.Errorf("expected required action to have config %s set to %s, but got %s", key, value, action.Config[key])
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakRequiresActionExists(realm, requiredActionAlias string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
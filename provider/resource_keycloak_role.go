@@ -59,10 +59,10 @@ func mapFromDataToRole(data *schema.ResourceData) *keycloak.Role {
 	return role
 }
 
+// `realm_id` isn't set here, since it may be the realm's internal ID rather than the name that `role.RealmId` is resolved to
 func mapFromRoleToData(data *schema.ResourceData, role *keycloak.Role) {
 	data.SetId(role.Id)
 
-	data.Set("realm_id", role.RealmId)
 	data.Set("client_id", role.ClientId)
 	data.Set("name", role.Name)
 	data.Set("description", role.Description)
@@ -73,6 +73,12 @@ func resourceKeycloakRoleCreate(data *schema.ResourceData, meta interface{}) err
 
 	role := mapFromDataToRole(data)
 
+	realmId, err := resolveRealm(keycloakClient, role.RealmId)
+	if err != nil {
+		return err
+	}
+	role.RealmId = realmId
+
 	var compositeRoles []*keycloak.Role
 	if v, ok := data.GetOk("composite_roles"); ok {
 		compositeRolesTf := v.(*schema.Set).List()
@@ -91,7 +97,7 @@ func resourceKeycloakRoleCreate(data *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	err := keycloakClient.CreateRole(role)
+	err = keycloakClient.CreateRole(role)
 	if err != nil {
 		return err
 	}
@@ -111,10 +117,13 @@ func resourceKeycloakRoleCreate(data *schema.ResourceData, meta interface{}) err
 func resourceKeycloakRoleRead(data *schema.ResourceData, meta interface{}) error {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	realmId := data.Get("realm_id").(string)
-	id := data.Id()
+	var role *keycloak.Role
+	_, err := withResolvedRealm(keycloakClient, data.Get("realm_id").(string), func(realmId string) error {
+		var err error
+		role, err = keycloakClient.GetRole(realmId, data.Id())
 
-	role, err := keycloakClient.GetRole(realmId, id)
+		return err
+	})
 	if err != nil {
 		return handleNotFoundError(err, data)
 	}
@@ -134,6 +143,9 @@ func resourceKeycloakRoleRead(data *schema.ResourceData, meta interface{}) error
 		}
 
 		data.Set("composite_roles", compositeRoleIds)
+	} else {
+		// the composites may have been removed outside of terraform, which keycloak reports by unsetting `composite`
+		data.Set("composite_roles", nil)
 	}
 
 	return nil
@@ -144,7 +156,12 @@ func resourceKeycloakRoleUpdate(data *schema.ResourceData, meta interface{}) err
 
 	role := mapFromDataToRole(data)
 
-	err := keycloakClient.UpdateRole(role)
+	// the realm that the update succeeded with is left in `role.RealmId` for the requests below
+	_, err := withResolvedRealm(keycloakClient, role.RealmId, func(realmId string) error {
+		role.RealmId = realmId
+
+		return keycloakClient.UpdateRole(role)
+	})
 	if err != nil {
 		return err
 	}
@@ -214,10 +231,11 @@ func resourceKeycloakRoleUpdate(data *schema.ResourceData, meta interface{}) err
 func resourceKeycloakRoleDelete(data *schema.ResourceData, meta interface{}) error {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	realmId := data.Get("realm_id").(string)
-	id := data.Id()
+	_, err := withResolvedRealm(keycloakClient, data.Get("realm_id").(string), func(realmId string) error {
+		return keycloakClient.DeleteRole(realmId, data.Id())
+	})
 
-	return keycloakClient.DeleteRole(realmId, id)
+	return err
 }
 
 func resourceKeycloakRoleImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
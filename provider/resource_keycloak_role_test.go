@@ -265,6 +265,62 @@ func TestAccKeycloakRole_composites(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRole_compositesRemovedOutsideOfTerraform(t *testing.T) {
+	role := &keycloak.Role{}
+
+	realmName := "terraform-" + acctest.RandString(10)
+	clientOne := "terraform-client-" + acctest.RandString(10)
+	clientTwo := "terraform-client-" + acctest.RandString(10)
+	roleOne := "terraform-role-one-" + acctest.RandString(10)
+	roleTwo := "terraform-role-two-" + acctest.RandString(10)
+	roleThree := "terraform-role-three-" + acctest.RandString(10)
+	roleFour := "terraform-role-four-" + acctest.RandString(10)
+	roleWithComposites := "terraform-role-with-composites-" + acctest.RandString(10)
+	roleWithCompositesResourceName := "keycloak_role.role_with_composites"
+
+	config := testKeycloakRole_composites(realmName, clientOne, clientTwo, roleOne, roleTwo, roleThree, roleFour, roleWithComposites, []string{
+		"${keycloak_role.role_1.id}",
+		"${keycloak_role.role_3.id}",
+	})
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleHasComposites(roleWithCompositesResourceName, []string{roleOne, roleThree}),
+					testAccCheckKeycloakRoleFetch(roleWithCompositesResourceName, role),
+				),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					composites, err := keycloakClient.GetRoleComposites(role)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.RemoveCompositesFromRole(role, composites)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  testAccCheckKeycloakRoleHasComposites(roleWithCompositesResourceName, []string{roleOne, roleThree}),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakRoleExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getRoleFromState(s, resourceName)
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -101,18 +103,31 @@ func resourceKeycloakSamlClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			// keycloak generates a certificate when one isn't provided, which is read back so it can be referenced and
+			// so changes made outside of terraform are detected
 			"signing_certificate": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
 					return old == formatSigningCertificate(new)
 				},
 			},
+			// only a hash of the private key is kept in state, which still detects a key that was changed outside of terraform
 			"signing_private_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return old == hashSigningPrivateKey(new)
+				},
+			},
+			"encryption_certificate": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
-					return old == formatSigningPrivateKey(new)
+					return old == formatSigningCertificate(new)
 				},
 			},
 			"idp_initiated_sso_url_name": {
@@ -144,6 +159,20 @@ func resourceKeycloakSamlClient() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"consent_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"display_on_consent_screen": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"consent_screen_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -168,6 +197,14 @@ func formatSigningPrivateKey(signingPrivateKey string) string {
 	return r.Replace(signingPrivateKey)
 }
 
+// a private key has far too much entropy to be recovered from an unsalted hash, and bcrypt would only compare the first
+// 72 bytes of it
+func hashSigningPrivateKey(signingPrivateKey string) string {
+	hash := sha256.Sum256([]byte(formatSigningPrivateKey(signingPrivateKey)))
+
+	return hex.EncodeToString(hash[:])
+}
+
 func mapToSamlClientFromData(data *schema.ResourceData) *keycloak.SamlClient {
 	var validRedirectUris []string
 
@@ -185,6 +222,7 @@ func mapToSamlClientFromData(data *schema.ResourceData) *keycloak.SamlClient {
 		AssertionConsumerRedirectURL:    data.Get("assertion_consumer_redirect_url").(string),
 		LogoutServicePostBindingURL:     data.Get("logout_service_post_binding_url").(string),
 		LogoutServiceRedirectBindingURL: data.Get("logout_service_redirect_binding_url").(string),
+		ConsentScreenText:               data.Get("consent_screen_text").(string),
 	}
 
 	if signingCertificate, ok := data.GetOkExists("signing_certificate"); ok {
@@ -197,6 +235,11 @@ func mapToSamlClientFromData(data *schema.ResourceData) *keycloak.SamlClient {
 		samlAttributes.SigningPrivateKey = &signingPrivateKeyString
 	}
 
+	if encryptionCertificate, ok := data.GetOkExists("encryption_certificate"); ok {
+		encryptionCertificateString := formatSigningCertificate(encryptionCertificate.(string))
+		samlAttributes.EncryptionCertificate = &encryptionCertificateString
+	}
+
 	if includeAuthnStatement, ok := data.GetOkExists("include_authn_statement"); ok {
 		includeAuthnStatementString := strconv.FormatBool(includeAuthnStatement.(bool))
 		samlAttributes.IncludeAuthnStatement = &includeAuthnStatementString
@@ -222,6 +265,11 @@ func mapToSamlClientFromData(data *schema.ResourceData) *keycloak.SamlClient {
 		samlAttributes.ForcePostBinding = &forcePostBindingString
 	}
 
+	if displayOnConsentScreen, ok := data.GetOkExists("display_on_consent_screen"); ok {
+		displayOnConsentScreenString := strconv.FormatBool(displayOnConsentScreen.(bool))
+		samlAttributes.DisplayOnConsentScreen = &displayOnConsentScreenString
+	}
+
 	samlClient := &keycloak.SamlClient{
 		Id:                      data.Id(),
 		ClientId:                data.Get("client_id").(string),
@@ -235,6 +283,7 @@ func mapToSamlClientFromData(data *schema.ResourceData) *keycloak.SamlClient {
 		BaseUrl:                 data.Get("base_url").(string),
 		MasterSamlProcessingUrl: data.Get("master_saml_processing_url").(string),
 		FullScopeAllowed:        data.Get("full_scope_allowed").(bool),
+		ConsentRequired:         data.Get("consent_required").(bool),
 		Attributes:              samlAttributes,
 	}
 
@@ -289,12 +338,26 @@ func mapToDataFromSamlClient(data *schema.ResourceData, client *keycloak.SamlCli
 		data.Set("force_post_binding", forcePostBinding)
 	}
 
-	if _, exists := data.GetOkExists("signing_certificate"); client.Attributes.SigningCertificate != nil && exists {
+	if client.Attributes.DisplayOnConsentScreen != nil {
+		displayOnConsentScreen, err := strconv.ParseBool(*client.Attributes.DisplayOnConsentScreen)
+		if err != nil {
+			return err
+		}
+
+		data.Set("display_on_consent_screen", displayOnConsentScreen)
+	}
+
+	if client.Attributes.SigningCertificate != nil {
 		data.Set("signing_certificate", *client.Attributes.SigningCertificate)
 	}
 
-	if _, exists := data.GetOkExists("signing_certificate"); client.Attributes.SigningPrivateKey != nil && exists {
-		data.Set("signing_private_key", *client.Attributes.SigningPrivateKey)
+	// the private key is only tracked when it's managed by terraform, so a key generated by keycloak doesn't end up in state
+	if _, exists := data.GetOkExists("signing_private_key"); client.Attributes.SigningPrivateKey != nil && exists {
+		data.Set("signing_private_key", hashSigningPrivateKey(*client.Attributes.SigningPrivateKey))
+	}
+
+	if client.Attributes.EncryptionCertificate != nil {
+		data.Set("encryption_certificate", *client.Attributes.EncryptionCertificate)
 	}
 
 	data.Set("client_id", client.ClientId)
@@ -315,6 +378,8 @@ func mapToDataFromSamlClient(data *schema.ResourceData, client *keycloak.SamlCli
 	data.Set("logout_service_post_binding_url", client.Attributes.LogoutServicePostBindingURL)
 	data.Set("logout_service_redirect_binding_url", client.Attributes.LogoutServiceRedirectBindingURL)
 	data.Set("full_scope_allowed", client.FullScopeAllowed)
+	data.Set("consent_required", client.ConsentRequired)
+	data.Set("consent_screen_text", client.Attributes.ConsentScreenText)
 
 	return nil
 }
@@ -358,6 +423,16 @@ func resourceKeycloakSamlClientUpdate(data *schema.ResourceData, meta interface{
 
 	client := mapToSamlClientFromData(data)
 
+	// state only holds the hash of an unchanged private key, so the key that keycloak already has is sent back instead
+	if client.Attributes.SigningPrivateKey != nil && !data.HasChange("signing_private_key") {
+		existingClient, err := keycloakClient.GetSamlClient(client.RealmId, client.Id)
+		if err != nil {
+			return err
+		}
+
+		client.Attributes.SigningPrivateKey = existingClient.Attributes.SigningPrivateKey
+	}
+
 	err := keycloakClient.UpdateSamlClient(client)
 	if err != nil {
 		return err
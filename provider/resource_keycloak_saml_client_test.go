@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"strconv"
 	"strings"
 	"testing"
@@ -114,7 +116,8 @@ func TestAccKeycloakSamlClient_keycloakDefaults(t *testing.T) {
 					testAccCheckKeycloakSamlClientExistsWithCorrectProtocol("keycloak_saml_client.saml_client"),
 					testAccCheckKeycloakSamlClientHasDefaultBooleanAttributes("keycloak_saml_client.saml_client"),
 					TestCheckResourceAttrNot("keycloak_saml_client.saml_client", "signing_certificate", ""),
-					TestCheckResourceAttrNot("keycloak_saml_client.saml_client", "signing_private_key", ""),
+					// keycloak generates a private key as well, but it isn't tracked unless it's managed by terraform
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "signing_private_key", ""),
 				),
 			},
 		},
@@ -251,6 +254,114 @@ func TestAccKeycloakSamlClient_certificateAndKey(t *testing.T) {
 	})
 }
 
+// certificates and keys that already exist can be provided instead of using the ones generated by keycloak, and are
+// read back on import. the private key can't be verified on import since it isn't tracked until it's configured
+func TestAccKeycloakSamlClient_providedCertificates(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+
+	certificate, err := ioutil.ReadFile("misc/saml-cert.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := ioutil.ReadFile("misc/saml-key.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlClient_providedCertificates(realmName, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSamlClientHasCertificate("keycloak_saml_client.saml_client"),
+					testAccCheckKeycloakSamlClientHasPrivateKey("keycloak_saml_client.saml_client"),
+					testAccCheckKeycloakSamlClientHasEncryptionCertificate("keycloak_saml_client.saml_client", formatSigningCertificate(string(certificate))),
+					// only a hash of the private key is stored in state
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "signing_private_key", hashSigningPrivateKey(string(privateKey))),
+				),
+			},
+			{
+				ResourceName:            "keycloak_saml_client.saml_client",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     realmName + "/",
+				ImportStateVerifyIgnore: []string{"signing_private_key"},
+			},
+		},
+	})
+}
+
+// state only holds a hash of the private key, so an update that doesn't change it sends back the key keycloak already has
+func TestKeycloakSamlClient_updateKeepsPrivateKey(t *testing.T) {
+	var updatedClient string
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /auth/admin/realms/test/clients/client-id":
+			w.Write([]byte(`{"id": "client-id", "clientId": "saml", "protocol": "saml", "attributes": {"saml.signing.private.key": "existing-key"}}`))
+		case "PUT /auth/admin/realms/test/clients/client-id":
+			body, _ := ioutil.ReadAll(r.Body)
+			updatedClient = string(body)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := resourceKeycloakSamlClient().Data(&terraform.InstanceState{
+		ID: "client-id",
+		Attributes: map[string]string{
+			"id":                  "client-id",
+			"realm_id":            "test",
+			"client_id":           "saml",
+			"signing_private_key": hashSigningPrivateKey("existing-key"),
+		},
+	})
+
+	err := resourceKeycloakSamlClientUpdate(data, keycloakClient)
+	if err != nil {
+		t.Fatalf("expected update to succeed, got %s", err)
+	}
+
+	if !strings.Contains(updatedClient, `"saml.signing.private.key":"existing-key"`) {
+		t.Errorf("expected the existing private key to be sent back, got %s", updatedClient)
+	}
+
+	if signingPrivateKey := data.Get("signing_private_key").(string); signingPrivateKey != hashSigningPrivateKey("existing-key") {
+		t.Errorf("expected a hash of the private key in state, got %s", signingPrivateKey)
+	}
+}
+
+func TestAccKeycloakSamlClient_consent(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlClient_consent(realmName, clientId, true, "Access to your profile"),
+				Check:  testAccCheckKeycloakSamlClientConsent("keycloak_saml_client.saml_client", true, "Access to your profile"),
+			},
+			{
+				Config: testKeycloakSamlClient_consent(realmName, clientId, false, ""),
+				Check:  testAccCheckKeycloakSamlClientConsent("keycloak_saml_client.saml_client", false, ""),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakSamlClientExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getSamlClientFromState(s, resourceName)
@@ -266,6 +377,34 @@ func testAccCheckKeycloakSamlClientExistsWithCorrectProtocol(resourceName string
 	}
 }
 
+func testAccCheckKeycloakSamlClientConsent(resourceName string, consent bool, consentScreenText string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getSamlClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.ConsentRequired != consent {
+			return fmt.Errorf("expected saml client to have consent_required value of %t, but got %t", consent, client.ConsentRequired)
+		}
+
+		displayOnConsentScreen, err := parseBoolAndTreatEmptyStringAsFalse(*client.Attributes.DisplayOnConsentScreen)
+		if err != nil {
+			return err
+		}
+
+		if displayOnConsentScreen != consent {
+			return fmt.Errorf("expected saml client to have display_on_consent_screen value of %t, but got %t", consent, displayOnConsentScreen)
+		}
+
+		if client.Attributes.ConsentScreenText != consentScreenText {
+			return fmt.Errorf("expected saml client to have consent_screen_text value of %s, but got %s", consentScreenText, client.Attributes.ConsentScreenText)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakSamlClientHasCertificate(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getSamlClientFromState(s, resourceName)
@@ -312,6 +451,21 @@ func testAccCheckKeycloakSamlClientHasPrivateKey(resourceName string) resource.T
 	}
 }
 
+func testAccCheckKeycloakSamlClientHasEncryptionCertificate(resourceName, encryptionCertificate string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getSamlClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.Attributes.EncryptionCertificate == nil || *client.Attributes.EncryptionCertificate != encryptionCertificate {
+			return fmt.Errorf("expected saml client to have the provided encryption certificate")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakSamlClientFetch(resourceName string, client *keycloak.SamlClient) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		fetchedClient, err := getSamlClientFromState(s, resourceName)
@@ -434,6 +588,23 @@ resource "keycloak_saml_client" "saml_client" {
 	`, realm, clientId)
 }
 
+func testKeycloakSamlClient_consent(realm, clientId string, consent bool, consentScreenText string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	client_id = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+
+	consent_required          = %t
+	display_on_consent_screen = %t
+	consent_screen_text       = "%s"
+}
+	`, realm, clientId, consent, consent, consentScreenText)
+}
+
 func testKeycloakSamlClient_updateRealmBefore(realmOne, realmTwo, clientId string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm_1" {
@@ -572,3 +743,25 @@ resource "keycloak_saml_client" "saml_client" {
 }
 	`, realm, clientId)
 }
+
+func testKeycloakSamlClient_providedCertificates(realm, clientId string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	client_id               = "%s"
+	realm_id                = "${keycloak_realm.realm.id}"
+	name                    = "test-saml-client"
+
+	sign_documents          = false
+	sign_assertions         = true
+	include_authn_statement = true
+
+	signing_certificate     = "${file("misc/saml-cert.pem")}"
+	signing_private_key     = "${file("misc/saml-key.pem")}"
+	encryption_certificate  = "${file("misc/saml-cert.pem")}"
+}
+	`, realm, clientId)
+}
@@ -55,6 +55,25 @@ func resourceKeycloakUser() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"multivalue_attribute": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Required: true,
+							MinItems: 1,
+						},
+					},
+				},
+			},
 			"federated_identity": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -100,6 +119,13 @@ func resourceKeycloakUser() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			// when true, attributes that aren't in `attributes` or a `multivalue_attribute` block, such as ones set by
+			// another process, are kept when the user is updated instead of being removed
+			"merge_attributes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -116,6 +142,11 @@ func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
 		}
 	}
 
+	for _, v := range data.Get("multivalue_attribute").(*schema.Set).List() {
+		attribute := v.(map[string]interface{})
+		attributes[attribute["name"].(string)] = interfaceSliceToStringSlice(attribute["values"].(*schema.Set).List())
+	}
+
 	federatedIdentities := &keycloak.FederatedIdentities{}
 
 	if v, ok := data.GetOk("federated_identity"); ok {
@@ -159,9 +190,21 @@ func mapFromUserToData(data *schema.ResourceData, user *keycloak.User) {
 		}
 		federatedIdentities = append(federatedIdentities, identity)
 	}
+	// values in `attributes` are split into chunks that keycloak can store, so they're joined back together here. only
+	// attributes that are configured with a `multivalue_attribute` block are read as separate values
+	multivalueAttributeNames := getUserMultivalueAttributeNames(data)
+
 	attributes := map[string]string{}
+	var multivalueAttributes []interface{}
 	for k, v := range user.Attributes {
-		attributes[k] = strings.Join(v, "")
+		if multivalueAttributeNames[k] {
+			multivalueAttributes = append(multivalueAttributes, map[string]interface{}{
+				"name":   k,
+				"values": schema.NewSet(schema.HashString, stringSliceToInterfaceSlice(v)),
+			})
+		} else {
+			attributes[k] = strings.Join(v, "")
+		}
 	}
 	data.SetId(user.Id)
 	data.Set("realm_id", user.RealmId)
@@ -171,15 +214,67 @@ func mapFromUserToData(data *schema.ResourceData, user *keycloak.User) {
 	data.Set("last_name", user.LastName)
 	data.Set("enabled", user.Enabled)
 	data.Set("attributes", attributes)
+	data.Set("multivalue_attribute", multivalueAttributes)
 	data.Set("federated_identity", federatedIdentities)
 }
 
+func getUserMultivalueAttributeNames(data *schema.ResourceData) map[string]bool {
+	names := make(map[string]bool)
+
+	for _, v := range data.Get("multivalue_attribute").(*schema.Set).List() {
+		names[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	return names
+}
+
+// returns the names of the attributes set in `attributes` and `multivalue_attribute`
+func getUserAttributeNames(attributes, multivalueAttributes interface{}) map[string]bool {
+	names := make(map[string]bool)
+
+	for name := range attributes.(map[string]interface{}) {
+		names[name] = true
+	}
+
+	for _, v := range multivalueAttributes.(*schema.Set).List() {
+		names[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	return names
+}
+
+// removes every attribute that isn't in `names`, so attributes managed elsewhere don't show up as a change
+func filterUserAttributes(user *keycloak.User, names map[string]bool) {
+	for name := range user.Attributes {
+		if !names[name] {
+			delete(user.Attributes, name)
+		}
+	}
+}
+
+func validateUserAttributes(data *schema.ResourceData) error {
+	attributes := data.Get("attributes").(map[string]interface{})
+
+	for name := range getUserMultivalueAttributeNames(data) {
+		if _, ok := attributes[name]; ok {
+			return fmt.Errorf("validation error: attribute %s cannot be set in both attributes and a multivalue_attribute block", name)
+		}
+	}
+
+	return nil
+}
+
 func resourceKeycloakUserCreate(data *schema.ResourceData, meta interface{}) error {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	err := validateUserAttributes(data)
+	if err != nil {
+		return err
+	}
+
 	user := mapFromDataToUser(data)
 
-	err := keycloakClient.NewUser(user)
+	err = keycloakClient.NewUser(user)
 	if err != nil {
 		return err
 	}
@@ -211,6 +306,10 @@ func resourceKeycloakUserRead(data *schema.ResourceData, meta interface{}) error
 		return handleNotFoundError(err, data)
 	}
 
+	if data.Get("merge_attributes").(bool) {
+		filterUserAttributes(user, getUserAttributeNames(data.Get("attributes"), data.Get("multivalue_attribute")))
+	}
+
 	mapFromUserToData(data, user)
 
 	return nil
@@ -219,13 +318,43 @@ func resourceKeycloakUserRead(data *schema.ResourceData, meta interface{}) error
 func resourceKeycloakUserUpdate(data *schema.ResourceData, meta interface{}) error {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	err := validateUserAttributes(data)
+	if err != nil {
+		return err
+	}
+
 	user := mapFromDataToUser(data)
 
-	err := keycloakClient.UpdateUser(user)
+	mergeAttributes := data.Get("merge_attributes").(bool)
+	managedAttributeNames := getUserAttributeNames(data.Get("attributes"), data.Get("multivalue_attribute"))
+
+	if mergeAttributes {
+		remoteUser, err := keycloakClient.GetUser(user.RealmId, user.Id)
+		if err != nil {
+			return err
+		}
+
+		// attributes that were removed from the configuration are still removed from the user
+		oldAttributes, _ := data.GetChange("attributes")
+		oldMultivalueAttributes, _ := data.GetChange("multivalue_attribute")
+		previouslyManagedAttributeNames := getUserAttributeNames(oldAttributes, oldMultivalueAttributes)
+
+		for name, values := range remoteUser.Attributes {
+			if !managedAttributeNames[name] && !previouslyManagedAttributeNames[name] {
+				user.Attributes[name] = values
+			}
+		}
+	}
+
+	err = keycloakClient.UpdateUser(user)
 	if err != nil {
 		return err
 	}
 
+	if mergeAttributes {
+		filterUserAttributes(user, managedAttributeNames)
+	}
+
 	mapFromUserToData(data, user)
 
 	return nil
@@ -247,6 +376,7 @@ func resourceKeycloakUserImport(d *schema.ResourceData, _ interface{}) ([]*schem
 	}
 
 	d.Set("realm_id", parts[0])
+	d.Set("merge_attributes", false)
 	d.SetId(parts[1])
 
 	return []*schema.ResourceData{d}, nil
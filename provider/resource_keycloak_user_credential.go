@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func resourceKeycloakUserCredential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakUserCredentialCreate,
+		Read:   resourceKeycloakUserCredentialRead,
+		Update: resourceKeycloakUserCredentialUpdate,
+		Delete: resourceKeycloakUserCredentialDelete,
+		// This resource doesn't support import, since keycloak never returns a user's password.
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// only a bcrypt hash of the password is kept in state. it's salted, so the configured password is compared
+			// against it rather than hashed again
+			"value": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressUserCredentialValueDiff,
+			},
+			// the password has to be sent again to change this, which is only possible when it's part of the diff
+			"temporary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func hashUserCredentialValue(value string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(value), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+// `old` is the hash in state and `new` is the configured password. state from before the hash was salted doesn't match,
+// so the password is set once more and its hash replaced
+func suppressUserCredentialValueDiff(_, old, new string, _ *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(old), []byte(new)) == nil
+}
+
+// sends the configured password to keycloak, then replaces it with its hash so the password itself never reaches state
+func setUserCredentialPassword(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) error {
+	value := data.Get("value").(string)
+
+	err := keycloakClient.ResetUserPassword(data.Get("realm_id").(string), data.Get("user_id").(string), value, data.Get("temporary").(bool))
+	if err != nil {
+		return err
+	}
+
+	hash, err := hashUserCredentialValue(value)
+	if err != nil {
+		return err
+	}
+
+	data.Set("value", hash)
+
+	return nil
+}
+
+func userCredentialId(realmId, userId string) string {
+	return fmt.Sprintf("%s/credential/%s", realmId, userId)
+}
+
+func resourceKeycloakUserCredentialCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	err := setUserCredentialPassword(keycloakClient, data)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(userCredentialId(realmId, userId))
+
+	return resourceKeycloakUserCredentialRead(data, meta)
+}
+
+// keycloak never returns a user's password, so this only checks that the user still exists
+func resourceKeycloakUserCredentialRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	_, err := keycloakClient.GetUser(data.Get("realm_id").(string), data.Get("user_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserCredentialUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := setUserCredentialPassword(keycloakClient, data)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakUserCredentialRead(data, meta)
+}
+
+// the password is left as it is, since removing it would lock the user out
+func resourceKeycloakUserCredentialDelete(_ *schema.ResourceData, _ interface{}) error {
+	return nil
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAccKeycloakUserCredential_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+	passwordOne := "terraform-password-" + acctest.RandString(10)
+	passwordTwo := "terraform-password-" + acctest.RandString(10)
+	clientId := "terraform-client-" + acctest.RandString(10)
+
+	resourceName := "keycloak_user_credential.credential"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserCredential_basic(realmName, username, passwordOne, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserInitialPasswordLogin(realmName, username, passwordOne, clientId),
+					testAccCheckKeycloakUserCredentialValueNotInState(resourceName, passwordOne),
+				),
+			},
+			// unlike `initial_password`, changing the value sets the new password
+			{
+				Config: testKeycloakUserCredential_basic(realmName, username, passwordTwo, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserInitialPasswordLogin(realmName, username, passwordTwo, clientId),
+					testAccCheckKeycloakUserCredentialValueNotInState(resourceName, passwordTwo),
+				),
+			},
+		},
+	})
+}
+
+// a temporary password can't be used to log in until it has been changed
+func TestAccKeycloakUserCredential_temporary(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+	password := "terraform-password-" + acctest.RandString(10)
+	clientId := "terraform-client-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserCredential_temporary(realmName, username, password, clientId),
+				Check:  testAccCheckKeycloakUserCredentialCannotLogin(realmName, username, password, clientId),
+			},
+			{
+				Config: testKeycloakUserCredential_basic(realmName, username, password, clientId),
+				Check:  testAccCheckKeycloakUserInitialPasswordLogin(realmName, username, password, clientId),
+			},
+		},
+	})
+}
+
+func TestKeycloakUserCredential_create(t *testing.T) {
+	var sentCredentials *keycloak.PasswordCredentials
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/auth/admin/realms/test/users/user-id/reset-password":
+			json.NewDecoder(r.Body).Decode(&sentCredentials)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/users/user-id":
+			w.Write([]byte(`{"id": "user-id", "username": "user"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	for _, temporary := range []bool{true, false} {
+		data := schema.TestResourceDataRaw(t, resourceKeycloakUserCredential().Schema, map[string]interface{}{
+			"realm_id":  "test",
+			"user_id":   "user-id",
+			"value":     "hunter2",
+			"temporary": temporary,
+		})
+
+		err := resourceKeycloakUserCredentialCreate(data, keycloakClient)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if sentCredentials == nil || sentCredentials.Value != "hunter2" || sentCredentials.Type != "password" || sentCredentials.Temporary != temporary {
+			t.Errorf("expected a temporary = %t password to be sent, got %+v", temporary, sentCredentials)
+		}
+
+		hash := data.Get("value").(string)
+		if hash == "hunter2" || !strings.HasPrefix(hash, "$2") {
+			t.Errorf("expected a bcrypt hash of the password in state, got %s", hash)
+		}
+
+		if !suppressUserCredentialValueDiff("value", hash, "hunter2", data) {
+			t.Error("expected the hash in state to match the configured password")
+		}
+
+		if suppressUserCredentialValueDiff("value", hash, "hunter3", data) {
+			t.Error("expected the hash in state to not match a different password")
+		}
+	}
+}
+
+func testAccCheckKeycloakUserCredentialCannotLogin(realmName, username, password, clientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testAccCheckKeycloakUserInitialPasswordLogin(realmName, username, password, clientId)(s) == nil {
+			return fmt.Errorf("expected user %s to have to change their temporary password before logging in", username)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUserCredentialValueNotInState(resourceName, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.Attributes["value"] == password {
+			return fmt.Errorf("expected the password to be hashed in state for %s", resourceName)
+		}
+
+		if !suppressUserCredentialValueDiff("value", rs.Primary.Attributes["value"], password, nil) {
+			return fmt.Errorf("expected a hash of the password to be in state for %s, got %s", resourceName, rs.Primary.Attributes["value"])
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserCredential_basic(realm, username, password, clientId string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                     = "${keycloak_realm.realm.id}"
+	client_id                    = "%s"
+
+	name                         = "test client"
+	enabled                      = true
+
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_credential" "credential" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+	value    = "%s"
+}
+	`, realm, clientId, username, password)
+}
+
+func testKeycloakUserCredential_temporary(realm, username, password, clientId string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                     = "${keycloak_realm.realm.id}"
+	client_id                    = "%s"
+
+	name                         = "test client"
+	enabled                      = true
+
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_credential" "credential" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	user_id   = "${keycloak_user.user.id}"
+	value     = "%s"
+	temporary = true
+}
+	`, realm, clientId, username, password)
+}
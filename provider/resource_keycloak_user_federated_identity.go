@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"log"
+	"strings"
+)
+
+func resourceKeycloakUserFederatedIdentity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakUserFederatedIdentityCreate,
+		Read:   resourceKeycloakUserFederatedIdentityRead,
+		Delete: resourceKeycloakUserFederatedIdentityDelete,
+		// This resource can be imported using {{realm}}/{{userId}}/{{identityProvider}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakUserFederatedIdentityImport,
+		},
+		// keycloak doesn't support updating a federated identity link, so every change requires a new one
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_provider": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"federated_user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func userFederatedIdentityId(realmId, userId, identityProvider string) string {
+	return fmt.Sprintf("%s/%s/%s", realmId, userId, identityProvider)
+}
+
+func resourceKeycloakUserFederatedIdentityCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	federatedIdentity := &keycloak.FederatedIdentity{
+		IdentityProvider: data.Get("identity_provider").(string),
+		UserId:           data.Get("federated_user_id").(string),
+		UserName:         data.Get("user_name").(string),
+	}
+
+	err := keycloakClient.CreateFederatedIdentity(realmId, userId, federatedIdentity)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(userFederatedIdentityId(realmId, userId, federatedIdentity.IdentityProvider))
+
+	return resourceKeycloakUserFederatedIdentityRead(data, meta)
+}
+
+func resourceKeycloakUserFederatedIdentityRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	identityProvider := data.Get("identity_provider").(string)
+
+	federatedIdentities, err := keycloakClient.GetFederatedIdentities(realmId, userId)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	for _, federatedIdentity := range federatedIdentities {
+		if federatedIdentity.IdentityProvider != identityProvider {
+			continue
+		}
+
+		data.Set("federated_user_id", federatedIdentity.UserId)
+		data.Set("user_name", federatedIdentity.UserName)
+
+		return nil
+	}
+
+	log.Printf("[WARN] Removing resource with id %s from state as the user is no longer linked to %s", data.Id(), identityProvider)
+	data.SetId("")
+
+	return nil
+}
+
+func resourceKeycloakUserFederatedIdentityDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	identityProvider := data.Get("identity_provider").(string)
+
+	return keycloakClient.RemoveFederatedIdentity(realmId, userId, identityProvider)
+}
+
+func resourceKeycloakUserFederatedIdentityImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{userId}}/{{identityProvider}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("user_id", parts[1])
+	d.Set("identity_provider", parts[2])
+
+	d.SetId(userFederatedIdentityId(parts[0], parts[1], parts[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakUserFederatedIdentity_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+	alias := "terraform-" + acctest.RandString(10)
+	federatedUserId := acctest.RandString(10)
+
+	resourceName := "keycloak_user_federated_identity.identity"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(realmName, username, alias, federatedUserId, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserFederatedIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federated_user_id", federatedUserId),
+					resource.TestCheckResourceAttr(resourceName, "user_name", username),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// removing the resource should unlink the identity provider without deleting the user
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(realmName, username, alias, federatedUserId, false),
+				Check:  testAccCheckKeycloakUserHasNoFederatedIdentities("keycloak_user.user"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserFederatedIdentityExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.Attributes["user_id"]
+		identityProvider := rs.Primary.Attributes["identity_provider"]
+
+		federatedIdentities, err := keycloakClient.GetFederatedIdentities(realm, userId)
+		if err != nil {
+			return err
+		}
+
+		for _, federatedIdentity := range federatedIdentities {
+			if federatedIdentity.IdentityProvider == identityProvider {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected user %s to be linked to identity provider %s", userId, identityProvider)
+	}
+}
+
+func testAccCheckKeycloakUserHasNoFederatedIdentities(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.ID
+
+		federatedIdentities, err := keycloakClient.GetFederatedIdentities(realm, userId)
+		if err != nil {
+			return err
+		}
+
+		if len(federatedIdentities) != 0 {
+			return fmt.Errorf("expected user %s to have no federated identities, got %d", userId, len(federatedIdentities))
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserFederatedIdentity_basic(realmName, username, alias, federatedUserId string, withFederatedIdentity bool) string {
+	federatedIdentity := ""
+	if withFederatedIdentity {
+		federatedIdentity = fmt.Sprintf(`
+resource "keycloak_user_federated_identity" "identity" {
+	realm_id          = "${keycloak_realm.realm.id}"
+	user_id           = "${keycloak_user.user.id}"
+	identity_provider = "${keycloak_oidc_identity_provider.oidc.alias}"
+	federated_user_id = "%s"
+	user_name         = "${keycloak_user.user.username}"
+}`, federatedUserId)
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = "${keycloak_realm.realm.id}"
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+%s
+	`, realmName, username, alias, federatedIdentity)
+}
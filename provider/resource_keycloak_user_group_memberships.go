@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"strings"
+)
+
+func resourceKeycloakUserGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakUserGroupMembershipsCreate,
+		Read:   resourceKeycloakUserGroupMembershipsRead,
+		Update: resourceKeycloakUserGroupMembershipsUpdate,
+		Delete: resourceKeycloakUserGroupMembershipsDelete,
+		// This resource can be imported using {{realm}}/{{userId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakUserGroupMembershipsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Required: true,
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func userGroupMembershipsId(realmId, userId string) string {
+	return fmt.Sprintf("%s/%s", realmId, userId)
+}
+
+func addUserToGroups(keycloakClient *keycloak.KeycloakClient, user *keycloak.User, groupIds []interface{}) error {
+	for _, groupId := range groupIds {
+		err := keycloakClient.AddUserToGroup(user, groupId.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func removeUserFromGroups(keycloakClient *keycloak.KeycloakClient, user *keycloak.User, groupIds []interface{}) error {
+	for _, groupId := range groupIds {
+		err := keycloakClient.RemoveUserFromGroup(user, groupId.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserGroupMembershipsCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	err := updateUserGroupMemberships(keycloakClient, data, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(userGroupMembershipsId(realmId, userId))
+
+	return resourceKeycloakUserGroupMembershipsRead(data, meta)
+}
+
+func resourceKeycloakUserGroupMembershipsRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	groups, err := keycloakClient.GetUserGroups(realmId, userId)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	var groupIds []string
+	for _, group := range groups {
+		groupIds = append(groupIds, group.Id)
+	}
+
+	// when this resource isn't exclusive, only track the groups that it manages so memberships added elsewhere don't cause drift
+	if !data.Get("exclusive").(bool) {
+		groupIds = filterToManagedIds(groupIds, data.Get("group_ids").(*schema.Set))
+	}
+
+	data.Set("group_ids", groupIds)
+	data.SetId(userGroupMembershipsId(realmId, userId))
+
+	return nil
+}
+
+// when exclusive, the user's groups are made to match `group_ids` exactly, including groups the user was already a member
+// of before this resource existed. otherwise, only the groups added to or removed from `group_ids` are changed
+func updateUserGroupMemberships(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, realmId, userId string) error {
+	user, err := keycloakClient.GetUser(realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	tfGroupIds := data.Get("group_ids").(*schema.Set)
+
+	var groupIdsToAdd, groupIdsToRemove []interface{}
+
+	if data.Get("exclusive").(bool) {
+		groups, err := keycloakClient.GetUserGroups(realmId, userId)
+		if err != nil {
+			return err
+		}
+
+		keycloakGroupIds := &schema.Set{F: schema.HashString}
+		for _, group := range groups {
+			keycloakGroupIds.Add(group.Id)
+		}
+
+		groupIdsToAdd = tfGroupIds.Difference(keycloakGroupIds).List()
+		groupIdsToRemove = keycloakGroupIds.Difference(tfGroupIds).List()
+	} else {
+		// only add groups that were added to `group_ids`, and only remove groups that were removed from it
+		oldGroupIds, _ := data.GetChange("group_ids")
+
+		groupIdsToAdd = tfGroupIds.Difference(oldGroupIds.(*schema.Set)).List()
+		groupIdsToRemove = oldGroupIds.(*schema.Set).Difference(tfGroupIds).List()
+	}
+
+	err = addUserToGroups(keycloakClient, user, groupIdsToAdd)
+	if err != nil {
+		return err
+	}
+
+	return removeUserFromGroups(keycloakClient, user, groupIdsToRemove)
+}
+
+func resourceKeycloakUserGroupMembershipsUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	err := updateUserGroupMemberships(keycloakClient, data, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakUserGroupMembershipsRead(data, meta)
+}
+
+func resourceKeycloakUserGroupMembershipsDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	user, err := keycloakClient.GetUser(realmId, userId)
+	if err != nil {
+		// the user's group memberships were deleted along with the user, so there's nothing left to do
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return removeUserFromGroups(keycloakClient, user, data.Get("group_ids").(*schema.Set).List())
+}
+
+func resourceKeycloakUserGroupMembershipsImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{userId}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("user_id", parts[1])
+	d.Set("exclusive", true)
+
+	d.SetId(userGroupMembershipsId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+)
+
+func TestAccKeycloakUserGroupMemberships_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	groupOneName := "terraform-group-" + acctest.RandString(10)
+	groupTwoName := "terraform-group-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resourceName := "keycloak_user_group_memberships.user_groups"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserGroupMemberships_basic(realmName, groupOneName, groupTwoName, username, []string{
+					"${keycloak_group.group_one.id}",
+				}),
+				Check: testAccCheckKeycloakUserIsMemberOfGroups(resourceName, true),
+			},
+			{
+				Config: testKeycloakUserGroupMemberships_basic(realmName, groupOneName, groupTwoName, username, []string{
+					"${keycloak_group.group_one.id}",
+					"${keycloak_group.group_two.id}",
+				}),
+				Check: testAccCheckKeycloakUserIsMemberOfGroups(resourceName, true),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakUserGroupMemberships_basic(realmName, groupOneName, groupTwoName, username, []string{}),
+				Check:  testAccCheckKeycloakUserIsMemberOfGroups(resourceName, true),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserGroupMemberships_nonExclusive(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	groupOneName := "terraform-group-" + acctest.RandString(10)
+	groupTwoName := "terraform-group-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserGroupMemberships_nonExclusive(realmName, groupOneName, groupTwoName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_group_memberships.user_groups_one", "group_ids.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_user_group_memberships.user_groups_two", "group_ids.#", "1"),
+					testAccCheckKeycloakUserIsMemberOfGroups("keycloak_user_group_memberships.user_groups_one", false),
+					testAccCheckKeycloakUserIsMemberOfGroups("keycloak_user_group_memberships.user_groups_two", false),
+				),
+			},
+		},
+	})
+}
+
+// an exclusive resource takes over every membership of the user, including the ones that existed before it was created
+func TestKeycloakUserGroupMemberships_createExclusiveRemovesExistingGroups(t *testing.T) {
+	var added, removed []string
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /auth/admin/realms/test/users/user-id":
+			w.Write([]byte(`{"id": "user-id", "username": "user"}`))
+		case "GET /auth/admin/realms/test/users/user-id/groups":
+			if len(added) == 0 {
+				w.Write([]byte(`[{"id": "existing-group", "name": "existing"}, {"id": "group-one", "name": "one"}]`))
+			} else {
+				w.Write([]byte(`[{"id": "group-one", "name": "one"}, {"id": "group-two", "name": "two"}]`))
+			}
+		case "PUT /auth/admin/realms/test/users/user-id/groups/group-two":
+			added = append(added, "group-two")
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE /auth/admin/realms/test/users/user-id/groups/existing-group":
+			removed = append(removed, "existing-group")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserGroupMemberships().Schema, map[string]interface{}{
+		"realm_id":  "test",
+		"user_id":   "user-id",
+		"group_ids": []interface{}{"group-one", "group-two"},
+	})
+
+	err := resourceKeycloakUserGroupMembershipsCreate(data, keycloakClient)
+	if err != nil {
+		t.Fatalf("expected create to succeed, got %s", err)
+	}
+
+	if !reflect.DeepEqual(added, []string{"group-two"}) {
+		t.Errorf("expected only group-two to be added, got %v", added)
+	}
+
+	if !reflect.DeepEqual(removed, []string{"existing-group"}) {
+		t.Errorf("expected existing-group to be removed, got %v", removed)
+	}
+
+	groupIds := interfaceSliceToStringSlice(data.Get("group_ids").(*schema.Set).List())
+	sort.Strings(groupIds)
+
+	if !reflect.DeepEqual(groupIds, []string{"group-one", "group-two"}) {
+		t.Errorf("expected group_ids to be [group-one group-two] after reading, got %v", groupIds)
+	}
+}
+
+func TestKeycloakUserGroupMemberships_deleteWhenUserDoesNotExist(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/admin/realms/test/users/deleted-user":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserGroupMemberships().Schema, map[string]interface{}{
+		"realm_id":  "test",
+		"user_id":   "deleted-user",
+		"group_ids": []interface{}{"group-one"},
+	})
+	data.SetId(userGroupMembershipsId("test", "deleted-user"))
+
+	err := resourceKeycloakUserGroupMembershipsDelete(data, keycloakClient)
+	if err != nil {
+		t.Fatalf("expected delete to succeed when the user does not exist, got %s", err)
+	}
+}
+
+// checks that the user is a member of every group in `group_ids`. if `exact` is true, the user must not be a member of any other groups
+func testAccCheckKeycloakUserIsMemberOfGroups(resourceName string, exact bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.Attributes["user_id"]
+
+		groups, err := keycloakClient.GetUserGroups(realm, userId)
+		if err != nil {
+			return err
+		}
+
+		var groupIds []string
+		for k, v := range rs.Primary.Attributes {
+			if match, _ := regexp.MatchString("group_ids\\.[^#]+", k); !match {
+				continue
+			}
+
+			groupIds = append(groupIds, v)
+		}
+
+		if exact && len(groups) != len(groupIds) {
+			return fmt.Errorf("expected user %s to be a member of %d groups, got %d", userId, len(groupIds), len(groups))
+		}
+
+		for _, groupId := range groupIds {
+			found := false
+
+			for _, group := range groups {
+				if group.Id == groupId {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("expected user %s to be a member of group %s", userId, groupId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserGroupMemberships_basic(realmName, groupOneName, groupTwoName, username string, groupIds []string) string {
+	tfGroupIds := fmt.Sprintf("group_ids = %s", arrayOfStringsForTerraformResource(groupIds))
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group_one" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_group" "group_two" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_group_memberships" "user_groups" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	%s
+}
+	`, realmName, groupOneName, groupTwoName, username, tfGroupIds)
+}
+
+func testKeycloakUserGroupMemberships_nonExclusive(realmName, groupOneName, groupTwoName, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group_one" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_group" "group_two" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_group_memberships" "user_groups_one" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	user_id   = "${keycloak_user.user.id}"
+	exclusive = false
+
+	group_ids = [
+		"${keycloak_group.group_one.id}",
+	]
+}
+
+resource "keycloak_user_group_memberships" "user_groups_two" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	user_id   = "${keycloak_user.user.id}"
+	exclusive = false
+
+	group_ids = [
+		"${keycloak_group.group_two.id}",
+	]
+}
+	`, realmName, groupOneName, groupTwoName, username)
+}
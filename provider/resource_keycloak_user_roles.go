@@ -0,0 +1,482 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"sort"
+	"strings"
+)
+
+func resourceKeycloakUserRoles() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakUserRolesCreate,
+		Read:   resourceKeycloakUserRolesRead,
+		Update: resourceKeycloakUserRolesUpdate,
+		Delete: resourceKeycloakUserRolesDelete,
+		// This resource can be imported using {{realm}}/{{userId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakUserRolesImport,
+		},
+		CustomizeDiff: validateUserRolesUser,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"service_account_client_id", "username"},
+				DiffSuppressFunc: suppressUserRolesUserDiff,
+			},
+			"service_account_client_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"user_id", "username"},
+				DiffSuppressFunc: suppressUserRolesUserDiff,
+			},
+			"username": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"user_id", "service_account_client_id"},
+				DiffSuppressFunc: suppressUserRolesUserDiff,
+			},
+			"role_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Required: true,
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// the arguments that can be used to choose the user this resource manages roles for
+var userRolesUserKeys = []string{"user_id", "username", "service_account_client_id"}
+
+// `user_id` and `username` are always filled in by reads, and `service_account_client_id` by imports, so they can hold a
+// value that isn't in the configuration. that's only a change when none of the other arguments choose the user instead
+func suppressUserRolesUserDiff(k, _, new string, data *schema.ResourceData) bool {
+	if new != "" {
+		return false
+	}
+
+	for _, key := range userRolesUserKeys {
+		if key != k && data.Get(key).(string) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// makes sure the user is chosen at plan time, rather than failing when the resource is created. values that aren't
+// known yet, such as the ID of a user created in the same apply, are checked when they are used instead
+func validateUserRolesUser(diff *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range userRolesUserKeys {
+		if !diff.NewValueKnown(key) || diff.Get(key).(string) != "" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("one of user_id, username, or service_account_client_id must be set")
+}
+
+func userRolesId(realmId, userId string) string {
+	return fmt.Sprintf("%s/%s", realmId, userId)
+}
+
+// records that an exclusive resource manages every role of the user. two exclusive resources for the same user remove each
+// other's roles on every apply and never converge, so this fails instead of letting that show up as endless drift
+func claimExclusiveUserRoles(keycloakClient *keycloak.KeycloakClient, realmId, userId, previousClaim, claim string) error {
+	if keycloakClient.ClaimUserRoles(realmId, userId, previousClaim, claim) {
+		return nil
+	}
+
+	return fmt.Errorf("the roles of user %s in realm %s are managed by more than one resource with exclusive = true, which would remove each other's roles on every apply. manage all of this user's roles with a single exclusive keycloak_user_roles, keycloak_user_roles_by_name, or keycloak_openid_client_service_account_realm_roles resource, or set exclusive = false on every resource that assigns roles to this user", userId, realmId)
+}
+
+// runs `apply` for a resource that changes the user's roles. when the resource is exclusive, the user is claimed first,
+// and the claim is released again if `apply` fails, so a resource that couldn't be created or updated doesn't keep
+// another resource from managing the user
+func withUserRolesClaim(keycloakClient *keycloak.KeycloakClient, exclusive bool, realmId, userId, previousClaim, claim string, apply func() error) error {
+	if !exclusive {
+		return apply()
+	}
+
+	err := claimExclusiveUserRoles(keycloakClient, realmId, userId, previousClaim, claim)
+	if err != nil {
+		return err
+	}
+
+	err = apply()
+	if err != nil {
+		keycloakClient.ReleaseUserRoles(realmId, userId, claim)
+	}
+
+	return err
+}
+
+func userRolesClaim(roleIds *schema.Set) string {
+	ids := interfaceSliceToStringSlice(roleIds.List())
+	sort.Strings(ids)
+
+	return "keycloak_user_roles:" + strings.Join(ids, ",")
+}
+
+// returns the user this resource manages roles for, which is either `user_id`, the user named by `username`, or the
+// service account user for `service_account_client_id`
+func getUserFromUserRolesData(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (*keycloak.User, error) {
+	realmId := data.Get("realm_id").(string)
+
+	if userId := data.Get("user_id").(string); userId != "" {
+		return keycloakClient.GetUser(realmId, userId)
+	}
+
+	if username := data.Get("username").(string); username != "" {
+		user, err := keycloakClient.GetUserByUsername(realmId, username)
+		if err != nil {
+			return nil, err
+		}
+
+		// this is a 404 so that reading a resource whose user was deleted removes it from state
+		if user == nil {
+			return nil, keycloak.NewUserNotFoundError(realmId, username)
+		}
+
+		return user, nil
+	}
+
+	if clientId := data.Get("service_account_client_id").(string); clientId != "" {
+		return keycloakClient.GetOpenidClientServiceAccountUserId(realmId, clientId)
+	}
+
+	return nil, fmt.Errorf("one of user_id, username, or service_account_client_id must be set")
+}
+
+// fetch all of the realm and client roles that are directly assigned to a user
+// this is shared by the `keycloak_user_roles` resource and data source so they resolve roles the same way
+func getMapOfRealmAndClientRolesFromUser(keycloakClient *keycloak.KeycloakClient, user *keycloak.User) (map[string][]*keycloak.Role, error) {
+	roleMappings, err := keycloakClient.GetUserRoleMappings(user.RealmId, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return getMapOfRealmAndClientRolesFromRoleMapping(roleMappings), nil
+}
+
+// groups the roles in a user's or group's role mappings by "realm" or client ID. the role mappings already contain the
+// whole role, so nothing has to be looked up by name
+func getMapOfRealmAndClientRolesFromRoleMapping(roleMappings *keycloak.RoleMapping) map[string][]*keycloak.Role {
+	roles := make(map[string][]*keycloak.Role)
+
+	if len(roleMappings.RealmMappings) != 0 {
+		roles["realm"] = roleMappings.RealmMappings
+	}
+
+	for _, clientRoleMapping := range roleMappings.ClientMappings {
+		if len(clientRoleMapping.Mappings) != 0 {
+			roles[clientRoleMapping.Id] = clientRoleMapping.Mappings
+		}
+	}
+
+	return roles
+}
+
+func getRoleIdsFromMapOfRealmAndClientRoles(roles map[string][]*keycloak.Role) []string {
+	var roleIds []string
+
+	for _, rolesForContainer := range roles {
+		for _, role := range rolesForContainer {
+			roleIds = append(roleIds, role.Id)
+		}
+	}
+
+	return roleIds
+}
+
+// applies a set of role mapping changes, grouped by "realm" or client ID. keycloak's role mapping endpoints only accept
+// roles from a single container, so this makes one call for the realm roles and one call per client, skipping any
+// container without roles. the client calls don't depend on each other, so they are made concurrently.
+func applyRoleMappings(keycloakClient *keycloak.KeycloakClient, roles map[string][]*keycloak.Role, realmFn func([]*keycloak.Role) error, clientFn func(string, []*keycloak.Role) error) error {
+	if realmRoles := roles["realm"]; len(realmRoles) != 0 {
+		err := realmFn(realmRoles)
+		if err != nil {
+			return err
+		}
+	}
+
+	var clientIds []string
+	for clientId, clientRoles := range roles {
+		if clientId != "realm" && len(clientRoles) != 0 {
+			clientIds = append(clientIds, clientId)
+		}
+	}
+	sort.Strings(clientIds)
+
+	return parallelForEach(keycloakClient.MaxConcurrency(), len(clientIds), func(i int) error {
+		return clientFn(clientIds[i], roles[clientIds[i]])
+	})
+}
+
+func addRolesToUser(keycloakClient *keycloak.KeycloakClient, rolesToAdd map[string][]*keycloak.Role, user *keycloak.User) error {
+	return applyRoleMappings(keycloakClient, rolesToAdd, func(roles []*keycloak.Role) error {
+		return keycloakClient.AddRealmRolesToUser(user.RealmId, user.Id, roles)
+	}, func(clientId string, roles []*keycloak.Role) error {
+		return keycloakClient.AddClientRolesToUser(user.RealmId, user.Id, clientId, roles)
+	})
+}
+
+func removeRolesFromUser(keycloakClient *keycloak.KeycloakClient, rolesToRemove map[string][]*keycloak.Role, user *keycloak.User) error {
+	return applyRoleMappings(keycloakClient, rolesToRemove, func(roles []*keycloak.Role) error {
+		return keycloakClient.RemoveRealmRolesFromUser(user.RealmId, user.Id, roles)
+	}, func(clientId string, roles []*keycloak.Role) error {
+		return keycloakClient.RemoveClientRolesFromUser(user.RealmId, user.Id, clientId, roles)
+	})
+}
+
+func resourceKeycloakUserRolesCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	err := keycloakClient.ValidateRealmExists(realmId)
+	if err != nil {
+		return err
+	}
+
+	user, err := getUserFromUserRolesData(keycloakClient, data)
+	if err != nil {
+		return err
+	}
+
+	err = withUserRolesClaim(keycloakClient, data.Get("exclusive").(bool), realmId, user.Id, "", userRolesClaim(data.Get("role_ids").(*schema.Set)), func() error {
+		defer keycloakClient.LockUser(realmId, user.Id)()
+
+		roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
+		rolesToAdd, err := getMapOfRealmAndClientRoles(keycloakClient, realmId, roleIds)
+		if err != nil {
+			return err
+		}
+
+		return addRolesToUser(keycloakClient, rolesToAdd, user)
+	})
+	if err != nil {
+		return err
+	}
+
+	data.Set("user_id", user.Id)
+	data.SetId(userRolesId(realmId, user.Id))
+
+	return resourceKeycloakUserRolesRead(data, meta)
+}
+
+func resourceKeycloakUserRolesRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	// `user_id` is always set once this resource has been created, but it may not be known yet when `username` is used
+	user, err := getUserFromUserRolesData(keycloakClient, data)
+	if err != nil {
+		// the user could have been deleted by another process, in which case its role mappings are gone as well
+		return handleNotFoundError(err, data)
+	}
+
+	roles, err := getMapOfRealmAndClientRolesFromUser(keycloakClient, user)
+	if err != nil {
+		return err
+	}
+
+	var roleIds []string
+
+	// when this resource isn't exclusive, only track the roles that it manages so roles assigned elsewhere don't cause drift
+	if !data.Get("exclusive").(bool) {
+		roleIds = filterToManagedIds(getRoleIdsFromMapOfRealmAndClientRoles(roles), data.Get("role_ids").(*schema.Set))
+	} else {
+		roleIds, err = getRoleIdsNotImpliedByManagedComposites(keycloakClient, roles, data.Get("role_ids").(*schema.Set))
+		if err != nil {
+			return err
+		}
+
+		// an existing resource claims the user as well, so a conflict is found even when this resource doesn't change.
+		// the claim is made for the roles that were read, since those are what a later update changes the claim from
+		err = claimExclusiveUserRoles(keycloakClient, realmId, user.Id, userRolesClaim(data.Get("role_ids").(*schema.Set)), userRolesClaim(schema.NewSet(schema.HashString, stringSliceToInterfaceSlice(roleIds))))
+		if err != nil {
+			return err
+		}
+	}
+
+	data.Set("role_ids", roleIds)
+	data.Set("user_id", user.Id)
+	data.Set("username", user.Username)
+	data.SetId(userRolesId(realmId, user.Id))
+
+	return nil
+}
+
+func resourceKeycloakUserRolesUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// the role mapping calls only need the user's ID, so there's no need to fetch the user again here
+	user := &keycloak.User{
+		RealmId: realmId,
+		Id:      userId,
+	}
+
+	defer keycloakClient.LockUser(realmId, userId)()
+
+	if !data.Get("exclusive").(bool) {
+		// only add roles that were added to `role_ids`, and only remove roles that were removed from it
+		oldRoleIds, newRoleIds := data.GetChange("role_ids")
+
+		rolesToAdd, err := getMapOfRealmAndClientRoles(keycloakClient, realmId, interfaceSliceToStringSlice(newRoleIds.(*schema.Set).Difference(oldRoleIds.(*schema.Set)).List()))
+		if err != nil {
+			return err
+		}
+
+		rolesToRemove, err := getMapOfExistingRealmAndClientRoles(keycloakClient, realmId, interfaceSliceToStringSlice(oldRoleIds.(*schema.Set).Difference(newRoleIds.(*schema.Set)).List()))
+		if err != nil {
+			return err
+		}
+
+		err = addRolesToUser(keycloakClient, rolesToAdd, user)
+		if err != nil {
+			return err
+		}
+
+		err = removeRolesFromUser(keycloakClient, rolesToRemove, user)
+		if err != nil {
+			return err
+		}
+
+		return resourceKeycloakUserRolesRead(data, meta)
+	}
+
+	oldRoleIds, newRoleIds := data.GetChange("role_ids")
+
+	err := withUserRolesClaim(keycloakClient, true, realmId, userId, userRolesClaim(oldRoleIds.(*schema.Set)), userRolesClaim(newRoleIds.(*schema.Set)), func() error {
+		return updateExclusiveUserRoles(keycloakClient, user, interfaceSliceToStringSlice(newRoleIds.(*schema.Set).List()))
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakUserRolesRead(data, meta)
+}
+
+// makes `roleIds` the only roles that are directly assigned to the user, not counting roles that are implied by one of
+// the composite roles in `roleIds`
+func updateExclusiveUserRoles(keycloakClient *keycloak.KeycloakClient, user *keycloak.User, roleIds []string) error {
+	tfRoles, err := getMapOfRealmAndClientRoles(keycloakClient, user.RealmId, roleIds)
+	if err != nil {
+		return err
+	}
+
+	tfComposites := filterRoles(tfRoles, func(role *keycloak.Role) bool {
+		return role.Composite
+	})
+
+	remoteRoles, err := getMapOfRealmAndClientRolesFromUser(keycloakClient, user)
+	if err != nil {
+		return err
+	}
+
+	removeDuplicateRoles(&tfRoles, &remoteRoles)
+
+	err = removeRolesImpliedByComposites(keycloakClient, remoteRoles, tfComposites)
+	if err != nil {
+		return err
+	}
+
+	// `tfRoles` contains all roles that need to be added
+	// `remoteRoles` contains all roles that need to be removed
+
+	err = addRolesToUser(keycloakClient, tfRoles, user)
+	if err != nil {
+		return err
+	}
+
+	return removeRolesFromUser(keycloakClient, remoteRoles, user)
+}
+
+func resourceKeycloakUserRolesDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	keycloakClient.ReleaseUserRoles(realmId, userId, userRolesClaim(data.Get("role_ids").(*schema.Set)))
+
+	user, err := keycloakClient.GetUser(realmId, userId)
+	if err != nil {
+		// the user's roles were deleted along with the user, so there's nothing left to do
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	defer keycloakClient.LockUser(realmId, userId)()
+
+	roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
+	rolesToRemove, err := getMapOfExistingRealmAndClientRoles(keycloakClient, realmId, roleIds)
+	if err != nil {
+		return err
+	}
+
+	return removeRolesFromUser(keycloakClient, rolesToRemove, user)
+}
+
+func resourceKeycloakUserRolesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{userId}}.")
+	}
+
+	// make sure the user exists, otherwise this resource would be imported and then fail on every read
+	user, err := keycloakClient.GetUser(parts[0], parts[1])
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil, fmt.Errorf("cannot import keycloak_user_roles: user with id %s does not exist in realm %s", parts[1], parts[0])
+		}
+
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("user_id", parts[1])
+	d.Set("exclusive", true)
+
+	// service account users are imported with the same ID format, so `service_account_client_id` needs to be filled in
+	// here for configurations that use it instead of `user_id`
+	if user.ServiceAccountClientId != "" {
+		client, err := keycloakClient.GetGenericClientByClientId(parts[0], user.ServiceAccountClientId)
+		if err != nil {
+			return nil, err
+		}
+
+		d.Set("service_account_client_id", client.Id)
+	}
+
+	d.SetId(userRolesId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
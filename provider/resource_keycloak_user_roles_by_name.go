@@ -0,0 +1,433 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"sort"
+	"strings"
+)
+
+func resourceKeycloakUserRolesByName() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakUserRolesByNameCreate,
+		Read:   resourceKeycloakUserRolesByNameRead,
+		Update: resourceKeycloakUserRolesByNameUpdate,
+		Delete: resourceKeycloakUserRolesByNameDelete,
+		// This resource can be imported using {{realm}}/{{userId}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakUserRolesByNameImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"realm_roles": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Optional: true,
+			},
+			"client_roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"names": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"role_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// identifies a role by the `clientId` of the client it belongs to (empty for realm roles) and its name
+type roleKey struct {
+	client string
+	name   string
+}
+
+func getRoleNamesFromUserRolesByNameData(realmRoles, clientRoles interface{}) map[roleKey]bool {
+	roleNames := make(map[roleKey]bool)
+
+	for _, name := range realmRoles.(*schema.Set).List() {
+		roleNames[roleKey{name: name.(string)}] = true
+	}
+
+	for _, c := range clientRoles.(*schema.Set).List() {
+		clientRole := c.(map[string]interface{})
+		client := clientRole["client"].(string)
+
+		for _, name := range clientRole["names"].(*schema.Set).List() {
+			roleNames[roleKey{client: client, name: name.(string)}] = true
+		}
+	}
+
+	return roleNames
+}
+
+// identifies the roles that an exclusive resource manages, ignoring the order they were configured in
+func userRolesByNameClaim(wanted map[roleKey]bool) string {
+	var names []string
+	for key := range wanted {
+		names = append(names, key.client+"/"+key.name)
+	}
+	sort.Strings(names)
+
+	return "keycloak_user_roles_by_name:" + strings.Join(names, ",")
+}
+
+// keycloak doesn't always respond with a role name in the same case it was configured with. role names are case-sensitive,
+// so this is only used when reading: each remote role is matched to the configured key with exactly the same name, or
+// otherwise to a configured key that only differs in case and has no exact match of its own, so it doesn't show up as a change
+func findConfiguredRoleKeys(configured map[roleKey]bool, remote map[roleKey]*keycloak.Role) map[roleKey]roleKey {
+	matches := make(map[roleKey]roleKey)
+	for key := range remote {
+		if configured[key] {
+			matches[key] = key
+		}
+	}
+
+	for key := range remote {
+		if _, ok := matches[key]; ok {
+			continue
+		}
+
+		for k := range configured {
+			if k.client == key.client && strings.EqualFold(k.name, key.name) && remote[k] == nil {
+				matches[key] = k
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+// returns every role that is directly assigned to the user, keyed by the client it belongs to and its name
+func getRolesByNameFromUser(keycloakClient *keycloak.KeycloakClient, realmId, userId string) (map[roleKey]*keycloak.Role, error) {
+	roles := make(map[roleKey]*keycloak.Role)
+
+	roleMappings, err := keycloakClient.GetUserRoleMappings(realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, realmRole := range roleMappings.RealmMappings {
+		roles[roleKey{name: realmRole.Name}] = realmRole
+	}
+
+	for _, clientRoleMapping := range roleMappings.ClientMappings {
+		for _, clientRole := range clientRoleMapping.Mappings {
+			roles[roleKey{client: clientRoleMapping.Client, name: clientRole.Name}] = clientRole
+		}
+	}
+
+	return roles, nil
+}
+
+// looks up each role by name, and groups them by "realm" or client ID so they can be passed to `addRolesToUser`
+func getMapOfRealmAndClientRolesFromNames(keycloakClient *keycloak.KeycloakClient, realmId string, keys []roleKey) (map[string][]*keycloak.Role, error) {
+	roles := make(map[string][]*keycloak.Role)
+	cache := newRoleCache(keycloakClient, realmId)
+	clients := make(map[string]*keycloak.GenericClient)
+
+	for _, key := range keys {
+		if key.client == "" {
+			role, err := cache.getRoleByName("", key.name)
+			if err != nil {
+				return nil, err
+			}
+
+			roles["realm"] = append(roles["realm"], role)
+
+			continue
+		}
+
+		client, ok := clients[key.client]
+		if !ok {
+			var err error
+			client, err = keycloakClient.GetGenericClientByClientId(realmId, key.client)
+			if err != nil {
+				// the client's role mappings are deleted along with it, so its roles are no longer read back and show
+				// up as a change. say which client is gone instead of failing on every apply with a generic error
+				if keycloak.ErrorIs404(err) {
+					return nil, fmt.Errorf("cannot assign role %s: client %s does not exist in realm %s. If it was deleted, remove it from client_roles", key.name, key.client, realmId)
+				}
+
+				return nil, err
+			}
+
+			clients[key.client] = client
+		}
+
+		role, err := cache.getClientRoleByName(client, key.name)
+		if err != nil {
+			return nil, err
+		}
+
+		roles[client.Id] = append(roles[client.Id], role)
+	}
+
+	return roles, nil
+}
+
+func groupRolesByContainer(roles []*keycloak.Role) map[string][]*keycloak.Role {
+	rolesByContainer := make(map[string][]*keycloak.Role)
+
+	for _, role := range roles {
+		if role.ClientRole {
+			rolesByContainer[role.ClientId] = append(rolesByContainer[role.ClientId], role)
+		} else {
+			rolesByContainer["realm"] = append(rolesByContainer["realm"], role)
+		}
+	}
+
+	return rolesByContainer
+}
+
+// assigns the roles in `wanted` that the user doesn't have yet, then removes any other roles the user has for which
+// `shouldRemove` returns true
+func syncUserRolesByName(keycloakClient *keycloak.KeycloakClient, user *keycloak.User, wanted map[roleKey]bool, shouldRemove func(roleKey) bool) error {
+	defer keycloakClient.LockUser(user.RealmId, user.Id)()
+
+	remoteRoles, err := getRolesByNameFromUser(keycloakClient, user.RealmId, user.Id)
+	if err != nil {
+		return err
+	}
+
+	var keysToAdd []roleKey
+	for key := range wanted {
+		if remoteRoles[key] == nil {
+			keysToAdd = append(keysToAdd, key)
+		}
+	}
+
+	var rolesToRemove []*keycloak.Role
+	for key, role := range remoteRoles {
+		if !wanted[key] && shouldRemove(key) {
+			rolesToRemove = append(rolesToRemove, role)
+		}
+	}
+
+	rolesToAdd, err := getMapOfRealmAndClientRolesFromNames(keycloakClient, user.RealmId, keysToAdd)
+	if err != nil {
+		return err
+	}
+
+	err = addRolesToUser(keycloakClient, rolesToAdd, user)
+	if err != nil {
+		return err
+	}
+
+	return removeRolesFromUser(keycloakClient, groupRolesByContainer(rolesToRemove), user)
+}
+
+func resourceKeycloakUserRolesByNameCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	user, err := keycloakClient.GetUser(realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	wanted := getRoleNamesFromUserRolesByNameData(data.Get("realm_roles"), data.Get("client_roles"))
+	exclusive := data.Get("exclusive").(bool)
+
+	err = withUserRolesClaim(keycloakClient, exclusive, realmId, userId, "", userRolesByNameClaim(wanted), func() error {
+		return syncUserRolesByName(keycloakClient, user, wanted, func(roleKey) bool {
+			return exclusive
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	data.SetId(userRolesId(realmId, userId))
+
+	return resourceKeycloakUserRolesByNameRead(data, meta)
+}
+
+func resourceKeycloakUserRolesByNameRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	remoteRoles, err := getRolesByNameFromUser(keycloakClient, realmId, userId)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	// when this resource isn't exclusive, only track the roles that it manages so roles assigned elsewhere don't cause drift
+	managed := getRoleNamesFromUserRolesByNameData(data.Get("realm_roles"), data.Get("client_roles"))
+	exclusive := data.Get("exclusive").(bool)
+
+	// the IDs that the names resolved to are tracked as well, so a role that is renamed after being assigned is still
+	// recognized and shows up under its new name, which is then reported as a change
+	managedIds := data.Get("role_ids").(*schema.Set)
+
+	var realmRoles []string
+	var roleIds []string
+	namesByClient := make(map[string][]interface{})
+	read := make(map[roleKey]bool)
+
+	configuredKeys := findConfiguredRoleKeys(managed, remoteRoles)
+
+	for key, role := range remoteRoles {
+		managedKey, isManaged := configuredKeys[key]
+		if !exclusive && !isManaged && !managedIds.Contains(role.Id) {
+			continue
+		}
+
+		// use the name from the configuration when it only differs in case, so it doesn't show up as a change
+		if isManaged {
+			key = managedKey
+		}
+
+		roleIds = append(roleIds, role.Id)
+		read[key] = true
+
+		if key.client == "" {
+			realmRoles = append(realmRoles, key.name)
+		} else {
+			namesByClient[key.client] = append(namesByClient[key.client], key.name)
+		}
+	}
+
+	// an existing resource claims the user as well, so a conflict is found even when this resource doesn't change.
+	// the claim is made for the roles that were read, since those are what a later update changes the claim from
+	if exclusive {
+		err = claimExclusiveUserRoles(keycloakClient, realmId, userId, userRolesByNameClaim(managed), userRolesByNameClaim(read))
+		if err != nil {
+			return err
+		}
+	}
+
+	var clientRoles []interface{}
+	for client, names := range namesByClient {
+		clientRoles = append(clientRoles, map[string]interface{}{
+			"client": client,
+			"names":  schema.NewSet(schema.HashString, names),
+		})
+	}
+
+	data.Set("realm_roles", realmRoles)
+	data.Set("client_roles", clientRoles)
+	data.Set("role_ids", roleIds)
+	data.SetId(userRolesId(realmId, userId))
+
+	return nil
+}
+
+func resourceKeycloakUserRolesByNameUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	user := &keycloak.User{
+		RealmId: realmId,
+		Id:      userId,
+	}
+
+	oldRealmRoles, newRealmRoles := data.GetChange("realm_roles")
+	oldClientRoles, newClientRoles := data.GetChange("client_roles")
+
+	wanted := getRoleNamesFromUserRolesByNameData(newRealmRoles, newClientRoles)
+	previouslyManaged := getRoleNamesFromUserRolesByNameData(oldRealmRoles, oldClientRoles)
+	exclusive := data.Get("exclusive").(bool)
+
+	// when this resource isn't exclusive, only roles that were removed from the configuration are removed from the user
+	err := withUserRolesClaim(keycloakClient, exclusive, realmId, userId, userRolesByNameClaim(previouslyManaged), userRolesByNameClaim(wanted), func() error {
+		return syncUserRolesByName(keycloakClient, user, wanted, func(key roleKey) bool {
+			return exclusive || previouslyManaged[key]
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakUserRolesByNameRead(data, meta)
+}
+
+func resourceKeycloakUserRolesByNameDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	managed := getRoleNamesFromUserRolesByNameData(data.Get("realm_roles"), data.Get("client_roles"))
+
+	keycloakClient.ReleaseUserRoles(realmId, userId, userRolesByNameClaim(managed))
+
+	user, err := keycloakClient.GetUser(realmId, userId)
+	if err != nil {
+		// the user's roles were deleted along with the user, so there's nothing left to do
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return syncUserRolesByName(keycloakClient, user, nil, func(key roleKey) bool {
+		return managed[key]
+	})
+}
+
+func resourceKeycloakUserRolesByNameImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{userId}}.")
+	}
+
+	_, err := keycloakClient.GetUser(parts[0], parts[1])
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil, fmt.Errorf("cannot import keycloak_user_roles_by_name: user with id %s does not exist in realm %s", parts[1], parts[0])
+		}
+
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("user_id", parts[1])
+	d.Set("exclusive", true)
+
+	d.SetId(userRolesId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
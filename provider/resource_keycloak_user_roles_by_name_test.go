@@ -0,0 +1,457 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAccKeycloakUserRolesByName_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+	clientId := "terraform-openid-client-" + acctest.RandString(10)
+	clientRoleName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRolesByName_basic(realmName, realmRoleName, clientId, clientRoleName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_roles_by_name.user_roles", "role_ids.#", "3"),
+					resource.TestCheckResourceAttr("keycloak_user_roles_by_name.user_roles", "realm_roles.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_user_roles_by_name.user_roles", "client_roles.#", "1"),
+					testAccCheckKeycloakUserHasRoles("keycloak_user_roles_by_name.user_roles"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_user_roles_by_name.user_roles",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRolesByName_roleRenamed(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+	clientId := "terraform-openid-client-" + acctest.RandString(10)
+	clientRoleName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRolesByName_basic(realmName, realmRoleName, clientId, clientRoleName, username),
+				Check:  testAccCheckKeycloakUserHasRoles("keycloak_user_roles_by_name.user_roles"),
+			},
+			{
+				// renaming the role outside of terraform should be detected as drift
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					role, err := keycloakClient.GetRoleByName(realmName, "", realmRoleName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					role.Name = realmRoleName + "-renamed"
+
+					err = keycloakClient.UpdateRole(role)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testKeycloakUserRolesByName_basic(realmName, realmRoleName, clientId, clientRoleName, username),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRolesByName_nonExclusive(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleOneName := "terraform-role-" + acctest.RandString(10)
+	realmRoleTwoName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRolesByName_nonExclusive(realmName, realmRoleOneName, realmRoleTwoName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_roles_by_name.user_roles_one", "realm_roles.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_user_roles_by_name.user_roles_two", "realm_roles.#", "1"),
+					testAccCheckKeycloakUserHasRolesIncluding("keycloak_user_roles_by_name.user_roles_one"),
+					testAccCheckKeycloakUserHasRolesIncluding("keycloak_user_roles_by_name.user_roles_two"),
+				),
+			},
+		},
+	})
+}
+
+// the client is deleted outside of terraform, which deletes its roles and their mappings along with it. this should show
+// up as a change instead of failing the plan
+func TestAccKeycloakUserRolesByName_clientDeletedOutsideOfTerraform(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+	clientId := "terraform-openid-client-" + acctest.RandString(10)
+	clientRoleName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRolesByName_basic(realmName, realmRoleName, clientId, clientRoleName, username),
+				Check:  testAccCheckKeycloakUserHasRoles("keycloak_user_roles_by_name.user_roles"),
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					client, err := keycloakClient.GetGenericClientByClientId(realmName, clientId)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.DeleteOpenidClient(realmName, client.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testKeycloakUserRolesByName_basic(realmName, realmRoleName, clientId, clientRoleName, username),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKeycloakUserRolesByName_basic(realmName, realmRoleName, clientId, clientRoleName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_roles_by_name.user_roles", "client_roles.#", "1"),
+					testAccCheckKeycloakUserHasRoles("keycloak_user_roles_by_name.user_roles"),
+				),
+			},
+		},
+	})
+}
+
+func TestKeycloakUserRolesByName_clientDoesNotExist(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/auth/admin/realms/test/users/user-id/role-mappings":
+			w.Write([]byte(`{}`))
+		case r.URL.Path == "/auth/admin/realms/test/clients":
+			w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	user := &keycloak.User{RealmId: "test", Id: "user-id"}
+
+	err := syncUserRolesByName(keycloakClient, user, map[roleKey]bool{{client: "deleted-client", name: "foo"}: true}, func(roleKey) bool {
+		return true
+	})
+	if err == nil {
+		t.Fatal("expected an error when the client does not exist")
+	}
+
+	expected := "cannot assign role foo: client deleted-client does not exist in realm test"
+	if !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error to contain %q, got %q", expected, err.Error())
+	}
+}
+
+// two resources managing roles for the same user are applied at the same time. each one reads the user's role mappings
+// before changing them, so the second one must not read them until the first one is done
+func TestKeycloakUserRolesByName_syncSerializesWritesToSameUser(t *testing.T) {
+	var mutex sync.Mutex
+	var requests []string
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/roles":
+			w.Write([]byte(`[{"id": "role-a", "name": "a"}, {"id": "role-b", "name": "b"}]`))
+		case strings.HasPrefix(r.URL.Path, "/auth/admin/realms/test/users/user-id/role-mappings"):
+			mutex.Lock()
+			requests = append(requests, r.Method)
+			mutex.Unlock()
+
+			// give the other resource a chance to interleave its requests if it isn't waiting
+			time.Sleep(20 * time.Millisecond)
+
+			if r.Method == http.MethodGet {
+				w.Write([]byte(`{"realmMappings": []}`))
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	user := &keycloak.User{RealmId: "test", Id: "user-id"}
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"a", "b"} {
+		wg.Add(1)
+
+		go func(name string) {
+			defer wg.Done()
+
+			err := syncUserRolesByName(keycloakClient, user, map[roleKey]bool{{name: name}: true}, func(roleKey) bool {
+				return false
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	if strings.Join(requests, ",") != "GET,POST,GET,POST" {
+		t.Fatalf("expected each resource to read and then write the user's roles before the other started, got %v", requests)
+	}
+}
+
+// keycloak responds with "Foo" for a role that's configured as "foo". the role is already assigned, so nothing should be
+// changed, and the configured name should be kept so there's no diff
+func TestKeycloakUserRolesByName_roleNameCaseDiffers(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/users/user-id/role-mappings":
+			w.Write([]byte(`{"realmMappings": [{"id": "role-foo", "name": "Foo"}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRolesByName().Schema, map[string]interface{}{
+		"realm_id":    "test",
+		"user_id":     "user-id",
+		"realm_roles": []interface{}{"foo"},
+	})
+
+	err := resourceKeycloakUserRolesByNameRead(data, keycloakClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	realmRoles := data.Get("realm_roles").(*schema.Set)
+	if realmRoles.Len() != 1 || !realmRoles.Contains("foo") {
+		t.Fatalf("expected realm_roles to keep the configured name foo, got %v", realmRoles.List())
+	}
+}
+
+// role names are case-sensitive, so roles that only differ in case are assigned and removed independently, and only
+// reported under the configured name when no role with exactly that name is assigned
+func TestKeycloakUserRolesByName_roleNamesAreCaseSensitive(t *testing.T) {
+	var requests []string
+	roleMappings := `{"realmMappings": [{"id": "role-admin-lower", "name": "admin"}]}`
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/users/user-id/role-mappings":
+			w.Write([]byte(roleMappings))
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/roles":
+			w.Write([]byte(`[{"id": "role-admin-lower", "name": "admin"}, {"id": "role-admin-upper", "name": "Admin"}]`))
+		case r.URL.Path == "/auth/admin/realms/test/users/user-id/role-mappings/realm":
+			body, _ := ioutil.ReadAll(r.Body)
+			requests = append(requests, r.Method+" "+string(body))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	user := &keycloak.User{RealmId: "test", Id: "user-id"}
+
+	err := syncUserRolesByName(keycloakClient, user, map[roleKey]bool{{name: "Admin"}: true}, func(roleKey) bool {
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(requests)
+	if len(requests) != 2 || !strings.Contains(requests[0], "role-admin-lower") || !strings.HasPrefix(requests[0], http.MethodDelete) ||
+		!strings.Contains(requests[1], "role-admin-upper") || !strings.HasPrefix(requests[1], http.MethodPost) {
+		t.Fatalf("expected Admin to be added and admin to be removed, got %v", requests)
+	}
+
+	// when both are assigned, the one that isn't configured shows up as a change rather than being mistaken for the other
+	roleMappings = `{"realmMappings": [{"id": "role-admin-lower", "name": "admin"}, {"id": "role-admin-upper", "name": "Admin"}]}`
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRolesByName().Schema, map[string]interface{}{
+		"realm_id":    "test",
+		"user_id":     "user-id",
+		"exclusive":   true,
+		"realm_roles": []interface{}{"Admin"},
+	})
+
+	err = resourceKeycloakUserRolesByNameRead(data, keycloakClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	realmRoles := data.Get("realm_roles").(*schema.Set)
+	if realmRoles.Len() != 2 || !realmRoles.Contains("admin") || !realmRoles.Contains("Admin") {
+		t.Fatalf("expected realm_roles to contain admin and Admin, got %v", realmRoles.List())
+	}
+}
+
+// a non-exclusive resource only reads back the roles it manages, but a managed role that was renamed outside of terraform
+// is still recognized by its ID and reported under its new name
+func TestKeycloakUserRolesByName_readRenamedRole(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/users/user-id/role-mappings":
+			w.Write([]byte(`{"realmMappings": [{"id": "role-foo", "name": "bar"}, {"id": "role-other", "name": "other"}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRolesByName().Schema, map[string]interface{}{
+		"realm_id":    "test",
+		"user_id":     "user-id",
+		"exclusive":   false,
+		"realm_roles": []interface{}{"foo"},
+	})
+	data.SetId(userRolesId("test", "user-id"))
+	// the ID that foo resolved to when it was assigned
+	data.Set("role_ids", []string{"role-foo"})
+
+	err := resourceKeycloakUserRolesByNameRead(data, keycloakClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	realmRoles := data.Get("realm_roles").(*schema.Set)
+	if realmRoles.Len() != 1 || !realmRoles.Contains("bar") {
+		t.Fatalf("expected realm_roles to contain only the renamed role bar, got %v", realmRoles.List())
+	}
+}
+
+func testKeycloakUserRolesByName_basic(realmName, realmRoleName, clientId, clientRoleName, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "CONFIDENTIAL"
+}
+
+resource "keycloak_role" "client_role" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.client.id}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles_by_name" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	realm_roles = [
+		"${keycloak_role.realm_role.name}",
+		"offline_access",
+	]
+
+	client_roles {
+		client = "${keycloak_openid_client.client.client_id}"
+		names  = [
+			"${keycloak_role.client_role.name}",
+		]
+	}
+}
+	`, realmName, realmRoleName, clientId, clientRoleName, username)
+}
+
+func testKeycloakUserRolesByName_nonExclusive(realmName, realmRoleOneName, realmRoleTwoName, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role_one" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "realm_role_two" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles_by_name" "user_roles_one" {
+	realm_id    = "${keycloak_realm.realm.id}"
+	user_id     = "${keycloak_user.user.id}"
+	exclusive   = false
+	realm_roles = ["${keycloak_role.realm_role_one.name}"]
+}
+
+resource "keycloak_user_roles_by_name" "user_roles_two" {
+	realm_id    = "${keycloak_realm.realm.id}"
+	user_id     = "${keycloak_user.user.id}"
+	exclusive   = false
+	realm_roles = ["${keycloak_role.realm_role_two.name}"]
+}
+	`, realmName, realmRoleOneName, realmRoleTwoName, username)
+}
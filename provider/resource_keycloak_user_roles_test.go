@@ -0,0 +1,1601 @@
+package provider
+
+import (
+	"fmt"
+	configs "github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/config/hcl2shim"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAccKeycloakUserRoles_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+	openIdClientName := "terraform-openid-client-" + acctest.RandString(10)
+	openIdRoleName := "terraform-role-" + acctest.RandString(10)
+	samlClientName := "terraform-saml-client-" + acctest.RandString(10)
+	samlRoleName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_basic(realmName, openIdClientName, samlClientName, realmRoleName, openIdRoleName, samlRoleName, username),
+				Check:  testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+			},
+			{
+				ResourceName:      "keycloak_user_roles.user_roles",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_importUserDoesNotExist(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+	openIdClientName := "terraform-openid-client-" + acctest.RandString(10)
+	openIdRoleName := "terraform-role-" + acctest.RandString(10)
+	samlClientName := "terraform-saml-client-" + acctest.RandString(10)
+	samlRoleName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_basic(realmName, openIdClientName, samlClientName, realmRoleName, openIdRoleName, samlRoleName, username),
+			},
+			{
+				ResourceName:  "keycloak_user_roles.user_roles",
+				ImportState:   true,
+				ImportStateId: realmName + "/this-user-does-not-exist",
+				ExpectError:   regexp.MustCompile("user with id this-user-does-not-exist does not exist"),
+			},
+		},
+	})
+}
+
+// this doesn't need a keycloak instance, since destroying the resource after the user has been deleted should not send
+// any requests other than fetching the user
+func TestKeycloakUserRoles_deleteWhenUserDoesNotExist(t *testing.T) {
+	var unexpectedRequests []string
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/admin/realms/test/users/deleted-user":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			unexpectedRequests = append(unexpectedRequests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRoles().Schema, map[string]interface{}{
+		"realm_id": "test",
+		"user_id":  "deleted-user",
+		"role_ids": []interface{}{"role-id"},
+	})
+	data.SetId(userRolesId("test", "deleted-user"))
+
+	err := resourceKeycloakUserRolesDelete(data, keycloakClient)
+	if err != nil {
+		t.Fatalf("expected delete to succeed when the user does not exist, got %s", err)
+	}
+
+	if len(unexpectedRequests) != 0 {
+		t.Fatalf("expected no requests other than fetching the user, got %v", unexpectedRequests)
+	}
+}
+
+func TestKeycloakUserRoles_readWhenUserDoesNotExist(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/auth/admin/realms/test/users/deleted-user":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRoles().Schema, map[string]interface{}{
+		"realm_id": "test",
+		"user_id":  "deleted-user",
+		"role_ids": []interface{}{"role-id"},
+	})
+	data.SetId(userRolesId("test", "deleted-user"))
+
+	err := resourceKeycloakUserRolesRead(data, keycloakClient)
+	if err != nil {
+		t.Fatalf("expected read to succeed when the user does not exist, got %s", err)
+	}
+
+	if data.Id() != "" {
+		t.Errorf("expected the resource to be removed from state, got id %s", data.Id())
+	}
+}
+
+// a user that is looked up by username and no longer exists is handled the same way as one looked up by ID
+func TestKeycloakUserRoles_readWhenUsernameDoesNotExist(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/auth/admin/realms/test/users":
+			w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRoles().Schema, map[string]interface{}{
+		"realm_id": "test",
+		"username": "deleted-user",
+		"role_ids": []interface{}{"role-id"},
+	})
+	data.SetId(userRolesId("test", "deleted-user-id"))
+
+	err := resourceKeycloakUserRolesRead(data, keycloakClient)
+	if err != nil {
+		t.Fatalf("expected read to succeed when the user does not exist, got %s", err)
+	}
+
+	if data.Id() != "" {
+		t.Errorf("expected the resource to be removed from state, got id %s", data.Id())
+	}
+}
+
+// two exclusive resources for the same user would remove each other's roles forever, so the second one fails before
+// changing any role mappings
+func TestKeycloakUserRoles_twoExclusiveResourcesForSameUser(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test":
+			w.Write([]byte(`{"id": "test", "realm": "test"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/users/user-id":
+			w.Write([]byte(`{"id": "user-id", "username": "user"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	// another keycloak_user_roles resource already manages this user's roles
+	err := claimExclusiveUserRoles(keycloakClient, "test", "user-id", "", userRolesClaim(schema.NewSet(schema.HashString, []interface{}{"role-a"})))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRoles().Schema, map[string]interface{}{
+		"realm_id": "test",
+		"user_id":  "user-id",
+		"role_ids": []interface{}{"role-b"},
+	})
+
+	err = resourceKeycloakUserRolesCreate(data, keycloakClient)
+	if err == nil {
+		t.Fatal("expected an error when a second exclusive resource manages the same user")
+	}
+
+	if !strings.Contains(err.Error(), "more than one resource with exclusive = true") {
+		t.Errorf("expected the error to explain the conflict, got %s", err)
+	}
+}
+
+// an exclusive resource that already exists claims the user when it's read, so a second exclusive resource is found
+// even when the first one has no changes to apply
+func TestKeycloakUserRoles_readClaimsExclusiveUser(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test":
+			w.Write([]byte(`{"id": "test", "realm": "test"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/users/user-id":
+			w.Write([]byte(`{"id": "user-id", "username": "user"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/users/user-id/role-mappings":
+			w.Write([]byte(`{"realmMappings": [{"id": "role-a", "name": "a"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	existing := resourceKeycloakUserRoles().Data(&terraform.InstanceState{
+		ID: userRolesId("test", "user-id"),
+		Attributes: map[string]string{
+			"realm_id":   "test",
+			"user_id":    "user-id",
+			"exclusive":  "true",
+			"role_ids.#": "1",
+			fmt.Sprintf("role_ids.%d", schema.HashString("role-a")): "role-a",
+		},
+	})
+
+	err := resourceKeycloakUserRolesRead(existing, keycloakClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRoles().Schema, map[string]interface{}{
+		"realm_id": "test",
+		"user_id":  "user-id",
+		"role_ids": []interface{}{"role-b"},
+	})
+
+	err = resourceKeycloakUserRolesCreate(data, keycloakClient)
+	if err == nil || !strings.Contains(err.Error(), "more than one resource with exclusive = true") {
+		t.Fatalf("expected an error when a second exclusive resource manages the same user, got %v", err)
+	}
+}
+
+// a resource that fails to be created doesn't keep its claim, otherwise fixing the configuration could conflict with it
+func TestKeycloakUserRoles_createFailureReleasesClaim(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test":
+			w.Write([]byte(`{"id": "test", "realm": "test"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/users/user-id":
+			w.Write([]byte(`{"id": "user-id", "username": "user"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/roles":
+			w.Write([]byte(`[]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/auth/admin/realms/test/roles-by-id/role-a":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRoles().Schema, map[string]interface{}{
+		"realm_id": "test",
+		"user_id":  "user-id",
+		"role_ids": []interface{}{"role-a"},
+	})
+
+	err := resourceKeycloakUserRolesCreate(data, keycloakClient)
+	if err == nil {
+		t.Fatal("expected an error when a role doesn't exist")
+	}
+
+	err = claimExclusiveUserRoles(keycloakClient, "test", "user-id", "", userRolesClaim(schema.NewSet(schema.HashString, []interface{}{"role-b"})))
+	if err != nil {
+		t.Fatalf("expected the claim of the failed resource to be released, got %s", err)
+	}
+}
+
+func TestKeycloakUserRoles_deleteSkipsRolesThatDoNotExist(t *testing.T) {
+	var removedRoles string
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /auth/admin/realms/test/users/user-id":
+			w.Write([]byte(`{"id": "user-id", "username": "user"}`))
+		case "GET /auth/admin/realms/test/roles":
+			w.Write([]byte(`[{"id": "role-id", "name": "role", "clientRole": false}]`))
+		case "GET /auth/admin/realms/test/roles-by-id/deleted-role-id":
+			w.WriteHeader(http.StatusNotFound)
+		case "DELETE /auth/admin/realms/test/users/user-id/role-mappings/realm":
+			body, _ := ioutil.ReadAll(r.Body)
+			removedRoles = string(body)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	data := schema.TestResourceDataRaw(t, resourceKeycloakUserRoles().Schema, map[string]interface{}{
+		"realm_id": "test",
+		"user_id":  "user-id",
+		"role_ids": []interface{}{"role-id", "deleted-role-id"},
+	})
+	data.SetId(userRolesId("test", "user-id"))
+
+	err := resourceKeycloakUserRolesDelete(data, keycloakClient)
+	if err != nil {
+		t.Fatalf("expected delete to succeed when one of the roles does not exist, got %s", err)
+	}
+
+	if !strings.Contains(removedRoles, `"role-id"`) || strings.Contains(removedRoles, "deleted-role-id") {
+		t.Fatalf("expected only role-id to be removed, got %s", removedRoles)
+	}
+}
+
+// the user has a composite role, one of the roles within it, and an unrelated role assigned directly. an exclusive
+// resource with the composite should only remove the unrelated role, whether or not the child is in the configuration
+func TestKeycloakUserRoles_updateKeepsRolesImpliedByComposites(t *testing.T) {
+	tests := []struct {
+		name     string
+		roleIds  []interface{}
+		expected []string
+	}{
+		{name: "composite only", roleIds: []interface{}{"composite-id"}, expected: []string{"composite-id"}},
+		{name: "composite and child", roleIds: []interface{}{"composite-id", "child-id"}, expected: []string{"child-id", "composite-id"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assigned := `[{"id": "composite-id", "name": "composite", "composite": true}, {"id": "child-id", "name": "child"}, {"id": "other-id", "name": "other"}]`
+			var removedRoles string
+
+			keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.Method + " " + r.URL.Path {
+				case "GET /auth/admin/realms/test/users/user-id":
+					w.Write([]byte(`{"id": "user-id", "username": "user"}`))
+				case "GET /auth/admin/realms/test/roles":
+					w.Write([]byte(`[{"id": "composite-id", "name": "composite", "composite": true}, {"id": "child-id", "name": "child"}, {"id": "other-id", "name": "other"}]`))
+				case "GET /auth/admin/realms/test/users/user-id/role-mappings":
+					w.Write([]byte(fmt.Sprintf(`{"realmMappings": %s}`, assigned)))
+				case "GET /auth/admin/realms/test/roles-by-id/composite-id/composites":
+					w.Write([]byte(`[{"id": "child-id", "name": "child"}]`))
+				case "DELETE /auth/admin/realms/test/users/user-id/role-mappings/realm":
+					body, _ := ioutil.ReadAll(r.Body)
+					removedRoles = string(body)
+					assigned = `[{"id": "composite-id", "name": "composite", "composite": true}, {"id": "child-id", "name": "child"}]`
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			})
+			defer server.Close()
+
+			data := schema.TestResourceDataRaw(t, resourceKeycloakUserRoles().Schema, map[string]interface{}{
+				"realm_id": "test",
+				"user_id":  "user-id",
+				"role_ids": test.roleIds,
+			})
+			data.SetId(userRolesId("test", "user-id"))
+
+			err := resourceKeycloakUserRolesUpdate(data, keycloakClient)
+			if err != nil {
+				t.Fatalf("expected update to succeed, got %s", err)
+			}
+
+			if !strings.Contains(removedRoles, `"other-id"`) || strings.Contains(removedRoles, "child-id") || strings.Contains(removedRoles, "composite-id") {
+				t.Fatalf("expected only other-id to be removed, got %s", removedRoles)
+			}
+
+			roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
+			sort.Strings(roleIds)
+
+			if !reflect.DeepEqual(roleIds, test.expected) {
+				t.Fatalf("expected role_ids to be %v after reading, got %v", test.expected, roleIds)
+			}
+		})
+	}
+}
+
+// serves the role mapping endpoints for a single user, taking `delay` to respond to each client role mapping request
+func newClientRoleMappingsServer(t testing.TB, delay time.Duration, requests, inFlight, maxInFlight *int32) *httptest.Server {
+	return newTestKeycloakServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodPost || !strings.HasPrefix(r.URL.Path, "/auth/admin/realms/test/users/user-id/role-mappings/clients/") {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		atomic.AddInt32(requests, 1)
+		current := atomic.AddInt32(inFlight, 1)
+		for {
+			max := atomic.LoadInt32(maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(maxInFlight, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(delay)
+		atomic.AddInt32(inFlight, -1)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func clientRolesToAdd(clients int) map[string][]*keycloak.Role {
+	roles := map[string][]*keycloak.Role{
+		// empty containers shouldn't result in any requests
+		"realm":        {},
+		"empty-client": {},
+	}
+
+	for i := 0; i < clients; i++ {
+		clientId := fmt.Sprintf("client-%d", i)
+		roles[clientId] = []*keycloak.Role{{Id: "role-" + clientId, Name: "role", ClientRole: true, ClientId: clientId}}
+	}
+
+	return roles
+}
+
+func TestKeycloakUserRoles_addClientRolesConcurrently(t *testing.T) {
+	var requests, inFlight, maxInFlight int32
+
+	server := newClientRoleMappingsServer(t, 20*time.Millisecond, &requests, &inFlight, &maxInFlight)
+	defer server.Close()
+
+	keycloakClient := newTestKeycloakClientForServer(t, server, 4)
+
+	err := addRolesToUser(keycloakClient, clientRolesToAdd(15), &keycloak.User{RealmId: "test", Id: "user-id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 15 {
+		t.Fatalf("expected one request per client with roles, got %d", requests)
+	}
+
+	if maxInFlight < 2 || maxInFlight > 4 {
+		t.Fatalf("expected between 2 and 4 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func BenchmarkAddRolesToUser(b *testing.B) {
+	for _, maxConcurrency := range []int{1, 4} {
+		b.Run(fmt.Sprintf("max_concurrency_%d", maxConcurrency), func(b *testing.B) {
+			var requests, inFlight, maxInFlight int32
+
+			server := newClientRoleMappingsServer(b, 5*time.Millisecond, &requests, &inFlight, &maxInFlight)
+			defer server.Close()
+
+			keycloakClient := newTestKeycloakClientForServer(b, server, maxConcurrency)
+
+			roles := clientRolesToAdd(10)
+			user := &keycloak.User{RealmId: "test", Id: "user-id"}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				err := addRolesToUser(keycloakClient, roles, user)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestAccKeycloakUserRoles_realmDoesNotExist(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "%s"
+	user_id  = "user-id"
+
+	role_ids = ["role-id"]
+}
+				`, realmName),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("realm %s does not exist", realmName)),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_roleDeletedOutsideOfTerraform(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleOneName := "terraform-role-" + acctest.RandString(10)
+	realmRoleTwoName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	var deletedRoleId string
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_nonExclusive(realmName, realmRoleOneName, realmRoleTwoName, username),
+				Check: func(s *terraform.State) error {
+					deletedRoleId = s.RootModule().Resources["keycloak_role.realm_role_two"].Primary.ID
+
+					return nil
+				},
+			},
+			// the role is deleted and then removed from the configuration, which shouldn't fail on the missing role
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					err := keycloakClient.DeleteRole(realmName, deletedRoleId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUserRoles_roleRemoved(realmName, realmRoleOneName, username),
+				Check:  testAccCheckKeycloakUserHasRolesIncluding("keycloak_user_roles.user_roles_one"),
+			},
+		},
+	})
+}
+
+// the user is deleted outside of terraform, which should cause this resource to be recreated instead of failing the plan
+func TestAccKeycloakUserRoles_userDeletedOutsideOfTerraform(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleOneName := "terraform-role-" + acctest.RandString(10)
+	realmRoleTwoName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	var userId string
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_username(realmName, realmRoleOneName, realmRoleTwoName, username),
+				Check: func(s *terraform.State) error {
+					userId = s.RootModule().Resources["keycloak_user.user"].Primary.ID
+
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					err := keycloakClient.DeleteUser(realmName, userId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testKeycloakUserRoles_username(realmName, realmRoleOneName, realmRoleTwoName, username),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKeycloakUserRoles_username(realmName, realmRoleOneName, realmRoleTwoName, username),
+				Check:  testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+			},
+		},
+	})
+}
+
+// role mappings are read by ID, so renaming a role outside of terraform shouldn't change the roles this resource tracks
+func TestAccKeycloakUserRoles_roleRenamedOutsideOfTerraform(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+	renamedRealmRoleName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	var renamedRoleId string
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_roleRemoved(realmName, realmRoleName, username),
+				Check: func(s *terraform.State) error {
+					renamedRoleId = s.RootModule().Resources["keycloak_role.realm_role_one"].Primary.ID
+
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					role, err := keycloakClient.GetRole(realmName, renamedRoleId)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					role.Name = renamedRealmRoleName
+
+					err = keycloakClient.UpdateRole(role)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				// the configuration matches the new name, so neither the role nor its assignment should change
+				Config:   testKeycloakUserRoles_roleRemoved(realmName, renamedRealmRoleName, username),
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakUserRoles_roleRemoved(realmName, renamedRealmRoleName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_roles.user_roles_one", "role_ids.#", "1"),
+					testAccCheckKeycloakUserHasRolesIncluding("keycloak_user_roles.user_roles_one"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_update(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+
+	realmRoleOneName := "terraform-role-" + acctest.RandString(10)
+	realmRoleTwoName := "terraform-role-" + acctest.RandString(10)
+	openIdClientName := "terraform-openid-client-" + acctest.RandString(10)
+	openIdRoleOneName := "terraform-role-" + acctest.RandString(10)
+	openIdRoleTwoName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	allRoleIds := []string{
+		"${keycloak_role.realm_role_one.id}",
+		"${keycloak_role.realm_role_two.id}",
+		"${keycloak_role.openid_client_role_one.id}",
+		"${keycloak_role.openid_client_role_two.id}",
+		"${data.keycloak_role.offline_access.id}",
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// initial setup, resource is defined but no roles are specified
+			{
+				Config: testKeycloakUserRoles_update(realmName, openIdClientName, realmRoleOneName, realmRoleTwoName, openIdRoleOneName, openIdRoleTwoName, username, []string{}),
+				Check:  testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+			},
+			// add all roles
+			{
+				Config: testKeycloakUserRoles_update(realmName, openIdClientName, realmRoleOneName, realmRoleTwoName, openIdRoleOneName, openIdRoleTwoName, username, allRoleIds),
+				Check:  testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+			},
+			// add some and remove some
+			{
+				Config: testKeycloakUserRoles_update(realmName, openIdClientName, realmRoleOneName, realmRoleTwoName, openIdRoleOneName, openIdRoleTwoName, username, []string{
+					"${keycloak_role.realm_role_two.id}",
+					"${keycloak_role.openid_client_role_one.id}",
+				}),
+				Check: testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+			},
+			// random scenario
+			{
+				Config: testKeycloakUserRoles_update(realmName, openIdClientName, realmRoleOneName, realmRoleTwoName, openIdRoleOneName, openIdRoleTwoName, username, randomStringSliceSubset(allRoleIds)),
+				Check:  testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+			},
+			// remove all
+			{
+				Config: testKeycloakUserRoles_update(realmName, openIdClientName, realmRoleOneName, realmRoleTwoName, openIdRoleOneName, openIdRoleTwoName, username, []string{}),
+				Check:  testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_changeRealm(t *testing.T) {
+	realmOneName := "terraform-" + acctest.RandString(10)
+	realmTwoName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_changeRealm(realmOneName, realmTwoName, realmRoleName, username, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+					resource.TestCheckResourceAttrPair("keycloak_user_roles.user_roles", "realm_id", "keycloak_realm.realm_one", "id"),
+				),
+			},
+			// moving the resource to another realm replaces it, so the roles have to be removed from the user in the old realm
+			{
+				Config: testKeycloakUserRoles_changeRealm(realmOneName, realmTwoName, realmRoleName, username, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+					resource.TestCheckResourceAttrPair("keycloak_user_roles.user_roles", "realm_id", "keycloak_realm.realm_two", "id"),
+					testAccCheckKeycloakUserHasNoRoles("keycloak_user.user_one"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_nonExclusive(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleOneName := "terraform-role-" + acctest.RandString(10)
+	realmRoleTwoName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_nonExclusive(realmName, realmRoleOneName, realmRoleTwoName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_roles.user_roles_one", "role_ids.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_user_roles.user_roles_two", "role_ids.#", "1"),
+					testAccCheckKeycloakUserHasRolesIncluding("keycloak_user_roles.user_roles_one"),
+					testAccCheckKeycloakUserHasRolesIncluding("keycloak_user_roles.user_roles_two"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_realmAndClientRoleWithSameName(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	clientId := "terraform-openid-client-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_realmAndClientRoleWithSameName(realmName, clientId, roleName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_roles.user_roles", "role_ids.#", "2"),
+					testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+				),
+			},
+			// reading the roles back must not mix up the two roles, otherwise this plan wouldn't be empty
+			{
+				Config:   testKeycloakUserRoles_realmAndClientRoleWithSameName(realmName, clientId, roleName, username),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_compositeRoles(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	compositeRoleName := "terraform-role-" + acctest.RandString(10)
+	childRoleName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// only the composite role is assigned, so its child should not be tracked
+			{
+				Config: testKeycloakUserRoles_compositeRoles(realmName, compositeRoleName, childRoleName, username, []string{
+					"${keycloak_role.composite_role.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_roles.user_roles", "role_ids.#", "1"),
+					testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+				),
+			},
+			// the child role is also explicitly assigned, so both should be tracked
+			{
+				Config: testKeycloakUserRoles_compositeRoles(realmName, compositeRoleName, childRoleName, username, []string{
+					"${keycloak_role.composite_role.id}",
+					"${keycloak_role.child_role.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_roles.user_roles", "role_ids.#", "2"),
+					testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_serviceAccount(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+	clientId := "terraform-openid-client-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_serviceAccount(realmName, realmRoleName, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_user_roles.user_roles", "user_id", "data.keycloak_openid_client_service_account_user.service_account_user", "id"),
+					resource.TestCheckResourceAttr("keycloak_user_roles.user_roles", "role_ids.#", "1"),
+					testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_user_roles.user_roles",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_username(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleOneName := "terraform-role-" + acctest.RandString(10)
+	realmRoleTwoName := "terraform-role-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserRoles_username(realmName, realmRoleOneName, realmRoleTwoName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_user_roles.user_roles", "user_id", "keycloak_user.user", "id"),
+					resource.TestCheckResourceAttr("keycloak_user_roles.user_roles", "role_ids.#", "2"),
+					testAccCheckKeycloakUserHasRoles("keycloak_user_roles.user_roles"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_user_roles.user_roles",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_userIdConflictsWithServiceAccountClientId(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+	clientId := "terraform-openid-client-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUserRoles_userIdConflictsWithServiceAccountClientId(realmName, realmRoleName, clientId),
+				ExpectError: regexp.MustCompile("conflicts with"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserRoles_userNotSet(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	realmRoleName := "terraform-role-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUserRoles_userNotSet(realmName, realmRoleName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("one of user_id, username, or service_account_client_id must be set"),
+			},
+		},
+	})
+}
+
+func TestKeycloakUserRoles_validateUser(t *testing.T) {
+	tests := map[string]struct {
+		config      map[string]interface{}
+		expectError bool
+	}{
+		"no user": {
+			config:      map[string]interface{}{},
+			expectError: true,
+		},
+		"empty user_id": {
+			config:      map[string]interface{}{"user_id": ""},
+			expectError: true,
+		},
+		"user_id": {
+			config: map[string]interface{}{"user_id": "user-id"},
+		},
+		"unknown user_id": {
+			config: map[string]interface{}{"user_id": hcl2shim.UnknownVariableValue},
+		},
+		"username": {
+			config: map[string]interface{}{"username": "user"},
+		},
+		"service_account_client_id": {
+			config: map[string]interface{}{"service_account_client_id": "client-id"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"realm_id": "test",
+				"role_ids": []interface{}{"role-id"},
+			}
+			for k, v := range test.config {
+				config[k] = v
+			}
+
+			rawConfig, err := configs.NewRawConfig(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = resourceKeycloakUserRoles().Diff(nil, terraform.NewResourceConfig(rawConfig), nil)
+			if test.expectError && err == nil {
+				t.Fatal("expected an error when no user is set")
+			}
+			if !test.expectError && err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		})
+	}
+}
+
+// `user_id` and `username` are filled in when the resource is read, which shouldn't cause a diff for configurations
+// that only use one of them
+func TestKeycloakUserRoles_userFilledInByRead(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: userRolesId("test", "user-id"),
+		Attributes: map[string]string{
+			"id":         userRolesId("test", "user-id"),
+			"realm_id":   "test",
+			"user_id":    "user-id",
+			"username":   "user",
+			"exclusive":  "true",
+			"role_ids.#": "1",
+			"role_ids." + strconv.Itoa(schema.HashString("role-id")): "role-id",
+		},
+	}
+
+	for _, config := range []map[string]interface{}{
+		{"user_id": "user-id"},
+		{"username": "user"},
+	} {
+		config["realm_id"] = "test"
+		config["role_ids"] = []interface{}{"role-id"}
+
+		rawConfig, err := configs.NewRawConfig(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		diff, err := resourceKeycloakUserRoles().Diff(state, terraform.NewResourceConfig(rawConfig), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff != nil && len(diff.Attributes) != 0 {
+			t.Errorf("expected no diff for config %v, got %v", config, diff.Attributes)
+		}
+	}
+}
+
+// only the user that the roles are assigned to should cause the resource to be replaced, role changes are done in place
+func TestKeycloakUserRoles_forceNew(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: userRolesId("test", "user-id"),
+		Attributes: map[string]string{
+			"id":         userRolesId("test", "user-id"),
+			"realm_id":   "test",
+			"user_id":    "user-id",
+			"username":   "user",
+			"exclusive":  "true",
+			"role_ids.#": "1",
+			"role_ids." + strconv.Itoa(schema.HashString("role-id")): "role-id",
+		},
+	}
+
+	tests := map[string]struct {
+		config      map[string]interface{}
+		requiresNew bool
+	}{
+		"realm_id": {
+			config:      map[string]interface{}{"realm_id": "other", "user_id": "user-id", "role_ids": []interface{}{"role-id"}},
+			requiresNew: true,
+		},
+		"user_id": {
+			config:      map[string]interface{}{"realm_id": "test", "user_id": "other-user-id", "role_ids": []interface{}{"role-id"}},
+			requiresNew: true,
+		},
+		"role_ids": {
+			config: map[string]interface{}{"realm_id": "test", "user_id": "user-id", "role_ids": []interface{}{"role-id", "other-role-id"}},
+		},
+		"exclusive": {
+			config: map[string]interface{}{"realm_id": "test", "user_id": "user-id", "exclusive": false, "role_ids": []interface{}{"role-id"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rawConfig, err := configs.NewRawConfig(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diff, err := resourceKeycloakUserRoles().Diff(state, terraform.NewResourceConfig(rawConfig), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff == nil || diff.Empty() {
+				t.Fatalf("expected a diff when changing %s", name)
+			}
+
+			if diff.RequiresNew() != test.requiresNew {
+				t.Errorf("expected changing %s to have requires new set to %t, got %t", name, test.requiresNew, diff.RequiresNew())
+			}
+		})
+	}
+}
+
+// like testAccCheckKeycloakUserHasRoles, but allows the user to have roles that are not managed by the resource
+func testAccCheckKeycloakUserHasRolesIncluding(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.Attributes["user_id"]
+
+		user, err := keycloakClient.GetUser(realm, userId)
+		if err != nil {
+			return err
+		}
+
+		roles, err := getMapOfRealmAndClientRolesFromUser(keycloakClient, user)
+		if err != nil {
+			return err
+		}
+
+		userRoleIds := getRoleIdsFromMapOfRealmAndClientRoles(roles)
+
+		for k, v := range rs.Primary.Attributes {
+			if match, _ := regexp.MatchString("role_ids\\.[^#]+", k); !match {
+				continue
+			}
+
+			found := false
+			for _, userRoleId := range userRoleIds {
+				if userRoleId == v {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("expected to find role %s assigned to user %s", v, userId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUserHasRoles(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.Attributes["user_id"]
+
+		var roleIds []string
+		for k, v := range rs.Primary.Attributes {
+			if match, _ := regexp.MatchString("role_ids\\.[^#]+", k); !match {
+				continue
+			}
+
+			roleIds = append(roleIds, v)
+		}
+
+		roleMappings, err := keycloakClient.GetUserRoleMappings(realm, userId)
+		if err != nil {
+			return err
+		}
+
+		var userRoleIds []string
+		for _, realmRole := range roleMappings.RealmMappings {
+			userRoleIds = append(userRoleIds, realmRole.Id)
+		}
+		for _, clientRoleMapping := range roleMappings.ClientMappings {
+			for _, clientRole := range clientRoleMapping.Mappings {
+				userRoleIds = append(userRoleIds, clientRole.Id)
+			}
+		}
+
+		if len(userRoleIds) != len(roleIds) {
+			return fmt.Errorf("expected number of user roles to be %d, got %d", len(roleIds), len(userRoleIds))
+		}
+
+		for _, roleId := range roleIds {
+			found := false
+
+			for _, userRoleId := range userRoleIds {
+				if userRoleId == roleId {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("expected to find role %s assigned to user %s", roleId, userId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUserHasNoRoles(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.ID
+
+		roleMappings, err := keycloakClient.GetUserRoleMappings(realm, userId)
+		if err != nil {
+			return err
+		}
+
+		if len(roleMappings.RealmMappings) != 0 || len(roleMappings.ClientMappings) != 0 {
+			return fmt.Errorf("expected user %s to have no roles, got %v", userId, roleMappings)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserRoles_basic(realmName, openIdClientName, samlClientName, realmRoleName, openIdRoleName, samlRoleName, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "CONFIDENTIAL"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	client_id = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "openid_client_role" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.openid_client.id}"
+}
+
+resource "keycloak_role" "saml_client_role" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_saml_client.saml_client.id}"
+}
+
+data "keycloak_role" "offline_access" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	name      = "offline_access"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role.id}",
+		"${keycloak_role.openid_client_role.id}",
+		"${keycloak_role.saml_client_role.id}",
+		"${data.keycloak_role.offline_access.id}",
+	]
+}
+	`, realmName, openIdClientName, samlClientName, realmRoleName, openIdRoleName, samlRoleName, username)
+}
+
+func testKeycloakUserRoles_update(realmName, openIdClientName, realmRoleOneName, realmRoleTwoName, openIdRoleOneName, openIdRoleTwoName, username string, roleIds []string) string {
+	tfRoleIds := fmt.Sprintf("role_ids = %s", arrayOfStringsForTerraformResource(roleIds))
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "CONFIDENTIAL"
+}
+
+resource "keycloak_role" "realm_role_one" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "realm_role_two" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "openid_client_role_one" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.openid_client.id}"
+}
+
+resource "keycloak_role" "openid_client_role_two" {
+	name      = "%s"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.openid_client.id}"
+}
+
+data "keycloak_role" "offline_access" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	name      = "offline_access"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	%s
+}
+	`, realmName, openIdClientName, realmRoleOneName, realmRoleTwoName, openIdRoleOneName, openIdRoleTwoName, username, tfRoleIds)
+}
+
+func testKeycloakUserRoles_nonExclusive(realmName, realmRoleOneName, realmRoleTwoName, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role_one" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "realm_role_two" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles_one" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	user_id   = "${keycloak_user.user.id}"
+	exclusive = false
+
+	role_ids = [
+		"${keycloak_role.realm_role_one.id}",
+	]
+}
+
+resource "keycloak_user_roles" "user_roles_two" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	user_id   = "${keycloak_user.user.id}"
+	exclusive = false
+
+	role_ids = [
+		"${keycloak_role.realm_role_two.id}",
+	]
+}
+	`, realmName, realmRoleOneName, realmRoleTwoName, username)
+}
+
+func testKeycloakUserRoles_compositeRoles(realmName, compositeRoleName, childRoleName, username string, roleIds []string) string {
+	tfRoleIds := fmt.Sprintf("role_ids = %s", arrayOfStringsForTerraformResource(roleIds))
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "child_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "composite_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+
+	composite_roles = [
+		"${keycloak_role.child_role.id}",
+	]
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	%s
+}
+	`, realmName, childRoleName, compositeRoleName, username, tfRoleIds)
+}
+
+func testKeycloakUserRoles_serviceAccount(realmName, realmRoleName, clientId string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                = "%s"
+	realm_id                 = "${keycloak_realm.realm.id}"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+data "keycloak_openid_client_service_account_user" "service_account_user" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.client.id}"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id                  = "${keycloak_realm.realm.id}"
+	service_account_client_id = "${keycloak_openid_client.client.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role.id}",
+	]
+}
+	`, realmName, realmRoleName, clientId)
+}
+
+func testKeycloakUserRoles_userIdConflictsWithServiceAccountClientId(realmName, realmRoleName, clientId string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                = "%s"
+	realm_id                 = "${keycloak_realm.realm.id}"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id                  = "${keycloak_realm.realm.id}"
+	user_id                   = "not-a-real-user"
+	service_account_client_id = "${keycloak_openid_client.client.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role.id}",
+	]
+}
+	`, realmName, realmRoleName, clientId)
+}
+
+func testKeycloakUserRoles_username(realmName, realmRoleOneName, realmRoleTwoName, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role_one" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "realm_role_two" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "${keycloak_user.user.username}"
+
+	role_ids = [
+		"${keycloak_role.realm_role_one.id}",
+		"${keycloak_role.realm_role_two.id}",
+	]
+}
+	`, realmName, realmRoleOneName, realmRoleTwoName, username)
+}
+
+func testKeycloakUserRoles_realmAndClientRoleWithSameName(realmName, clientId, roleName, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	client_id   = "%s"
+	realm_id    = "${keycloak_realm.realm.id}"
+	access_type = "CONFIDENTIAL"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_role" "client_role" {
+	name      = "${keycloak_role.realm_role.name}"
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "${keycloak_openid_client.openid_client.id}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+	user_id  = "${keycloak_user.user.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role.id}",
+		"${keycloak_role.client_role.id}",
+	]
+}
+	`, realmName, clientId, roleName, username)
+}
+
+func testKeycloakUserRoles_roleRemoved(realmName, realmRoleOneName, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role_one" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles_one" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	user_id   = "${keycloak_user.user.id}"
+	exclusive = false
+
+	role_ids = [
+		"${keycloak_role.realm_role_one.id}",
+	]
+}
+	`, realmName, realmRoleOneName, username)
+}
+
+func testKeycloakUserRoles_userNotSet(realmName, realmRoleName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm.id}"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role.id}",
+	]
+}
+	`, realmName, realmRoleName)
+}
+
+func testKeycloakUserRoles_changeRealm(realmOneName, realmTwoName, realmRoleName, username, realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm_one" {
+	realm = "%s"
+}
+
+resource "keycloak_realm" "realm_two" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "realm_role_one" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm_one.id}"
+}
+
+resource "keycloak_role" "realm_role_two" {
+	name     = "%s"
+	realm_id = "${keycloak_realm.realm_two.id}"
+}
+
+resource "keycloak_user" "user_one" {
+	realm_id = "${keycloak_realm.realm_one.id}"
+	username = "%s"
+}
+
+resource "keycloak_user" "user_two" {
+	realm_id = "${keycloak_realm.realm_two.id}"
+	username = "%s"
+}
+
+resource "keycloak_user_roles" "user_roles" {
+	realm_id = "${keycloak_realm.realm_%s.id}"
+	user_id  = "${keycloak_user.user_%s.id}"
+
+	role_ids = [
+		"${keycloak_role.realm_role_%s.id}",
+	]
+}
+	`, realmOneName, realmTwoName, realmRoleName, realmRoleName, username, username, realm, realm, realm)
+}
@@ -10,7 +10,9 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -42,6 +44,39 @@ func TestAccKeycloakUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUser_multivalueAttribute(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+	attributeName := "terraform-attribute-" + acctest.RandString(10)
+
+	resourceName := "keycloak_user.user"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_multivalueAttribute(realmName, username, attributeName, `"one", "two"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserHasAttributeValues(resourceName, attributeName, []string{"one", "two"}),
+					resource.TestCheckResourceAttr(resourceName, "multivalue_attribute.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "attributes."+attributeName),
+				),
+			},
+			{
+				Config: testKeycloakUser_multivalueAttribute(realmName, username, attributeName, `"one", "three", "four"`),
+				Check:  testAccCheckKeycloakUserHasAttributeValues(resourceName, attributeName, []string{"four", "one", "three"}),
+			},
+			{
+				// the attribute's values shouldn't be joined together, which would cause a diff
+				Config:   testKeycloakUser_multivalueAttribute(realmName, username, attributeName, `"one", "three", "four"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccKeycloakUser_withInitialPassword(t *testing.T) {
 	realmName := "terraform-" + acctest.RandString(10)
 	username := "terraform-user-" + acctest.RandString(10)
@@ -103,6 +138,54 @@ func TestAccKeycloakUser_createAfterManualDestroy(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUser_mergeAttributes(t *testing.T) {
+	var user = &keycloak.User{}
+
+	realmName := "terraform-" + acctest.RandString(10)
+	username := "terraform-user-" + acctest.RandString(10)
+	resourceName := "keycloak_user.user"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_mergeAttributes(realmName, username, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserExists(resourceName),
+					testAccCheckKeycloakUserFetch(resourceName, user),
+				),
+			},
+			{
+				// another process adds an attribute, which shouldn't show up as a change or be removed by the next update
+				PreConfig: func() {
+					keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+					remoteUser, err := keycloakClient.GetUser(user.RealmId, user.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					remoteUser.Attributes["external"] = []string{"kept"}
+
+					err = keycloakClient.UpdateUser(remoteUser)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUser_mergeAttributes(realmName, username, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.managed", "bar"),
+					testAccCheckKeycloakUserHasAttribute(resourceName, "managed", "bar"),
+					testAccCheckKeycloakUserHasAttribute(resourceName, "external", "kept"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakUser_updateRealm(t *testing.T) {
 	realmOne := "terraform-" + acctest.RandString(10)
 	realmTwo := "terraform-" + acctest.RandString(10)
@@ -316,6 +399,21 @@ func testAccCheckKeycloakUserFetch(resourceName string, user *keycloak.User) res
 	}
 }
 
+func testAccCheckKeycloakUserHasAttribute(resourceName, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if strings.Join(user.Attributes[name], "") != value {
+			return fmt.Errorf("expected user to have attribute %s with value %s, got %v", name, value, user.Attributes[name])
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakUserInitialPasswordLogin(realmName string, username string, password string, clientId string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		httpClient := &http.Client{}
@@ -349,6 +447,24 @@ func testAccCheckKeycloakUserInitialPasswordLogin(realmName string, username str
 	}
 }
 
+func testAccCheckKeycloakUserHasAttributeValues(resourceName, attributeName string, values []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		actual := append([]string{}, user.Attributes[attributeName]...)
+		sort.Strings(actual)
+
+		if !reflect.DeepEqual(actual, values) {
+			return fmt.Errorf("expected attribute %s to have values %v, got %v", attributeName, values, actual)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakUserDestroy() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -406,6 +522,24 @@ resource "keycloak_user" "user" {
 	`, realm, username, attributeName, attributeValue)
 }
 
+func testKeycloakUser_mergeAttributes(realm, username, attributeValue string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id         = "${keycloak_realm.realm.id}"
+	username         = "%s"
+	merge_attributes = true
+
+	attributes = {
+		managed = "%s"
+	}
+}
+	`, realm, username, attributeValue)
+}
+
 func testKeycloakUser_initialPassword(realm, username string, password string, clientId string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -485,3 +619,25 @@ resource "keycloak_user" "user" {
 }
 	`, user.RealmId, user.Username, user.Email, user.FirstName, user.LastName, user.Enabled)
 }
+
+func testKeycloakUser_multivalueAttribute(realm, username, attributeName, attributeValues string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = "${keycloak_realm.realm.id}"
+	username = "%s"
+
+	attributes = {
+		"single" = "value"
+	}
+
+	multivalue_attribute {
+		name   = "%s"
+		values = [%s]
+	}
+}
+	`, realm, username, attributeName, attributeValues)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"log"
+)
+
+var keycloakUsersPermissionsScopes = []scopePermissionAttribute{
+	{attribute: "view_scope", scope: "view"},
+	{attribute: "manage_scope", scope: "manage"},
+	{attribute: "map_roles_scope", scope: "map-roles"},
+	{attribute: "manage_group_membership_scope", scope: "manage-group-membership"},
+	{attribute: "impersonate_scope", scope: "impersonate"},
+	{attribute: "user_impersonated_scope", scope: "user-impersonated"},
+}
+
+func resourceKeycloakUsersPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeycloakUsersPermissionsCreate,
+		Read:   resourceKeycloakUsersPermissionsRead,
+		Update: resourceKeycloakUsersPermissionsUpdate,
+		Delete: resourceKeycloakUsersPermissionsDelete,
+		// This resource can be imported using {{realm}}.
+		Importer: &schema.ResourceImporter{
+			State: resourceKeycloakUsersPermissionsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"authorization_resource_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"view_scope":                    scopePermissionSchema(),
+			"manage_scope":                  scopePermissionSchema(),
+			"map_roles_scope":               scopePermissionSchema(),
+			"manage_group_membership_scope": scopePermissionSchema(),
+			"impersonate_scope":             scopePermissionSchema(),
+			"user_impersonated_scope":       scopePermissionSchema(),
+		},
+	}
+}
+
+func resourceKeycloakUsersPermissionsCreate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	err := keycloakClient.EnableUsersPermissions(realmId)
+	if err != nil {
+		return err
+	}
+
+	data.SetId(realmId)
+
+	return resourceKeycloakUsersPermissionsUpdate(data, meta)
+}
+
+func resourceKeycloakUsersPermissionsRead(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	usersPermissions, err := keycloakClient.GetUsersPermissions(realmId)
+	if err != nil {
+		return handleNotFoundError(err, data)
+	}
+
+	// permissions that were disabled outside of terraform no longer have any scope permissions, so they need to be
+	// enabled again
+	if !usersPermissions.Enabled {
+		log.Printf("[WARN] Removing resource with id %s from state as users permissions are no longer enabled", data.Id())
+		data.SetId("")
+
+		return nil
+	}
+
+	realmManagementClient, err := keycloakClient.GetRealmManagementClient(realmId)
+	if err != nil {
+		return err
+	}
+
+	err = setScopePermissionsData(keycloakClient, data, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions, keycloakUsersPermissionsScopes)
+	if err != nil {
+		return err
+	}
+
+	data.Set("enabled", usersPermissions.Enabled)
+	data.Set("authorization_resource_server_id", realmManagementClient.Id)
+
+	return nil
+}
+
+func resourceKeycloakUsersPermissionsUpdate(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	usersPermissions, err := keycloakClient.GetUsersPermissions(realmId)
+	if err != nil {
+		return err
+	}
+
+	realmManagementClient, err := keycloakClient.GetRealmManagementClient(realmId)
+	if err != nil {
+		return err
+	}
+
+	err = updateScopePermissionsFromData(keycloakClient, data, realmId, realmManagementClient.Id, usersPermissions.ScopePermissions, keycloakUsersPermissionsScopes)
+	if err != nil {
+		return err
+	}
+
+	return resourceKeycloakUsersPermissionsRead(data, meta)
+}
+
+func resourceKeycloakUsersPermissionsDelete(data *schema.ResourceData, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return keycloakClient.DisableUsersPermissions(data.Get("realm_id").(string))
+}
+
+func resourceKeycloakUsersPermissionsImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakUsersPermissions_basic(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+	policyName := "terraform-policy-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakUsersPermissionsDisabled(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUsersPermissions_basic(realmName, roleName, policyName, "UNANIMOUS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_users_permissions.permissions", "enabled", "true"),
+					resource.TestCheckResourceAttrPair("keycloak_users_permissions.permissions", "authorization_resource_server_id", "data.keycloak_openid_client.realm_management", "id"),
+					resource.TestCheckResourceAttr("keycloak_users_permissions.permissions", "view_scope.0.policies.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_users_permissions.permissions", "manage_scope.#", "0"),
+					testAccCheckKeycloakUsersPermissionHasPolicies(realmName, "view", 1),
+				),
+			},
+			{
+				ResourceName:      "keycloak_users_permissions.permissions",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakUsersPermissions_basic(realmName, roleName, policyName, "AFFIRMATIVE"),
+				Check:  resource.TestCheckResourceAttr("keycloak_users_permissions.permissions", "view_scope.0.decision_strategy", "AFFIRMATIVE"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUsersPermissions_impersonate(t *testing.T) {
+	realmName := "terraform-" + acctest.RandString(10)
+	roleName := "terraform-role-" + acctest.RandString(10)
+	policyName := "terraform-policy-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckKeycloakUsersPermissionsDisabled(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUsersPermissions_impersonate(realmName, roleName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_users_permissions.permissions", "impersonate_scope.0.policies.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_users_permissions.permissions", "user_impersonated_scope.#", "0"),
+					testAccCheckKeycloakUsersPermissionHasPolicies(realmName, "impersonate", 1),
+					testAccCheckKeycloakUsersPermissionHasPolicies(realmName, "user-impersonated", 0),
+				),
+			},
+			{
+				// removing the block removes the policy from the scope permission
+				Config: testKeycloakUsersPermissions_basic(realmName, roleName, policyName, "UNANIMOUS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_users_permissions.permissions", "impersonate_scope.#", "0"),
+					testAccCheckKeycloakUsersPermissionHasPolicies(realmName, "impersonate", 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUsersPermissionHasPolicies(realmId, scope string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+		usersPermissions, err := keycloakClient.GetUsersPermissions(realmId)
+		if err != nil {
+			return err
+		}
+
+		realmManagementClient, err := keycloakClient.GetRealmManagementClient(realmId)
+		if err != nil {
+			return err
+		}
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationScopePermission(realmId, realmManagementClient.Id, usersPermissions.ScopePermissions[scope])
+		if err != nil {
+			return err
+		}
+
+		if len(permission.Policies) != count {
+			return fmt.Errorf("expected %s permission to have %d policies, got %d", scope, count, len(permission.Policies))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUsersPermissionsDisabled() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_users_permissions" {
+				continue
+			}
+
+			keycloakClient := testAccProvider.Meta().(*keycloak.KeycloakClient)
+
+			usersPermissions, _ := keycloakClient.GetUsersPermissions(rs.Primary.Attributes["realm_id"])
+			if usersPermissions != nil && usersPermissions.Enabled {
+				return fmt.Errorf("users permissions are still enabled in realm %s", rs.Primary.Attributes["realm_id"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUsersPermissions_basic(realmName, roleName, policyName, decisionStrategy string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_openid_client" "realm_management" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "realm-management"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_openid_client_role_policy" "policy" {
+	realm_id           = "${keycloak_realm.realm.id}"
+	resource_server_id = "${data.keycloak_openid_client.realm_management.id}"
+	name               = "%s"
+
+	role {
+		id = "${keycloak_role.role.id}"
+	}
+}
+
+resource "keycloak_users_permissions" "permissions" {
+	realm_id = "${keycloak_realm.realm.id}"
+
+	view_scope {
+		policies          = ["${keycloak_openid_client_role_policy.policy.id}"]
+		description       = "view users"
+		decision_strategy = "%s"
+	}
+}
+	`, realmName, roleName, policyName, decisionStrategy)
+}
+
+func testKeycloakUsersPermissions_impersonate(realmName, roleName, policyName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_openid_client" "realm_management" {
+	realm_id  = "${keycloak_realm.realm.id}"
+	client_id = "realm-management"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = "${keycloak_realm.realm.id}"
+	name     = "%s"
+}
+
+resource "keycloak_openid_client_role_policy" "policy" {
+	realm_id           = "${keycloak_realm.realm.id}"
+	resource_server_id = "${data.keycloak_openid_client.realm_management.id}"
+	name               = "%s"
+
+	role {
+		id = "${keycloak_role.role.id}"
+	}
+}
+
+resource "keycloak_users_permissions" "permissions" {
+	realm_id = "${keycloak_realm.realm.id}"
+
+	impersonate_scope {
+		policies    = ["${keycloak_openid_client_role_policy.policy.id}"]
+		description = "impersonate users"
+	}
+}
+	`, realmName, roleName, policyName)
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"sync"
+)
+
+// roleCache is used to resolve many roles within a single operation without sending an HTTP request per role.
+// The first time a role belonging to the realm or to a client is needed, every role for that realm or client is fetched
+// at once and kept in memory, keyed by "realm" or the client's ID. It is safe for concurrent use.
+type roleCache struct {
+	keycloakClient *keycloak.KeycloakClient
+	realmId        string
+	roles          map[string][]*keycloak.Role
+	mutex          sync.RWMutex
+}
+
+func newRoleCache(keycloakClient *keycloak.KeycloakClient, realmId string) *roleCache {
+	return &roleCache{
+		keycloakClient: keycloakClient,
+		realmId:        realmId,
+		roles:          make(map[string][]*keycloak.Role),
+	}
+}
+
+func roleCacheKey(clientId string) string {
+	if clientId == "" {
+		return "realm"
+	}
+
+	return clientId
+}
+
+// returns all roles for the realm if `clientId` is empty, otherwise all roles for the client with the given ID
+func (c *roleCache) listRoles(clientId string) ([]*keycloak.Role, error) {
+	key := roleCacheKey(clientId)
+
+	c.mutex.RLock()
+	roles, ok := c.roles[key]
+	c.mutex.RUnlock()
+
+	if ok {
+		return roles, nil
+	}
+
+	// the lock isn't held while fetching so that roles for different clients can be fetched in parallel
+	roles, err := c.keycloakClient.ListRoles(c.realmId, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	c.roles[key] = roles
+	c.mutex.Unlock()
+
+	return roles, nil
+}
+
+func (c *roleCache) getRoleByName(clientId, name string) (*keycloak.Role, error) {
+	roles, err := c.listRoles(clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		if role.Name == name {
+			return role, nil
+		}
+	}
+
+	// fall back to fetching the role directly, which will return an appropriate error if it doesn't exist
+	return c.keycloakClient.GetRoleByName(c.realmId, clientId, name)
+}
+
+// like getRoleByName, but the error for a missing role names the client by its `clientId` rather than its ID
+func (c *roleCache) getClientRoleByName(client *keycloak.GenericClient, name string) (*keycloak.Role, error) {
+	role, err := c.getRoleByName(client.Id, name)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil, keycloak.NewRoleNotFoundError(c.realmId, client.ClientId, name)
+		}
+
+		return nil, err
+	}
+
+	return role, nil
+}
+
+func (c *roleCache) getRole(id string) (*keycloak.Role, error) {
+	c.mutex.RLock()
+	for _, roles := range c.roles {
+		for _, role := range roles {
+			if role.Id == id {
+				c.mutex.RUnlock()
+				return role, nil
+			}
+		}
+	}
+	_, realmRolesFetched := c.roles["realm"]
+	c.mutex.RUnlock()
+
+	// most roles are realm roles, so fetching all of them first will usually save some requests
+	if !realmRolesFetched {
+		realmRoles, err := c.listRoles("")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, role := range realmRoles {
+			if role.Id == id {
+				return role, nil
+			}
+		}
+	}
+
+	// the role belongs to a client we haven't seen yet (or it doesn't exist, in which case this returns an error)
+	role, err := c.keycloakClient.GetRole(c.realmId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.ClientRole {
+		// fetch the rest of this client's roles, since it's likely that other roles from this client will be needed
+		_, err = c.listRoles(role.ClientId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return role, nil
+}
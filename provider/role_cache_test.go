@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// a realm role and a client role both named "shared", which the cache must keep apart
+func newSharedRoleNameClient(t *testing.T) (*keycloak.KeycloakClient, *httptest.Server) {
+	return newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/auth/admin/realms/test/roles":
+			w.Write([]byte(`[{"id": "realm-role-id", "name": "shared", "clientRole": false, "containerId": "test"}]`))
+		case "/auth/admin/realms/test/clients/client-id/roles":
+			w.Write([]byte(`[{"id": "client-role-id", "name": "shared", "clientRole": true, "containerId": "client-id"}]`))
+		case "/auth/admin/realms/test/roles-by-id/client-role-id":
+			w.Write([]byte(`{"id": "client-role-id", "name": "shared", "clientRole": true, "containerId": "client-id"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func TestRoleCache_realmAndClientRoleWithSameName(t *testing.T) {
+	keycloakClient, server := newSharedRoleNameClient(t)
+	defer server.Close()
+
+	cache := newRoleCache(keycloakClient, "test")
+
+	clientRole, err := cache.getRoleByName("client-id", "shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if clientRole.Id != "client-role-id" || clientRole.ClientId != "client-id" {
+		t.Fatalf("expected client role client-role-id belonging to client-id, got %s belonging to %s", clientRole.Id, clientRole.ClientId)
+	}
+
+	realmRole, err := cache.getRoleByName("", "shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if realmRole.Id != "realm-role-id" || realmRole.ClientRole {
+		t.Fatalf("expected realm role realm-role-id, got %s", realmRole.Id)
+	}
+
+	// both containers are cached now, so looking a role up by ID must return the one from the right container
+	role, err := cache.getRole("client-role-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !role.ClientRole || role.ClientId != "client-id" {
+		t.Fatalf("expected role client-role-id to be a role of client-id")
+	}
+
+	roles, err := getMapOfRealmAndClientRoles(keycloakClient, "test", []string{"realm-role-id", "client-role-id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(roles["realm"]) != 1 || roles["realm"][0].Id != "realm-role-id" {
+		t.Fatalf("expected realm-role-id to be grouped as a realm role, got %v", roles["realm"])
+	}
+
+	if len(roles["client-id"]) != 1 || roles["client-id"][0].Id != "client-role-id" {
+		t.Fatalf("expected client-role-id to be grouped under client-id, got %v", roles["client-id"])
+	}
+}
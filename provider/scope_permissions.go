@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// fine-grained admin permissions (for users, clients, identity providers, etc.) are made up of one scope permission per
+// scope on the `realm-management` client. resources that manage them expose one block per scope using the helpers below
+
+// maps a block in the resource's schema to the name of the scope in keycloak
+type scopePermissionAttribute struct {
+	attribute string
+	scope     string
+}
+
+func scopePermissionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policies": {
+					Type:     schema.TypeSet,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+					Optional: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"decision_strategy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+					Default:      "UNANIMOUS",
+				},
+			},
+		},
+	}
+}
+
+// updates the scope permission for each attribute to match its block. a scope without a block has its policies removed,
+// so these resources are authoritative over every scope they expose
+func updateScopePermissionsFromData(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, realmId, resourceServerId string, scopePermissionIds map[string]string, attributes []scopePermissionAttribute) error {
+	for _, attribute := range attributes {
+		permissionId, ok := scopePermissionIds[attribute.scope]
+		if !ok {
+			return fmt.Errorf("keycloak did not return a permission for the %s scope", attribute.scope)
+		}
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationScopePermission(realmId, resourceServerId, permissionId)
+		if err != nil {
+			return err
+		}
+
+		permission.Policies = []string{}
+		permission.Description = ""
+		permission.DecisionStrategy = "UNANIMOUS"
+
+		if blocks := data.Get(attribute.attribute).([]interface{}); len(blocks) == 1 && blocks[0] != nil {
+			block := blocks[0].(map[string]interface{})
+
+			permission.Policies = interfaceSliceToStringSlice(block["policies"].(*schema.Set).List())
+			permission.Description = block["description"].(string)
+			permission.DecisionStrategy = block["decision_strategy"].(string)
+		}
+
+		err = keycloakClient.UpdateOpenidClientAuthorizationScopePermission(permission)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setScopePermissionsData(keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, realmId, resourceServerId string, scopePermissionIds map[string]string, attributes []scopePermissionAttribute) error {
+	for _, attribute := range attributes {
+		permissionId, ok := scopePermissionIds[attribute.scope]
+		if !ok {
+			data.Set(attribute.attribute, nil)
+
+			continue
+		}
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationScopePermission(realmId, resourceServerId, permissionId)
+		if err != nil {
+			return err
+		}
+
+		// a permission that's still in the state keycloak created it in is the same as not having a block for it
+		if len(permission.Policies) == 0 && permission.Description == "" && permission.DecisionStrategy == "UNANIMOUS" {
+			data.Set(attribute.attribute, nil)
+
+			continue
+		}
+
+		data.Set(attribute.attribute, []interface{}{
+			map[string]interface{}{
+				"policies":          permission.Policies,
+				"description":       permission.Description,
+				"decision_strategy": permission.DecisionStrategy,
+			},
+		})
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package provider
 import (
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 )
 
 func randomBool() bool {
@@ -75,3 +78,55 @@ func TestCheckResourceAttrNot(name, key, value string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// checks that the set of strings at `key` contains `value`. the hash that identifies each element isn't known ahead of
+// time, so every element is compared
+func testAccCheckResourceAttrSetContains(name, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		for attribute, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(attribute, key+".") && attribute != key+".#" && v == value {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s: expected %s to contain %#v", name, key, value)
+	}
+}
+
+// Starts a server that responds to token requests the same way Keycloak does, and passes all other requests to `handler`
+func newTestKeycloakServer(handler http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/realms/master/protocol/openid-connect/token" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token": "access-token", "refresh_token": "refresh-token", "token_type": "bearer"}`))
+
+			return
+		}
+
+		handler(w, r)
+	}))
+}
+
+// Starts a server with `newTestKeycloakServer`, and returns a client that is configured to talk to it
+func newTestKeycloakClient(t *testing.T, handler http.HandlerFunc) (*keycloak.KeycloakClient, *httptest.Server) {
+	server := newTestKeycloakServer(handler)
+
+	return newTestKeycloakClientForServer(t, server, 1), server
+}
+
+// Returns a client that is configured to talk to `server` and to send up to `maxConcurrency` requests at once, for tests
+// that start the server themselves
+func newTestKeycloakClientForServer(t testing.TB, server *httptest.Server, maxConcurrency int) *keycloak.KeycloakClient {
+	keycloakClient, err := keycloak.NewKeycloakClient(server.URL, "terraform", "secret", "master", "", "", true, 5, 0, 0, maxConcurrency, false, 30, "/auth")
+	if err != nil {
+		server.Close()
+		t.Fatalf("%s", err)
+	}
+
+	return keycloakClient
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"bytes"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -83,6 +84,49 @@ func handleNotFoundError(err error, data *schema.ResourceData) error {
 	return err
 }
 
+// `realm_id` is the name of the realm, but the realm's internal ID is accepted as well since that's what the admin API
+// responds with in some places. the name is tried first, and the name of the realm is returned either way
+func resolveRealm(keycloakClient *keycloak.KeycloakClient, realmId string) (string, error) {
+	realm, err := keycloakClient.GetRealm(realmId)
+	if err == nil {
+		return realm.Realm, nil
+	}
+
+	if !keycloak.ErrorIs404(err) {
+		return "", err
+	}
+
+	realms, listErr := keycloakClient.GetRealms()
+	if listErr != nil {
+		return "", listErr
+	}
+
+	for _, realm := range realms {
+		if realm.Id == realmId {
+			return realm.Realm, nil
+		}
+	}
+
+	return "", err
+}
+
+// calls `f` with `realm_id` as is, and only resolves the realm when that returns a 404, so the common case of `realm_id`
+// being the realm's name doesn't cost an extra request. the realm that `f` succeeded with is returned
+func withResolvedRealm(keycloakClient *keycloak.KeycloakClient, realmId string, f func(realmId string) error) (string, error) {
+	err := f(realmId)
+	if !keycloak.ErrorIs404(err) {
+		return realmId, err
+	}
+
+	realmName, resolveErr := resolveRealm(keycloakClient, realmId)
+	if resolveErr != nil || realmName == realmId {
+		// either the realm or the object within it doesn't exist, which the original 404 already reports
+		return realmId, err
+	}
+
+	return realmName, f(realmName)
+}
+
 func interfaceSliceToStringSlice(iv []interface{}) []string {
 	var sv []string
 	for _, i := range iv {
@@ -91,3 +135,64 @@ func interfaceSliceToStringSlice(iv []interface{}) []string {
 
 	return sv
 }
+
+func stringSliceToInterfaceSlice(sv []string) []interface{} {
+	var iv []interface{}
+	for _, s := range sv {
+		iv = append(iv, s)
+	}
+
+	return iv
+}
+
+// returns the IDs from `ids` that are also present in `managedIds`
+// this is used by non-exclusive resources to avoid tracking anything they didn't create
+func filterToManagedIds(ids []string, managedIds *schema.Set) []string {
+	var filteredIds []string
+
+	for _, id := range ids {
+		if managedIds.Contains(id) {
+			filteredIds = append(filteredIds, id)
+		}
+	}
+
+	return filteredIds
+}
+
+// calls `fn` once for every index in [0, n), running at most `limit` calls at the same time
+// if any calls fail, the error from the lowest index is returned so the result doesn't depend on scheduling
+func parallelForEach(limit, n int, fn func(i int) error) error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	errs := make([]error, n)
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < limit && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				errs[i] = fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
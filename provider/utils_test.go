@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelForEach_respectsLimit(t *testing.T) {
+	var running, maxRunning int32
+
+	err := parallelForEach(3, 20, func(i int) error {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			previous := atomic.LoadInt32(&maxRunning)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if maxRunning > 3 {
+		t.Fatalf("expected at most 3 concurrent calls, got %d", maxRunning)
+	}
+}
+
+func TestParallelForEach_returnsErrorFromLowestIndex(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		err := parallelForEach(4, 10, func(i int) error {
+			if i%3 == 2 {
+				// make the lowest failing index finish last
+				time.Sleep(time.Duration(10-i) * time.Millisecond)
+
+				return fmt.Errorf("error %d", i)
+			}
+
+			return nil
+		})
+
+		if err == nil || err.Error() != "error 2" {
+			t.Fatalf("expected error 2, got %v", err)
+		}
+	}
+}
+
+func TestResolveRealm_acceptsNameOrInternalId(t *testing.T) {
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/auth/admin/realms/my-realm":
+			w.Write([]byte(`{"id": "9c1b3f0e-5a2d-4c47-8f43-2e6f6d1a7b10", "realm": "my-realm"}`))
+		case "/auth/admin/realms":
+			w.Write([]byte(`[{"id": "master", "realm": "master"}, {"id": "9c1b3f0e-5a2d-4c47-8f43-2e6f6d1a7b10", "realm": "my-realm"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	for _, realmId := range []string{"my-realm", "9c1b3f0e-5a2d-4c47-8f43-2e6f6d1a7b10"} {
+		realmName, err := resolveRealm(keycloakClient, realmId)
+		if err != nil {
+			t.Fatalf("expected %s to resolve, got %s", realmId, err)
+		}
+
+		if realmName != "my-realm" {
+			t.Fatalf("expected %s to resolve to my-realm, got %s", realmId, realmName)
+		}
+	}
+
+	_, err := resolveRealm(keycloakClient, "missing")
+	if !keycloak.ErrorIs404(err) {
+		t.Fatalf("expected a 404 for a realm that doesn't exist, got %v", err)
+	}
+}
+
+func TestWithResolvedRealm_onlyResolvesAfterNotFound(t *testing.T) {
+	var requests []string
+
+	keycloakClient, server := newTestKeycloakClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		requests = append(requests, r.URL.Path)
+
+		switch r.URL.Path {
+		case "/auth/admin/realms/my-realm/roles-by-id/role-id":
+			w.Write([]byte(`{"id": "role-id", "name": "role"}`))
+		case "/auth/admin/realms":
+			w.Write([]byte(`[{"id": "9c1b3f0e-5a2d-4c47-8f43-2e6f6d1a7b10", "realm": "my-realm"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	getRole := func(realmId string) error {
+		_, err := keycloakClient.GetRole(realmId, "role-id")
+
+		return err
+	}
+
+	realmName, err := withResolvedRealm(keycloakClient, "my-realm", getRole)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if realmName != "my-realm" || len(requests) != 1 {
+		t.Fatalf("expected a single request when realm_id is the realm's name, got %v", requests)
+	}
+
+	realmName, err = withResolvedRealm(keycloakClient, "9c1b3f0e-5a2d-4c47-8f43-2e6f6d1a7b10", getRole)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if realmName != "my-realm" {
+		t.Fatalf("expected the internal ID to resolve to my-realm, got %s", realmName)
+	}
+}